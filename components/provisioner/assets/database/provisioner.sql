@@ -47,6 +47,7 @@ CREATE TABLE gardener_config
     shoot_networking_filter_disabled boolean,
     control_plane_failure_tolerance varchar(256),
     eu_access boolean NOT NULL,
+    node_config jsonb,
     UNIQUE(cluster_id),
     foreign key (cluster_id) REFERENCES cluster (id) ON DELETE CASCADE
 );
@@ -56,7 +57,8 @@ CREATE TABLE gardener_config
 CREATE TYPE operation_state AS ENUM (
     'IN_PROGRESS',
     'SUCCEEDED',
-    'FAILED'
+    'FAILED',
+    'CANCELLED'
     );
 
 CREATE TYPE operation_type AS ENUM (
@@ -67,7 +69,8 @@ CREATE TYPE operation_type AS ENUM (
     'UPGRADE_SHOOT',
     'HIBERNATE',
     'PROVISION_NO_INSTALL',
-    'DEPROVISION_NO_INSTALL'
+    'DEPROVISION_NO_INSTALL',
+    'WAKE_UP'
     );
 
 CREATE TABLE operation
@@ -84,7 +87,127 @@ CREATE TABLE operation
     last_transition timestamp without time zone,
     err_message text NOT NULL,
     reason text NOT NULL,
-    component text NOT NULL
+    component text NOT NULL,
+    priority integer NOT NULL DEFAULT 0,
+    attempts integer NOT NULL DEFAULT 0,
+    trace_context text NOT NULL DEFAULT ''
+);
+
+-- Operation retry
+
+CREATE TABLE operation_retry
+(
+    id uuid PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    operation_id uuid NOT NULL,
+    stage varchar(256) NOT NULL,
+    retry_timestamp timestamp without time zone NOT NULL,
+    message text,
+    err_message text NOT NULL,
+    reason text NOT NULL,
+    component text NOT NULL,
+    foreign key (operation_id) REFERENCES operation (id) ON DELETE CASCADE
+);
+
+-- Gardener config backup
+
+CREATE TABLE gardener_config_backup
+(
+    operation_id uuid PRIMARY KEY CHECK (operation_id <> '00000000-0000-0000-0000-000000000000'),
+    config jsonb NOT NULL,
+    upgraded_config jsonb,
+    foreign key (operation_id) REFERENCES operation (id) ON DELETE CASCADE
+);
+
+-- Idempotency key
+
+CREATE TABLE idempotency_key
+(
+    tenant varchar(256) NOT NULL,
+    key varchar(256) NOT NULL,
+    payload_hash varchar(64) NOT NULL,
+    operation_id uuid,
+    created_at timestamp without time zone NOT NULL,
+    PRIMARY KEY (tenant, key),
+    foreign key (operation_id) REFERENCES operation (id) ON DELETE CASCADE
+);
+
+-- Operation lease
+
+CREATE TABLE operation_lease
+(
+    operation_id uuid PRIMARY KEY,
+    owner varchar(256) NOT NULL,
+    heartbeat timestamp without time zone NOT NULL,
+    expires_at timestamp without time zone NOT NULL,
+    foreign key (operation_id) REFERENCES operation (id) ON DELETE CASCADE
+);
+
+CREATE INDEX operation_lease_owner_idx ON operation_lease (owner);
+
+-- Operation queue
+
+CREATE TABLE operation_queue
+(
+    operation_id uuid PRIMARY KEY,
+    queue varchar(64) NOT NULL,
+    priority integer NOT NULL DEFAULT 0,
+    next_run_at timestamp without time zone NOT NULL,
+    attempts integer NOT NULL DEFAULT 0,
+    owner varchar(256),
+    locked_until timestamp without time zone,
+    foreign key (operation_id) REFERENCES operation (id) ON DELETE CASCADE
+);
+
+CREATE INDEX operation_queue_next_run_idx ON operation_queue (queue, next_run_at);
+
+-- Shoot drift
+
+CREATE TABLE shoot_drift
+(
+    cluster_id uuid NOT NULL,
+    field varchar(256) NOT NULL,
+    expected_value text NOT NULL,
+    actual_value text NOT NULL,
+    policy varchar(256) NOT NULL,
+    detected_at timestamp without time zone NOT NULL,
+    PRIMARY KEY (cluster_id, field),
+    foreign key (cluster_id) REFERENCES cluster (id) ON DELETE CASCADE
+);
+
+-- Gardener config history
+
+CREATE TABLE gardener_config_history
+(
+    id uuid PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    cluster_id uuid NOT NULL,
+    field varchar(256) NOT NULL,
+    old_value text NOT NULL,
+    new_value text NOT NULL,
+    changed_at timestamp without time zone NOT NULL,
+    foreign key (cluster_id) REFERENCES cluster (id) ON DELETE CASCADE
+);
+
+CREATE INDEX gardener_config_history_cluster_idx ON gardener_config_history (cluster_id, changed_at);
+
+-- Worker pool
+
+CREATE TABLE worker_pool
+(
+    id uuid PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    gardener_config_id uuid NOT NULL,
+    name varchar(256) NOT NULL,
+    machine_type varchar(256) NOT NULL,
+    machine_image varchar(256),
+    machine_image_version varchar(256),
+    disk_type varchar(256),
+    volume_size_gb integer,
+    auto_scaler_min integer NOT NULL,
+    auto_scaler_max integer NOT NULL,
+    max_surge integer NOT NULL,
+    max_unavailable integer NOT NULL,
+    node_config jsonb,
+    UNIQUE(gardener_config_id, name),
+    foreign key (gardener_config_id) REFERENCES gardener_config (id) ON DELETE CASCADE
 );
 
 -- Kyma Release
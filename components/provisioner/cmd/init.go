@@ -1,7 +1,9 @@
 package main
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"time"
 
@@ -9,12 +11,19 @@ import (
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
 
+	gardener_apis "github.com/gardener/gardener/pkg/client/core/clientset/versioned/typed/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/events"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/gardener"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/healthz"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/tracing"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/uuid"
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/util/flowcontrol"
 )
 
 const (
@@ -34,10 +43,14 @@ func newProvisioningService(
 	provisioningQueue queue.OperationQueue,
 	deprovisioningQueue queue.OperationQueue,
 	shootUpgradeQueue queue.OperationQueue,
+	hibernationQueue queue.OperationQueue,
+	wakeUpQueue queue.OperationQueue,
 	defaultEnableKubernetesVersionAutoUpdate,
 	defaultEnableMachineImageVersionAutoUpdate bool,
 	defaultEnableIMDSv2 bool,
-	dynamicKubeconfigProvider DynamicKubeconfigProvider) provisioning.Service {
+	dynamicKubeconfigProvider DynamicKubeconfigProvider,
+	quotas provisioning.Quotas,
+	publisher events.Publisher) provisioning.Service {
 
 	uuidGenerator := uuid.NewUUIDGenerator()
 	inputConverter := provisioning.NewInputConverter(uuidGenerator, gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2)
@@ -53,10 +66,14 @@ func newProvisioningService(
 		provisioningQueue,
 		deprovisioningQueue,
 		shootUpgradeQueue,
-		dynamicKubeconfigProvider)
+		hibernationQueue,
+		wakeUpQueue,
+		dynamicKubeconfigProvider,
+		quotas,
+		publisher)
 }
 
-func newShootController(gardenerNamespace string, gardenerClusterCfg *restclient.Config, dbsFactory dbsession.Factory, auditLogTenantConfigPath string) (*gardener.ShootController, error) {
+func newShootController(gardenerNamespace string, gardenerClusterCfg *restclient.Config, dbsFactory dbsession.Factory, auditLogTenantConfigPath string, driftPolicies map[string]model.DriftPolicy) (*gardener.ShootController, error) {
 
 	syncPeriod := defaultSyncPeriod
 
@@ -65,7 +82,29 @@ func newShootController(gardenerNamespace string, gardenerClusterCfg *restclient
 		return nil, fmt.Errorf("unable to create shoot controller manager: %w", err)
 	}
 
-	return gardener.NewShootController(mgr, dbsFactory, auditLogTenantConfigPath)
+	return gardener.NewShootController(mgr, dbsFactory, auditLogTenantConfigPath, driftPolicies)
+}
+
+func newReadinessCheckers(dbsFactory dbsession.Factory, shootClient gardener_apis.ShootInterface, shootController *gardener.ShootController, queues map[string]queue.OperationQueue) []healthz.Checker {
+	checkers := []healthz.Checker{
+		healthz.NewChecker("database", func(ctx context.Context) error {
+			return dbsFactory.Ping(ctx)
+		}),
+		healthz.NewChecker("gardener", func(ctx context.Context) error {
+			_, err := shootClient.List(ctx, metav1.ListOptions{Limit: 1})
+			return err
+		}),
+		healthz.NewChecker("shootController", shootController.CacheSynced),
+	}
+
+	for name, operationQueue := range queues {
+		operationQueue := operationQueue
+		checkers = append(checkers, healthz.NewChecker(fmt.Sprintf("%sQueue", name), func(context.Context) error {
+			return operationQueue.Alive()
+		}))
+	}
+
+	return checkers
 }
 
 func newGardenerClusterConfig(cfg config) (*restclient.Config, error) {
@@ -78,6 +117,11 @@ func newGardenerClusterConfig(cfg config) (*restclient.Config, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to create Gardener cluster config: %s", err.Error())
 	}
+	// Every client created from the config shares the same limiter
+	gardenerClusterConfig.RateLimiter = flowcontrol.NewTokenBucketRateLimiter(cfg.Gardener.QPS, cfg.Gardener.Burst)
+	gardenerClusterConfig.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return tracing.NewTransport("gardener", rt)
+	})
 
 	return gardenerClusterConfig, nil
 }
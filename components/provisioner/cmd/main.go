@@ -4,8 +4,11 @@ import (
 	"context"
 	"fmt"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util/testkit"
+	"net"
 	"net/http"
-	"sync"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -19,24 +22,35 @@ import (
 	"github.com/kyma-project/control-plane/components/provisioner/internal/api"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/api/middlewares"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/auth"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/events"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/gardener"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/healthz"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/metrics"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/lease"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/queue"
 	provisioningStages "github.com/kyma-project/control-plane/components/provisioner/internal/operations/stages/provisioning"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/database"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/ratelimit"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/tracing"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util/k8s"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/uuid"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 	"github.com/pkg/errors"
-	"github.com/prometheus/client_golang/prometheus/promhttp"
 	log "github.com/sirupsen/logrus"
 	"github.com/vrischmann/envconfig"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 )
 
+const websocketKeepAliveInterval = 10 * time.Second
+
 const connStringFormat string = "host=%s port=%s user=%s password=%s dbname=%s sslmode=%s sslrootcert=%s"
 
 type config struct {
@@ -72,12 +86,49 @@ type config struct {
 		DefaultEnableMachineImageVersionAutoUpdate bool   `envconfig:"default=false"`
 		DefaultEnableIMDSv2                        bool   `envconfig:"default=false"`
 		EnableDumpShootSpec                        bool   `envconfig:"default=false"`
+		DeleteShootOnProvisioningFailure           bool   `envconfig:"default=false"`
+		// QPS and Burst limit calls of all Gardener clients together, including calls made while handling API requests
+		QPS   float32 `envconfig:"default=50"`
+		Burst int     `envconfig:"default=100"`
+		// ShootDriftPolicies lists entries in the form of "field=policy" overriding default policies, e.g. "machineType=revert,oidcConfig=record"
+		ShootDriftPolicies []string `envconfig:"optional"`
 	}
 
+	Auth auth.Config
+
+	RateLimit ratelimit.Config
+	Quotas    provisioning.Quotas
+
+	// IdempotencyKeyReservationTTL is the time after which the key reserved by the request which did not start its operation can be used again
+	IdempotencyKeyReservationTTL time.Duration `envconfig:"default=10m"`
+
 	EnqueueInProgressOperations bool `envconfig:"default=true"`
 
+	Leases lease.Config
+
+	// Queues allow tuning throughput of every operation queue separately
+	Queues struct {
+		// Durable queues keep the schedule of operations in the database, they do not need leases as operations stay claimed while processed
+		Durable      bool          `envconfig:"default=false"`
+		PollInterval time.Duration `envconfig:"default=1s"`
+		// ClaimTimeout after which operation claimed by replica which stopped responding is processed by other replica
+		ClaimTimeout   time.Duration `envconfig:"default=10m"`
+		Provisioning   queue.Config
+		Deprovisioning queue.Config
+		ShootUpgrade   queue.Config
+		Hibernation    queue.Config
+		WakeUp         queue.Config
+	}
+
+	Tracing tracing.Config
+
 	MetricsAddress string `envconfig:"default=127.0.0.1:9000"`
 
+	ShutdownTimeout time.Duration `envconfig:"default=50s"`
+
+	// ReadinessTimeout limits the time of all readiness checks, it has to be shorter than the timeout of the readiness probe
+	ReadinessTimeout time.Duration `envconfig:"default=4s"`
+
 	LogLevel string `envconfig:"default=info"`
 }
 
@@ -89,10 +140,21 @@ func (c *config) String() string {
 		"ProvisioningTimeoutInstallation: %s, ProvisioningTimeoutUpgrade: %s, "+
 		"DeprovisioningNoInstallTimeoutClusterDeletion: %s, DeprovisioningNoInstallTimeoutWaitingForClusterDeletion: %s "+
 		"ShootUpgradeTimeout: %s, "+
+		"HibernationTimeoutWaitingForClusterHibernation: %s, HibernationTimeoutWaitingForClusterWakeUp: %s, "+
 		"OperatorRoleBindingCreatingForAdmin: %t "+
-		"GardenerProject: %s, GardenerKubeconfigPath: %s, GardenerAuditLogsPolicyConfigMap: %s, AuditLogsTenantConfigPath: %s, DefaultEnableIMDSv2: %v "+
+		"GardenerProject: %s, GardenerKubeconfigPath: %s, GardenerAuditLogsPolicyConfigMap: %s, AuditLogsTenantConfigPath: %s, DefaultEnableIMDSv2: %v, GardenerQPS: %v, GardenerBurst: %d "+
+		"AuthEnabled: %v, AuthIssuerURL: %s, AuthJWKSFile: %s, AuthAudience: %s "+
+		"RateLimitEnabled: %v, RateLimitRequestsPerSecond: %v, RateLimitMutationsPerMinute: %v "+
+		"QuotasMaxRuntimesPerTenant: %d, QuotasMaxRuntimesPerSubAccount: %d "+
+		"IdempotencyKeyReservationTTL: %s "+
 		"EnqueueInProgressOperations: %v "+
+		"LeasesEnabled: %v, LeasesTTL: %s, LeasesResyncInterval: %s "+
+		"QueuesDurable: %v, QueuesClaimTimeout: %s, QueuesProvisioningWorkers: %d, QueuesDeprovisioningWorkers: %d, QueuesShootUpgradeWorkers: %d, QueuesShootUpgradeQPS: %v "+
+		"TracingEnabled: %v, TracingEndpoint: %s, TracingSampleRatio: %v "+
 		"EnableDumpShootSpec: %v "+
+		"DeleteShootOnProvisioningFailure: %v "+
+		"ShootDriftPolicies: %v "+
+		"ShutdownTimeout: %s, ReadinessTimeout: %s "+
 		"LogLevel: %s",
 		c.Address, c.APIEndpoint,
 		c.Database.User, c.Database.Host, c.Database.Port,
@@ -101,10 +163,21 @@ func (c *config) String() string {
 		c.ProvisioningTimeout.Installation.String(), c.ProvisioningTimeout.Upgrade.String(),
 		c.DeprovisioningTimeout.ClusterDeletion.String(), c.DeprovisioningTimeout.WaitingForClusterDeletion.String(),
 		c.ProvisioningTimeout.ShootUpgrade.String(),
+		c.HibernationTimeout.WaitingForClusterHibernation.String(), c.HibernationTimeout.WaitingForClusterWakeUp.String(),
 		c.OperatorRoleBinding.CreatingForAdmin,
-		c.Gardener.Project, c.Gardener.KubeconfigPath, c.Gardener.AuditLogsPolicyConfigMap, c.Gardener.AuditLogsTenantConfigPath, c.Gardener.DefaultEnableIMDSv2,
+		c.Gardener.Project, c.Gardener.KubeconfigPath, c.Gardener.AuditLogsPolicyConfigMap, c.Gardener.AuditLogsTenantConfigPath, c.Gardener.DefaultEnableIMDSv2, c.Gardener.QPS, c.Gardener.Burst,
+		c.Auth.Enabled, c.Auth.IssuerURL, c.Auth.JWKSFile, c.Auth.Audience,
+		c.RateLimit.Enabled, c.RateLimit.RequestsPerSecond, c.RateLimit.MutationsPerMinute,
+		c.Quotas.MaxRuntimesPerTenant, c.Quotas.MaxRuntimesPerSubAccount,
+		c.IdempotencyKeyReservationTTL.String(),
 		c.EnqueueInProgressOperations,
+		c.Leases.Enabled, c.Leases.TTL.String(), c.Leases.ResyncInterval.String(),
+		c.Queues.Durable, c.Queues.ClaimTimeout.String(), c.Queues.Provisioning.Workers, c.Queues.Deprovisioning.Workers, c.Queues.ShootUpgrade.Workers, c.Queues.ShootUpgrade.QPS,
+		c.Tracing.Enabled, c.Tracing.Endpoint, c.Tracing.SampleRatio,
 		c.Gardener.EnableDumpShootSpec,
+		c.Gardener.DeleteShootOnProvisioningFailure,
+		c.Gardener.ShootDriftPolicies,
+		c.ShutdownTimeout.String(), c.ReadinessTimeout.String(),
 		c.LogLevel)
 }
 
@@ -128,6 +201,14 @@ func main() {
 	log.Infof("Starting Provisioner")
 	log.Infof("Config: %s", cfg.String())
 
+	// Spans are not recorded when tracing is disabled as the default tracer provider does nothing
+	var tracerProvider *sdktrace.TracerProvider
+	if cfg.Tracing.Enabled {
+		tracerProvider, err = tracing.NewTracerProvider(context.Background(), cfg.Tracing)
+		exitOnError(err, "Failed to initialize tracing")
+		tracing.SetTracerProvider(tracerProvider)
+	}
+
 	connString := fmt.Sprintf(connStringFormat, cfg.Database.Host, cfg.Database.Port, cfg.Database.User,
 		cfg.Database.Password, cfg.Database.Name, cfg.Database.SSLMode, cfg.Database.SSLRootCert)
 
@@ -163,15 +244,48 @@ func main() {
 	adminKubeconfigRequest := gardenerClient.SubResource("adminkubeconfig")
 	kubeconfigProvider := gardener.NewKubeconfigProvider(shootClient, adminKubeconfigRequest, secretsInterface)
 
-	provisioningQueue := queue.CreateProvisioningQueue(cfg.ProvisioningTimeout, dbsFactory, shootClient, cfg.OperatorRoleBinding, k8sClientProvider, kubeconfigProvider)
-	shootUpgradeQueue := queue.CreateShootUpgradeQueue(cfg.ProvisioningTimeout, dbsFactory, shootClient, cfg.OperatorRoleBinding, k8sClientProvider, kubeconfigProvider)
-	deprovisioningQueue := queue.CreateDeprovisioningQueue(cfg.DeprovisioningTimeout, dbsFactory, shootClient)
+	// Events are published with Postgres NOTIFY so that subscribers connected to any replica receive them
+	eventPublisher := events.NewPostgresPublisher(connection)
+	eventBroker := events.NewBroker()
+
+	// Leases stored in the database make sure that every operation is processed by single replica
+	owner := lease.NewOwnerID(uuid.NewUUIDGenerator())
+	leaseManager := lease.NewNoopManager()
+	useLeases := cfg.Leases.Enabled && !cfg.Queues.Durable
+	if useLeases {
+		log.Infof("Processing operations with leases owned by %s", owner)
+		leaseManager = lease.NewManager(dbsFactory.NewWriteSession(), owner, cfg.Leases)
+	}
+
+	operationsCollector := metrics.NewOperationsCollector()
+
+	var queueBackend queue.Backend = queue.NewInMemoryBackend(leaseManager)
+	if cfg.Queues.Durable {
+		log.Infof("Using durable operation queues with claims owned by %s", owner)
+		queueBackend = queue.NewPostgresBackend(dbsFactory, owner, cfg.Queues.PollInterval, cfg.Queues.ClaimTimeout)
+	}
+
+	provisioningQueue := queue.CreateProvisioningQueue(cfg.Queues.Provisioning, cfg.ProvisioningTimeout, dbsFactory, shootClient, cfg.OperatorRoleBinding, k8sClientProvider, kubeconfigProvider, cfg.Gardener.DeleteShootOnProvisioningFailure, eventPublisher, operationsCollector, queueBackend)
+	shootUpgradeQueue := queue.CreateShootUpgradeQueue(cfg.Queues.ShootUpgrade, cfg.ProvisioningTimeout, dbsFactory, shootClient, cfg.OperatorRoleBinding, k8sClientProvider, kubeconfigProvider, eventPublisher, operationsCollector, queueBackend)
+	deprovisioningQueue := queue.CreateDeprovisioningQueue(cfg.Queues.Deprovisioning, cfg.DeprovisioningTimeout, dbsFactory, shootClient, eventPublisher, operationsCollector, queueBackend)
+	hibernationQueue := queue.CreateHibernationQueue(cfg.Queues.Hibernation, cfg.HibernationTimeout, dbsFactory, shootClient, eventPublisher, operationsCollector, queueBackend)
+	wakeUpQueue := queue.CreateWakeUpQueue(cfg.Queues.WakeUp, cfg.HibernationTimeout, dbsFactory, shootClient, eventPublisher, operationsCollector, queueBackend)
 
 	provisioner := gardener.NewProvisioner(gardenerNamespace, shootClient, dbsFactory, cfg.Gardener.AuditLogsPolicyConfigMap, cfg.Gardener.MaintenanceWindowConfigPath, testDataWriter)
-	shootController, err := newShootController(gardenerNamespace, gardenerClusterConfig, dbsFactory, cfg.Gardener.AuditLogsTenantConfigPath)
+	driftPolicies, err := gardener.ParseDriftPolicies(cfg.Gardener.ShootDriftPolicies)
+	exitOnError(err, "Failed to parse Shoot drift policies.")
+
+	shootController, err := newShootController(gardenerNamespace, gardenerClusterConfig, dbsFactory, cfg.Gardener.AuditLogsTenantConfigPath, driftPolicies)
 	exitOnError(err, "Failed to create Shoot controller.")
+
+	// Context is cancelled on SIGTERM or when API server fails, which starts graceful shutdown
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, os.Interrupt)
+	defer stop()
+
+	shootControllerStopped := make(chan struct{})
 	go func() {
-		err := shootController.StartShootController()
+		defer close(shootControllerStopped)
+		err := shootController.StartShootController(ctx)
 		exitOnError(err, "Failed to start Shoot Controller")
 	}()
 
@@ -183,18 +297,33 @@ func main() {
 		provisioningQueue,
 		deprovisioningQueue,
 		shootUpgradeQueue,
+		hibernationQueue,
+		wakeUpQueue,
 		cfg.Gardener.DefaultEnableKubernetesVersionAutoUpdate,
 		cfg.Gardener.DefaultEnableMachineImageVersionAutoUpdate,
 		cfg.Gardener.DefaultEnableIMDSv2,
 		kubeconfigProvider,
+		cfg.Quotas,
+		eventPublisher,
 	)
 
 	tenantUpdater := api.NewTenantUpdater(dbsFactory.NewReadWriteSession())
-	validator := api.NewValidator()
-	resolver := api.NewResolver(provisioningSVC, validator, tenantUpdater, testDataWriter)
+	idempotencyGuard := api.NewIdempotencyGuard(dbsFactory.NewReadWriteSession(), cfg.IdempotencyKeyReservationTTL)
+	validator := api.NewValidator(dbsFactory.NewReadSession())
+	resolver := api.NewResolver(provisioningSVC, validator, tenantUpdater, testDataWriter, eventBroker, idempotencyGuard)
 
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+	go func() {
+		err := events.NewPostgresListener(connString, eventBroker).Run(ctx)
+		exitOnError(err, "Failed to listen for operation events")
+	}()
+
+	// Leases are renewed until operation queues are drained at shutdown, otherwise other replicas could take over operations still being processed
+	stopLeaseRenewal := make(chan struct{})
+	leaseRenewalStopped := make(chan struct{})
+	go func() {
+		defer close(leaseRenewalStopped)
+		leaseManager.Run(stopLeaseRenewal)
+	}()
 
 	provisioningQueue.Run(ctx.Done())
 
@@ -202,6 +331,10 @@ func main() {
 
 	shootUpgradeQueue.Run(ctx.Done())
 
+	hibernationQueue.Run(ctx.Done())
+
+	wakeUpQueue.Run(ctx.Done())
+
 	gqlCfg := gqlschema.Config{
 		Resolvers: resolver,
 	}
@@ -212,24 +345,54 @@ func main() {
 	log.Infof("Registering endpoint on %s...", cfg.APIEndpoint)
 	router := mux.NewRouter()
 	router.Use(middlewares.ExtractTenant)
+	router.Use(middlewares.ExtractIdempotencyKey)
 
 	router.HandleFunc("/", playground.Handler("Dataloader", cfg.PlaygroundAPIEndpoint))
 
 	gqlHandler := handler.New(executableSchema)
 	gqlHandler.AddTransport(transport.POST{})
 	gqlHandler.AddTransport(transport.GET{})
+	gqlHandler.AddTransport(transport.Websocket{KeepAlivePingInterval: websocketKeepAliveInterval})
 	gqlHandler.Use(extension.Introspection{})
+	gqlHandler.Use(tracing.GraphQLTracer{})
+
+	var apiHandler http.Handler = gqlHandler
+	if cfg.RateLimit.Enabled {
+		gqlHandler.Use(ratelimit.NewMutationLimiter(cfg.RateLimit))
+		apiHandler = ratelimit.NewRequestLimiter(cfg.RateLimit)(apiHandler)
+	}
+	if cfg.Auth.Enabled {
+		keySource, err := auth.NewKeySource(cfg.Auth)
+		exitOnError(err, "Failed to initialize token verification keys")
+
+		gqlHandler.Use(auth.NewScopeEnforcer(cfg.Auth))
+		// Tenant used for rate limiting is taken from the token so authentication has to happen first
+		apiHandler = auth.NewAuthenticator(keySource, cfg.Auth).Middleware(apiHandler)
+	}
+
+	// Trace context sent by the client is used as the parent of the spans started by the Provisioner
+	apiHandler = otelhttp.NewHandler(apiHandler, "graphql")
+
 	gqlHandler.SetErrorPresenter(presenter.Do)
-	router.Handle(cfg.APIEndpoint, gqlHandler)
+	router.Handle(cfg.APIEndpoint, apiHandler)
 	router.HandleFunc("/healthz", healthz.NewHTTPHandler(log.StandardLogger()))
 
+	readinessCheckers := newReadinessCheckers(dbsFactory, shootClient, shootController, map[string]queue.OperationQueue{
+		"provisioning":   provisioningQueue,
+		"deprovisioning": deprovisioningQueue,
+		"shootUpgrade":   shootUpgradeQueue,
+		"hibernation":    hibernationQueue,
+		"wakeUp":         wakeUpQueue,
+	})
+	router.HandleFunc("/readyz", healthz.NewReadinessHandler(log.StandardLogger(), cfg.ReadinessTimeout, readinessCheckers...))
+
 	// Metrics
-	err = metrics.Register(dbsFactory.NewReadSession())
+	err = metrics.Register(dbsFactory.NewReadSession(), operationsCollector)
 	exitOnError(err, "Failed to register metrics collectors")
 
 	// Expose metrics on different port as it cannot be secured with mTLS
 	metricsRouter := mux.NewRouter()
-	metricsRouter.Handle("/metrics", promhttp.Handler())
+	metricsRouter.Handle("/metrics", metrics.Handler())
 
 	metricsServer := &http.Server{
 		Handler: metricsRouter,
@@ -239,32 +402,88 @@ func main() {
 	log.Infof("API listening on %s...", cfg.Address)
 	log.Infof("Metrics API listening on %s...", cfg.MetricsAddress)
 
-	wg := &sync.WaitGroup{}
-	wg.Add(1)
+	// Subscriptions are served over hijacked connections which are not closed by the server shutdown, they are closed when the base context is cancelled
+	apiCtx, cancelAPI := context.WithCancel(context.Background())
+	defer cancelAPI()
 
-	go func() {
-		defer wg.Done()
+	apiServer := &http.Server{
+		Handler:     router,
+		Addr:        cfg.Address,
+		BaseContext: func(net.Listener) context.Context { return apiCtx },
+	}
 
-		if err := http.ListenAndServe(cfg.Address, router); err != nil {
+	go func() {
+		if err := apiServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 			log.Errorf("Error starting server: %s", err.Error())
+			stop()
 		}
 	}()
 
 	go func() {
-		if err := metricsServer.ListenAndServe(); err != nil {
+		if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 			log.Errorf("Error starting metrics server: %s", err.Error())
 		}
 	}()
 
+	// Durable queues keep the schedule of operations which are already queued, only the missing ones are added
 	if cfg.EnqueueInProgressOperations {
-		err = enqueueOperationsInProgress(dbsFactory, provisioningQueue, deprovisioningQueue, shootUpgradeQueue)
+		err = enqueueOperationsInProgress(dbsFactory, provisioningQueue, deprovisioningQueue, shootUpgradeQueue, hibernationQueue, wakeUpQueue)
 		exitOnError(err, "Failed to enqueue in progress operations")
 	}
 
-	wg.Wait()
+	if useLeases {
+		// Operations of replicas which stopped renewing their leases are picked up after the leases expire
+		go resyncOperationsInProgress(dbsFactory, owner, cfg.Leases.ResyncInterval, ctx.Done(), provisioningQueue, deprovisioningQueue, shootUpgradeQueue, hibernationQueue, wakeUpQueue)
+	}
+
+	<-ctx.Done()
+	log.Infof("Shutting down Provisioner, waiting up to %s for operations in progress", cfg.ShutdownTimeout)
+
+	shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancelShutdown()
+
+	// API is stopped first so that no new operations are started
+	if err := apiServer.Shutdown(shutdownCtx); err != nil {
+		log.Warnf("Failed to shut down API server: %s", err.Error())
+	}
+	cancelAPI()
+
+	drained := true
+	for _, operationQueue := range []queue.OperationQueue{provisioningQueue, deprovisioningQueue, shootUpgradeQueue, hibernationQueue, wakeUpQueue} {
+		if err := operationQueue.Shutdown(shutdownCtx); err != nil {
+			log.Warnf("Operations in progress were not finished before shutdown: %s", err.Error())
+			drained = false
+		}
+	}
+
+	close(stopLeaseRenewal)
+	<-leaseRenewalStopped
+
+	// Leases of operations still being processed are left to expire so that they are not processed twice
+	if drained {
+		leaseManager.ReleaseAll()
+	}
+
+	select {
+	case <-shootControllerStopped:
+	case <-shutdownCtx.Done():
+		log.Warnf("Shoot controller was not stopped before shutdown")
+	}
+
+	if err := metricsServer.Shutdown(shutdownCtx); err != nil {
+		log.Warnf("Failed to shut down metrics server: %s", err.Error())
+	}
+
+	if tracerProvider != nil {
+		if err := tracerProvider.Shutdown(shutdownCtx); err != nil {
+			log.Warnf("Failed to flush spans: %s", err.Error())
+		}
+	}
+
+	log.Infof("Provisioner stopped")
 }
 
-func enqueueOperationsInProgress(dbFactory dbsession.Factory, provisioningQueue, deprovisioningQueue, shootUpgradeQueue queue.OperationQueue) error {
+func enqueueOperationsInProgress(dbFactory dbsession.Factory, provisioningQueue, deprovisioningQueue, shootUpgradeQueue, hibernationQueue, wakeUpQueue queue.OperationQueue) error {
 	readSession := dbFactory.NewReadSession()
 
 	var inProgressOps []model.Operation
@@ -284,18 +503,42 @@ func enqueueOperationsInProgress(dbFactory dbsession.Factory, provisioningQueue,
 		return fmt.Errorf("error enqueuing in progress operations: %s", err.Error())
 	}
 
+	addToQueues(inProgressOps, provisioningQueue, deprovisioningQueue, shootUpgradeQueue, hibernationQueue, wakeUpQueue)
+
+	return nil
+}
+
+// resyncOperationsInProgress adds operations which are not processed by any replica, operations waiting in the queue of the replica keep their delays
+func resyncOperationsInProgress(dbFactory dbsession.Factory, owner string, interval time.Duration, stop <-chan struct{}, provisioningQueue, deprovisioningQueue, shootUpgradeQueue, hibernationQueue, wakeUpQueue queue.OperationQueue) {
+	readSession := dbFactory.NewReadSession()
+
+	wait.Until(func() {
+		inProgressOps, err := readSession.ListInProgressOperationsWithoutLease(owner)
+		if err != nil {
+			log.Errorf("Failed to list in progress operations without lease: %s", err.Error())
+			return
+		}
+
+		addToQueues(inProgressOps, provisioningQueue, deprovisioningQueue, shootUpgradeQueue, hibernationQueue, wakeUpQueue)
+	}, interval, stop)
+}
+
+func addToQueues(inProgressOps []model.Operation, provisioningQueue, deprovisioningQueue, shootUpgradeQueue, hibernationQueue, wakeUpQueue queue.OperationQueue) {
 	for _, op := range inProgressOps {
 		switch op.Type {
-		case model.ProvisionNoInstall:
-			provisioningQueue.Add(op.ID)
+		// Provisioning operations are created with Provision type, ProvisionNoInstall is kept for operations stored by older versions
+		case model.Provision, model.ProvisionNoInstall:
+			provisioningQueue.Add(op.ID, op.Priority)
 		case model.DeprovisionNoInstall:
-			deprovisioningQueue.Add(op.ID)
+			deprovisioningQueue.Add(op.ID, op.Priority)
 		case model.UpgradeShoot:
-			shootUpgradeQueue.Add(op.ID)
+			shootUpgradeQueue.Add(op.ID, op.Priority)
+		case model.Hibernate:
+			hibernationQueue.Add(op.ID, op.Priority)
+		case model.WakeUp:
+			wakeUpQueue.Add(op.ID, op.Priority)
 		}
 	}
-
-	return nil
 }
 
 func exitOnError(err error, context string) {
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/mocks"
+	dbMocks "github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes"
+)
+
+func Test_addToQueues(t *testing.T) {
+	// given
+	provisioningQueue := &mocks.OperationQueue{}
+	deprovisioningQueue := &mocks.OperationQueue{}
+	shootUpgradeQueue := &mocks.OperationQueue{}
+	hibernationQueue := &mocks.OperationQueue{}
+	wakeUpQueue := &mocks.OperationQueue{}
+
+	operations := []model.Operation{
+		{ID: "provision", Type: model.Provision, Priority: model.HighPriority},
+		{ID: "provision-no-install", Type: model.ProvisionNoInstall, Priority: model.NormalPriority},
+		{ID: "deprovision", Type: model.DeprovisionNoInstall, Priority: model.NormalPriority},
+		{ID: "upgrade-shoot", Type: model.UpgradeShoot, Priority: model.NormalPriority},
+		{ID: "hibernate", Type: model.Hibernate, Priority: model.NormalPriority},
+		{ID: "wake-up", Type: model.WakeUp, Priority: model.NormalPriority},
+		{ID: "upgrade", Type: model.Upgrade, Priority: model.NormalPriority},
+	}
+
+	provisioningQueue.On("Add", "provision", model.HighPriority).Return()
+	provisioningQueue.On("Add", "provision-no-install", model.NormalPriority).Return()
+	deprovisioningQueue.On("Add", "deprovision", model.NormalPriority).Return()
+	shootUpgradeQueue.On("Add", "upgrade-shoot", model.NormalPriority).Return()
+	hibernationQueue.On("Add", "hibernate", model.NormalPriority).Return()
+	wakeUpQueue.On("Add", "wake-up", model.NormalPriority).Return()
+
+	// when
+	addToQueues(operations, provisioningQueue, deprovisioningQueue, shootUpgradeQueue, hibernationQueue, wakeUpQueue)
+
+	// then
+	for _, operationQueue := range []*mocks.OperationQueue{provisioningQueue, deprovisioningQueue, shootUpgradeQueue, hibernationQueue, wakeUpQueue} {
+		operationQueue.AssertExpectations(t)
+		operationQueue.AssertNotCalled(t, "Add", "upgrade", mock.Anything)
+	}
+}
+
+func Test_resyncOperationsInProgress(t *testing.T) {
+	// given
+	provisioningQueue := &mocks.OperationQueue{}
+	otherQueue := &mocks.OperationQueue{}
+
+	readSession := &dbMocks.ReadSession{}
+	readSession.On("ListInProgressOperationsWithoutLease", "replica-1").Return([]model.Operation{
+		{ID: "provision", Type: model.Provision, Priority: model.NormalPriority},
+	}, nil)
+
+	factory := &dbMocks.Factory{}
+	factory.On("NewReadSession").Return(readSession)
+
+	stop := make(chan struct{})
+	provisioningQueue.On("Add", "provision", model.NormalPriority).Run(func(args mock.Arguments) {
+		close(stop)
+	}).Return()
+
+	// when
+	resyncOperationsInProgress(factory, "replica-1", time.Minute, stop, provisioningQueue, otherQueue, otherQueue, otherQueue, otherQueue)
+
+	// then
+	readSession.AssertExpectations(t)
+	provisioningQueue.AssertExpectations(t)
+	otherQueue.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+}
+
+func Test_newGardenerClusterConfig(t *testing.T) {
+	// given
+	kubeconfigPath := filepath.Join(t.TempDir(), "kubeconfig.yaml")
+	err := os.WriteFile(kubeconfigPath, []byte(`apiVersion: v1
+kind: Config
+clusters:
+- name: gardener
+  cluster:
+    server: https://gardener.example.com
+contexts:
+- name: gardener
+  context:
+    cluster: gardener
+    user: gardener
+current-context: gardener
+users:
+- name: gardener
+  user:
+    token: token
+`), 0600)
+	require.NoError(t, err)
+
+	var cfg config
+	cfg.Gardener.KubeconfigPath = kubeconfigPath
+	cfg.Gardener.QPS = 5
+	cfg.Gardener.Burst = 10
+
+	// when
+	gardenerClusterConfig, err := newGardenerClusterConfig(cfg)
+
+	// then
+	require.NoError(t, err)
+	require.NotNil(t, gardenerClusterConfig.RateLimiter)
+	assert.Equal(t, float32(5), gardenerClusterConfig.RateLimiter.QPS())
+
+	clientSet, err := kubernetes.NewForConfig(gardenerClusterConfig)
+	require.NoError(t, err)
+	assert.Same(t, gardenerClusterConfig.RateLimiter, clientSet.CoreV1().RESTClient().GetRateLimiter())
+}
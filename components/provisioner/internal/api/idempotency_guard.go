@@ -0,0 +1,130 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/api/middlewares"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+	log "github.com/sirupsen/logrus"
+)
+
+const maxIdempotencyKeyLength = 256
+
+type IdempotencyGuard interface {
+	// StartOnce starts the operation unless it was already started with the idempotency key provided in the context,
+	// ID of the operation is returned in both cases together with the flag indicating if the operation was started by this call.
+	// The operation has to be started with the context passed to the start function, so that it is linked to the key in the same transaction
+	StartOnce(ctx context.Context, mutation string, payload interface{}, start func(ctx context.Context) (string, apperrors.AppError)) (string, bool, apperrors.AppError)
+}
+
+type idempotencyGuard struct {
+	readWriteSession dbsession.ReadWriteSession
+	// reservationTTL frees keys of requests which failed before their operation was stored, e.g. when the replica was killed
+	reservationTTL time.Duration
+}
+
+func NewIdempotencyGuard(readWriteSession dbsession.ReadWriteSession, reservationTTL time.Duration) IdempotencyGuard {
+	return &idempotencyGuard{
+		readWriteSession: readWriteSession,
+		reservationTTL:   reservationTTL,
+	}
+}
+
+func (g *idempotencyGuard) StartOnce(ctx context.Context, mutation string, payload interface{}, start func(ctx context.Context) (string, apperrors.AppError)) (string, bool, apperrors.AppError) {
+	key, ok := ctx.Value(middlewares.IdempotencyKey).(string)
+	if !ok || key == "" {
+		operationID, err := start(ctx)
+		if err != nil {
+			return "", false, err
+		}
+		return operationID, true, nil
+	}
+
+	if len(key) > maxIdempotencyKeyLength {
+		return "", false, apperrors.BadRequest("idempotency key cannot be longer than %d characters", maxIdempotencyKeyLength)
+	}
+
+	// Keys are scoped to the tenant so that tenants cannot see or block requests of each other
+	tenant, _ := ctx.Value(middlewares.Tenant).(string)
+
+	payloadHash, hashErr := hashPayload(mutation, payload)
+	if hashErr != nil {
+		return "", false, apperrors.Internal("Failed to hash request payload: %s", hashErr.Error())
+	}
+
+	now := time.Now()
+	dberr := g.readWriteSession.InsertIdempotencyKey(model.IdempotencyKey{
+		Tenant:      tenant,
+		Key:         key,
+		PayloadHash: payloadHash,
+		CreatedAt:   now,
+	}, now.Add(-g.reservationTTL))
+	if dberr != nil {
+		if dberr.Code() == dberrors.CodeAlreadyExists {
+			operationID, err := g.startedOperation(tenant, key, payloadHash)
+			if err != nil {
+				return "", false, err
+			}
+			return operationID, false, nil
+		}
+		return "", false, dberr
+	}
+
+	operationID, err := start(provisioning.WithIdempotencyKey(ctx, tenant, key))
+	if err != nil {
+		// Key is released so that the request can be retried
+		dberr := g.readWriteSession.DeleteIdempotencyKey(tenant, key)
+		if dberr != nil {
+			log.Errorf("Failed to release idempotency key %s: %s", key, dberr.Error())
+		}
+		return "", false, err
+	}
+
+	return operationID, true, nil
+}
+
+func (g *idempotencyGuard) startedOperation(tenant, key, payloadHash string) (string, apperrors.AppError) {
+	idempotencyKey, dberr := g.readWriteSession.GetIdempotencyKey(tenant, key)
+	if dberr != nil {
+		if dberr.Code() == dberrors.CodeNotFound {
+			return "", apperrors.Conflict("request with idempotency key %s is being processed", key)
+		}
+		return "", dberr
+	}
+
+	if idempotencyKey.PayloadHash != payloadHash {
+		return "", apperrors.Conflict("idempotency key %s was already used for a different request", key)
+	}
+
+	if idempotencyKey.OperationID == nil {
+		return "", apperrors.Conflict("request with idempotency key %s is being processed", key)
+	}
+
+	return *idempotencyKey.OperationID, nil
+}
+
+// hashPayload identifies the request made with the key, keys used by different tenants are stored separately
+func hashPayload(mutation string, payload interface{}) (string, error) {
+	data, err := json.Marshal(struct {
+		Mutation string
+		Payload  interface{}
+	}{
+		Mutation: mutation,
+		Payload:  payload,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(data)
+
+	return hex.EncodeToString(hash[:]), nil
+}
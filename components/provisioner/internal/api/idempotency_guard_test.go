@@ -0,0 +1,191 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/api/middlewares"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdempotencyGuard_StartOnce(t *testing.T) {
+	key := "idempotency-key"
+	reservationTTL := 10 * time.Minute
+	operationID := "operation-id"
+	payload := "runtime-id"
+
+	ctx := context.WithValue(context.Background(), middlewares.Tenant, "tenant")
+	ctxWithKey := context.WithValue(ctx, middlewares.IdempotencyKey, key)
+
+	payloadHash, err := hashPayload("hibernateRuntime", payload)
+	require.NoError(t, err)
+
+	startFunc := func(called *bool) func(context.Context) (string, apperrors.AppError) {
+		return func(context.Context) (string, apperrors.AppError) {
+			*called = true
+			return operationID, nil
+		}
+	}
+
+	t.Run("should start operation when idempotency key is not provided", func(t *testing.T) {
+		// given
+		guard := NewIdempotencyGuard(nil, reservationTTL)
+		called := false
+
+		// when
+		id, started, err := guard.StartOnce(ctx, "hibernateRuntime", payload, startFunc(&called))
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, called)
+		assert.True(t, started)
+		assert.Equal(t, operationID, id)
+	})
+
+	t.Run("should start operation with idempotency key reserved", func(t *testing.T) {
+		// given
+		rwsMock := &mocks.ReadWriteSession{}
+		rwsMock.On("InsertIdempotencyKey", mock.MatchedBy(func(idempotencyKey model.IdempotencyKey) bool {
+			return idempotencyKey.Tenant == "tenant" && idempotencyKey.Key == key && idempotencyKey.PayloadHash == payloadHash && idempotencyKey.OperationID == nil
+		}), mock.MatchedBy(func(staleBefore time.Time) bool {
+			return staleBefore.Before(time.Now().Add(-reservationTTL + time.Minute))
+		})).Return(nil)
+
+		guard := NewIdempotencyGuard(rwsMock, reservationTTL)
+		called := false
+
+		// when
+		id, started, err := guard.StartOnce(ctxWithKey, "hibernateRuntime", payload, startFunc(&called))
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, called)
+		assert.True(t, started)
+		assert.Equal(t, operationID, id)
+		rwsMock.AssertExpectations(t)
+	})
+
+	t.Run("should return operation started earlier for repeated request", func(t *testing.T) {
+		// given
+		rwsMock := &mocks.ReadWriteSession{}
+		rwsMock.On("InsertIdempotencyKey", mock.AnythingOfType("model.IdempotencyKey"), mock.AnythingOfType("time.Time")).Return(dberrors.AlreadyExists("exists"))
+		rwsMock.On("GetIdempotencyKey", "tenant", key).Return(model.IdempotencyKey{Key: key, PayloadHash: payloadHash, OperationID: util.PtrTo(operationID)}, nil)
+
+		guard := NewIdempotencyGuard(rwsMock, reservationTTL)
+		called := false
+
+		// when
+		id, started, err := guard.StartOnce(ctxWithKey, "hibernateRuntime", payload, startFunc(&called))
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, called)
+		assert.False(t, started)
+		assert.Equal(t, operationID, id)
+	})
+
+	t.Run("should start operation when idempotency key was used by other tenant", func(t *testing.T) {
+		// given
+		otherTenantCtx := context.WithValue(context.WithValue(context.Background(), middlewares.Tenant, "other-tenant"), middlewares.IdempotencyKey, key)
+
+		rwsMock := &mocks.ReadWriteSession{}
+		rwsMock.On("InsertIdempotencyKey", mock.MatchedBy(func(idempotencyKey model.IdempotencyKey) bool {
+			return idempotencyKey.Tenant == "other-tenant" && idempotencyKey.Key == key
+		}), mock.AnythingOfType("time.Time")).Return(nil)
+
+		guard := NewIdempotencyGuard(rwsMock, reservationTTL)
+		called := false
+
+		// when
+		id, started, err := guard.StartOnce(otherTenantCtx, "hibernateRuntime", payload, startFunc(&called))
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, called)
+		assert.True(t, started)
+		assert.Equal(t, operationID, id)
+		rwsMock.AssertExpectations(t)
+	})
+
+	t.Run("should return conflict when idempotency key was used for different request", func(t *testing.T) {
+		// given
+		rwsMock := &mocks.ReadWriteSession{}
+		rwsMock.On("InsertIdempotencyKey", mock.AnythingOfType("model.IdempotencyKey"), mock.AnythingOfType("time.Time")).Return(dberrors.AlreadyExists("exists"))
+		rwsMock.On("GetIdempotencyKey", "tenant", key).Return(model.IdempotencyKey{Key: key, PayloadHash: "other", OperationID: util.PtrTo(operationID)}, nil)
+
+		guard := NewIdempotencyGuard(rwsMock, reservationTTL)
+		called := false
+
+		// when
+		_, _, err := guard.StartOnce(ctxWithKey, "hibernateRuntime", payload, startFunc(&called))
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeConflict, err.Code())
+		assert.False(t, called)
+	})
+
+	t.Run("should return conflict when request with the same key is being processed", func(t *testing.T) {
+		// given
+		rwsMock := &mocks.ReadWriteSession{}
+		rwsMock.On("InsertIdempotencyKey", mock.AnythingOfType("model.IdempotencyKey"), mock.AnythingOfType("time.Time")).Return(dberrors.AlreadyExists("exists"))
+		rwsMock.On("GetIdempotencyKey", "tenant", key).Return(model.IdempotencyKey{Key: key, PayloadHash: payloadHash}, nil)
+
+		guard := NewIdempotencyGuard(rwsMock, reservationTTL)
+		called := false
+
+		// when
+		_, _, err := guard.StartOnce(ctxWithKey, "hibernateRuntime", payload, startFunc(&called))
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeConflict, err.Code())
+		assert.False(t, called)
+	})
+
+	t.Run("should release idempotency key when failed to start operation", func(t *testing.T) {
+		// given
+		rwsMock := &mocks.ReadWriteSession{}
+		rwsMock.On("InsertIdempotencyKey", mock.AnythingOfType("model.IdempotencyKey"), mock.AnythingOfType("time.Time")).Return(nil)
+		rwsMock.On("DeleteIdempotencyKey", "tenant", key).Return(nil)
+
+		guard := NewIdempotencyGuard(rwsMock, reservationTTL)
+
+		// when
+		_, started, err := guard.StartOnce(ctxWithKey, "hibernateRuntime", payload, func(context.Context) (string, apperrors.AppError) {
+			return "", apperrors.BadRequest("Runtime is already hibernated")
+		})
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeBadRequest, err.Code())
+		assert.False(t, started)
+		rwsMock.AssertExpectations(t)
+	})
+}
+
+func Test_hashPayload(t *testing.T) {
+	hash, err := hashPayload("hibernateRuntime", "runtime-id")
+	require.NoError(t, err)
+
+	sameHash, err := hashPayload("hibernateRuntime", "runtime-id")
+	require.NoError(t, err)
+	assert.Equal(t, hash, sameHash)
+
+	for _, otherHash := range []func() (string, error){
+		func() (string, error) { return hashPayload("wakeUpRuntime", "runtime-id") },
+		func() (string, error) { return hashPayload("hibernateRuntime", "other-runtime-id") },
+	} {
+		other, err := otherHash()
+		require.NoError(t, err)
+		assert.NotEqual(t, hash, other)
+	}
+}
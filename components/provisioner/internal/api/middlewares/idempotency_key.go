@@ -0,0 +1,21 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+)
+
+const IdempotencyKey Header = "Idempotency-Key"
+
+func ExtractIdempotencyKey(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		idempotencyKey := r.Header.Get(string(IdempotencyKey))
+		if idempotencyKey != "" {
+			ctx = context.WithValue(ctx, IdempotencyKey, idempotencyKey)
+		}
+
+		handler.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -12,6 +12,11 @@ const (
 	SubAccountID Header = "sub-account"
 )
 
+type contextKey string
+
+// Admin is set by the authenticator for callers with the admin scope, it is never taken from the request headers
+const Admin contextKey = "admin"
+
 func ExtractTenant(handler http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
@@ -15,6 +15,22 @@ type TenantUpdater struct {
 	mock.Mock
 }
 
+// CheckTenant provides a mock function with given fields: runtimeID, ctx
+func (_m *TenantUpdater) CheckTenant(runtimeID string, ctx context.Context) apperrors.AppError {
+	ret := _m.Called(runtimeID, ctx)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, context.Context) apperrors.AppError); ok {
+		r0 = rf(runtimeID, ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // GetAndUpdateTenant provides a mock function with given fields: runtimeID, ctx
 func (_m *TenantUpdater) GetAndUpdateTenant(runtimeID string, ctx context.Context) apperrors.AppError {
 	ret := _m.Called(runtimeID, ctx)
@@ -30,13 +30,13 @@ func (_m *Validator) ValidateProvisioningInput(input gqlschema.ProvisionRuntimeI
 	return r0
 }
 
-// ValidateUpgradeShootInput provides a mock function with given fields: input
-func (_m *Validator) ValidateUpgradeShootInput(input gqlschema.UpgradeShootInput) apperrors.AppError {
-	ret := _m.Called(input)
+// ValidateUpgradeShootInput provides a mock function with given fields: runtimeID, input
+func (_m *Validator) ValidateUpgradeShootInput(runtimeID string, input gqlschema.UpgradeShootInput) apperrors.AppError {
+	ret := _m.Called(runtimeID, input)
 
 	var r0 apperrors.AppError
-	if rf, ok := ret.Get(0).(func(gqlschema.UpgradeShootInput) apperrors.AppError); ok {
-		r0 = rf(input)
+	if rf, ok := ret.Get(0).(func(string, gqlschema.UpgradeShootInput) apperrors.AppError); ok {
+		r0 = rf(runtimeID, input)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(apperrors.AppError)
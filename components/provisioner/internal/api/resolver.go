@@ -4,19 +4,24 @@ import (
 	"context"
 	"fmt"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/api/middlewares"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
 	"github.com/pkg/errors"
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/kyma-project/control-plane/components/provisioner/internal/events"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 )
 
 type Resolver struct {
-	provisioning   provisioning.Service
-	validator      Validator
-	tenantUpdater  TenantUpdater
-	testDataWriter InputDataWriter
+	provisioning     provisioning.Service
+	validator        Validator
+	tenantUpdater    TenantUpdater
+	testDataWriter   InputDataWriter
+	subscriber       events.Subscriber
+	idempotencyGuard IdempotencyGuard
 }
 
 type InputDataWriter interface {
@@ -26,31 +31,47 @@ type InputDataWriter interface {
 
 func (r *Resolver) Mutation() gqlschema.MutationResolver {
 	return &Resolver{
-		provisioning:   r.provisioning,
-		validator:      r.validator,
-		tenantUpdater:  r.tenantUpdater,
-		testDataWriter: r.testDataWriter,
+		provisioning:     r.provisioning,
+		validator:        r.validator,
+		tenantUpdater:    r.tenantUpdater,
+		testDataWriter:   r.testDataWriter,
+		subscriber:       r.subscriber,
+		idempotencyGuard: r.idempotencyGuard,
+	}
+}
+func (r *Resolver) Subscription() gqlschema.SubscriptionResolver {
+	return &Resolver{
+		provisioning:     r.provisioning,
+		validator:        r.validator,
+		tenantUpdater:    r.tenantUpdater,
+		testDataWriter:   r.testDataWriter,
+		subscriber:       r.subscriber,
+		idempotencyGuard: r.idempotencyGuard,
 	}
 }
 func (r *Resolver) Query() gqlschema.QueryResolver {
 	return &Resolver{
-		provisioning:   r.provisioning,
-		validator:      r.validator,
-		tenantUpdater:  r.tenantUpdater,
-		testDataWriter: r.testDataWriter,
+		provisioning:     r.provisioning,
+		validator:        r.validator,
+		tenantUpdater:    r.tenantUpdater,
+		testDataWriter:   r.testDataWriter,
+		subscriber:       r.subscriber,
+		idempotencyGuard: r.idempotencyGuard,
 	}
 }
 
-func NewResolver(provisioningService provisioning.Service, validator Validator, tenantUpdater TenantUpdater, testDataWriter InputDataWriter) *Resolver {
+func NewResolver(provisioningService provisioning.Service, validator Validator, tenantUpdater TenantUpdater, testDataWriter InputDataWriter, subscriber events.Subscriber, idempotencyGuard IdempotencyGuard) *Resolver {
 	return &Resolver{
-		provisioning:   provisioningService,
-		validator:      validator,
-		tenantUpdater:  tenantUpdater,
-		testDataWriter: testDataWriter,
+		provisioning:     provisioningService,
+		validator:        validator,
+		tenantUpdater:    tenantUpdater,
+		testDataWriter:   testDataWriter,
+		subscriber:       subscriber,
+		idempotencyGuard: idempotencyGuard,
 	}
 }
 
-func (r *Resolver) ProvisionRuntime(ctx context.Context, config gqlschema.ProvisionRuntimeInput) (*gqlschema.OperationStatus, error) {
+func (r *Resolver) ProvisionRuntime(ctx context.Context, config gqlschema.ProvisionRuntimeInput, dryRun *bool) (*gqlschema.OperationStatus, error) {
 	err := r.validator.ValidateProvisioningInput(config)
 	if err != nil {
 		log.Errorf("Failed to provision Runtime %s", err)
@@ -65,6 +86,18 @@ func (r *Resolver) ProvisionRuntime(ctx context.Context, config gqlschema.Provis
 
 	subAccount := getSubAccount(ctx)
 
+	if util.UnwrapOrZero(dryRun) {
+		log.Infof("Requested dry run of provisioning of Runtime %s.", config.RuntimeInput.Name)
+
+		operationStatus, err := r.provisioning.DryRunProvisionRuntime(ctx, config, tenant, subAccount)
+		if err != nil {
+			log.Errorf("Failed to dry run provisioning of Runtime %s: %s", config.RuntimeInput.Name, err)
+			return nil, err
+		}
+
+		return operationStatus, nil
+	}
+
 	log.Infof("Requested provisioning of Runtime %s.", config.RuntimeInput.Name)
 
 	if r.testDataWriter.Enabled() {
@@ -78,7 +111,9 @@ func (r *Resolver) ProvisionRuntime(ctx context.Context, config gqlschema.Provis
 		}
 	}
 
-	operationStatus, err := r.provisioning.ProvisionRuntime(config, tenant, subAccount)
+	operationStatus, err := r.startOperationOnce(ctx, "provisionRuntime", config, func(ctx context.Context) (*gqlschema.OperationStatus, apperrors.AppError) {
+		return r.provisioning.ProvisionRuntime(ctx, config, tenant, subAccount)
+	})
 	if err != nil {
 		log.Errorf("Failed to provision Runtime %s: %s", config.RuntimeInput.Name, err)
 		return nil, err
@@ -88,7 +123,7 @@ func (r *Resolver) ProvisionRuntime(ctx context.Context, config gqlschema.Provis
 	return operationStatus, nil
 }
 
-func (r *Resolver) DeprovisionRuntime(ctx context.Context, id string) (string, error) {
+func (r *Resolver) DeprovisionRuntime(ctx context.Context, id string, priority *gqlschema.OperationPriority) (string, error) {
 	log.Infof("Requested deprovisioning of Runtime %s.", id)
 
 	err := r.tenantUpdater.GetAndUpdateTenant(id, ctx)
@@ -97,7 +132,9 @@ func (r *Resolver) DeprovisionRuntime(ctx context.Context, id string) (string, e
 		return "", err
 	}
 
-	operationID, err := r.provisioning.DeprovisionRuntime(id)
+	operationID, _, err := r.idempotencyGuard.StartOnce(ctx, "deprovisionRuntime", id, func(ctx context.Context) (string, apperrors.AppError) {
+		return r.provisioning.DeprovisionRuntime(ctx, id, priority)
+	})
 	if err != nil {
 		log.Errorf("Failed to deprovision Runtime %s: %s", id, err)
 		return "", err
@@ -132,7 +169,7 @@ func (r *Resolver) RuntimeStatus(ctx context.Context, runtimeID string) (*gqlsch
 		return nil, err
 	}
 
-	status, err := r.provisioning.RuntimeStatus(runtimeID)
+	status, err := r.provisioning.RuntimeStatus(ctx, runtimeID)
 	if err != nil {
 		log.Errorf("Failed to get status for Runtime %s: %s", runtimeID, err)
 		return nil, err
@@ -145,7 +182,7 @@ func (r *Resolver) RuntimeStatus(ctx context.Context, runtimeID string) (*gqlsch
 func (r *Resolver) RuntimeOperationStatus(ctx context.Context, operationID string) (*gqlschema.OperationStatus, error) {
 	log.Infof("Requested to get Runtime operation status for Operation %s.", operationID)
 
-	status, err := r.provisioning.RuntimeOperationStatus(operationID)
+	status, err := r.provisioning.RuntimeOperationStatus(ctx, operationID)
 	if err != nil {
 		log.Errorf("Failed to get Runtime operation status: %s Operation ID: %s", err, operationID)
 		return nil, err
@@ -162,22 +199,107 @@ func (r *Resolver) RuntimeOperationStatus(ctx context.Context, operationID strin
 	return status, nil
 }
 
-func (r *Resolver) UpgradeShoot(ctx context.Context, runtimeID string, input gqlschema.UpgradeShootInput) (*gqlschema.OperationStatus, error) {
-	log.Infof("Requested to upgrade Gardener Shoot cluster specification for Runtime : %s.", runtimeID)
+func (r *Resolver) ListRuntimes(ctx context.Context, filter *gqlschema.RuntimeFilterInput, first *int, after *string) (*gqlschema.RuntimeSummaryPage, error) {
+	log.Infof("Requested to list Runtimes.")
+
+	// Admins may list Runtimes of any tenant or of all tenants, other callers only Runtimes of their own tenant
+	if admin, _ := ctx.Value(middlewares.Admin).(bool); !admin {
+		tenant, err := r.tenantUpdater.GetTenant(ctx)
+		if err != nil {
+			log.Errorf("Failed to list Runtimes: %s", err)
+			return nil, err
+		}
+
+		filter, err = tenantRuntimeFilter(tenant, filter)
+		if err != nil {
+			log.Errorf("Failed to list Runtimes: %s", err)
+			return nil, err
+		}
+	}
+
+	page, err := r.provisioning.ListRuntimes(ctx, filter, first, after)
+	if err != nil {
+		log.Errorf("Failed to list Runtimes: %s", err)
+		return nil, err
+	}
+
+	log.Infof("Listing Runtimes succeeded, returned %d Runtimes.", len(page.Data))
+
+	return page, nil
+}
+
+// tenantRuntimeFilter restricts the listing to Runtimes of the caller's tenant
+func tenantRuntimeFilter(tenant string, filter *gqlschema.RuntimeFilterInput) (*gqlschema.RuntimeFilterInput, apperrors.AppError) {
+	scoped := gqlschema.RuntimeFilterInput{}
+	if filter != nil {
+		scoped = *filter
+	}
+
+	if scoped.Tenant != nil && *scoped.Tenant != tenant {
+		return nil, apperrors.Forbidden("cannot list Runtimes of tenant %s", *scoped.Tenant)
+	}
+	scoped.Tenant = &tenant
+
+	return &scoped, nil
+}
+
+func (r *Resolver) RuntimeOperations(ctx context.Context, runtimeID string, types []gqlschema.OperationType, states []gqlschema.OperationState, first *int, after *string) (*gqlschema.OperationHistoryPage, error) {
+	log.Infof("Requested to list operations for Runtime %s.", runtimeID)
 
 	err := r.tenantUpdater.GetAndUpdateTenant(runtimeID, ctx)
 	if err != nil {
-		log.Errorf("Failed to upgrade Gardener Shoot cluster specification for Runtime  %s: %s", runtimeID, err)
+		log.Errorf("Failed to list operations for Runtime %s: %s", runtimeID, err)
 		return nil, err
 	}
 
-	err = r.validator.ValidateUpgradeShootInput(input)
+	page, err := r.provisioning.RuntimeOperations(ctx, runtimeID, types, states, first, after)
+	if err != nil {
+		log.Errorf("Failed to list operations for Runtime %s: %s", runtimeID, err)
+		return nil, err
+	}
+
+	log.Infof("Listing operations for Runtime %s succeeded, returned %d operations.", runtimeID, len(page.Data))
+
+	return page, nil
+}
+
+func (r *Resolver) UpgradeShoot(ctx context.Context, runtimeID string, input gqlschema.UpgradeShootInput, dryRun *bool) (*gqlschema.OperationStatus, error) {
+	log.Infof("Requested to upgrade Gardener Shoot cluster specification for Runtime : %s.", runtimeID)
+
+	err := r.validator.ValidateUpgradeShootInput(runtimeID, input)
 	if err != nil {
 		log.Errorf("Failed to upgrade Gardener Shoot cluster specification for Runtime %s", err)
 		return nil, err
 	}
 
-	status, err := r.provisioning.UpgradeGardenerShoot(runtimeID, input)
+	// Dry run does not store anything, so the tenant of the Runtime is only checked
+	if util.UnwrapOrZero(dryRun) {
+		err := r.tenantUpdater.CheckTenant(runtimeID, ctx)
+		if err != nil {
+			log.Errorf("Failed to dry run upgrade of Gardener Shoot cluster specification for Runtime %s: %s", runtimeID, err)
+			return nil, err
+		}
+
+		status, err := r.provisioning.DryRunUpgradeGardenerShoot(ctx, runtimeID, input)
+		if err != nil {
+			log.Errorf("Failed to dry run upgrade of Gardener Shoot cluster specification for Runtime %s: %s", runtimeID, err)
+			return nil, err
+		}
+
+		log.Infof("Dry run of upgrade of Gardener Shoot cluster specification for Runtime %s succeeded", runtimeID)
+
+		return status, nil
+	}
+
+	err = r.tenantUpdater.GetAndUpdateTenant(runtimeID, ctx)
+	if err != nil {
+		log.Errorf("Failed to upgrade Gardener Shoot cluster specification for Runtime  %s: %s", runtimeID, err)
+		return nil, err
+	}
+
+	status, err := r.startOperationOnce(ctx, "upgradeShoot", []interface{}{runtimeID, input}, func(ctx context.Context) (*gqlschema.OperationStatus, apperrors.AppError) {
+		return r.provisioning.UpgradeGardenerShoot(ctx, runtimeID, input)
+	})
 	if err != nil {
 		log.Errorf("Failed to upgrade Gardener Shoot cluster specification for Runtime %s: %s", runtimeID, err)
 		return nil, err
@@ -188,8 +310,125 @@ func (r *Resolver) UpgradeShoot(ctx context.Context, runtimeID string, input gql
 	return status, nil
 }
 
-func (r *Resolver) HibernateRuntime(context.Context, string) (*gqlschema.OperationStatus, error) {
-	return nil, nil
+func (r *Resolver) HibernateRuntime(ctx context.Context, runtimeID string, priority *gqlschema.OperationPriority) (*gqlschema.OperationStatus, error) {
+	log.Infof("Requested to hibernate Runtime : %s.", runtimeID)
+
+	err := r.tenantUpdater.GetAndUpdateTenant(runtimeID, ctx)
+	if err != nil {
+		log.Errorf("Failed to hibernate Runtime %s: %s", runtimeID, err)
+		return nil, err
+	}
+
+	status, err := r.startOperationOnce(ctx, "hibernateRuntime", runtimeID, func(ctx context.Context) (*gqlschema.OperationStatus, apperrors.AppError) {
+		return r.provisioning.HibernateCluster(ctx, runtimeID, priority)
+	})
+	if err != nil {
+		log.Errorf("Failed to hibernate Runtime %s: %s", runtimeID, err)
+		return nil, err
+	}
+
+	log.Infof("Hibernation of Runtime %s started", runtimeID)
+
+	return status, nil
+}
+
+func (r *Resolver) CancelOperation(ctx context.Context, operationID string) (*gqlschema.OperationStatus, error) {
+	log.Infof("Requested to cancel Operation %s.", operationID)
+
+	status, err := r.provisioning.RuntimeOperationStatus(ctx, operationID)
+	if err != nil {
+		log.Errorf("Failed to cancel Operation %s: %s", operationID, err)
+		return nil, err
+	}
+
+	err = r.tenantUpdater.GetAndUpdateTenant(*status.RuntimeID, ctx)
+	if err != nil {
+		log.Errorf("Failed to cancel Operation %s: %s", operationID, err)
+		return nil, err
+	}
+
+	status, err = r.provisioning.CancelOperation(ctx, operationID)
+	if err != nil {
+		log.Errorf("Failed to cancel Operation %s: %s", operationID, err)
+		return nil, err
+	}
+
+	log.Infof("Operation %s cancelled", operationID)
+
+	return status, nil
+}
+
+func (r *Resolver) RetryOperation(ctx context.Context, operationID string) (*gqlschema.OperationStatus, error) {
+	log.Infof("Requested to retry Operation %s.", operationID)
+
+	status, err := r.provisioning.RuntimeOperationStatus(ctx, operationID)
+	if err != nil {
+		log.Errorf("Failed to retry Operation %s: %s", operationID, err)
+		return nil, err
+	}
+
+	err = r.tenantUpdater.GetAndUpdateTenant(*status.RuntimeID, ctx)
+	if err != nil {
+		log.Errorf("Failed to retry Operation %s: %s", operationID, err)
+		return nil, err
+	}
+
+	status, err = r.provisioning.RetryOperation(ctx, operationID)
+	if err != nil {
+		log.Errorf("Failed to retry Operation %s: %s", operationID, err)
+		return nil, err
+	}
+
+	log.Infof("Operation %s retried", operationID)
+
+	return status, nil
+}
+
+func (r *Resolver) WakeUpRuntime(ctx context.Context, runtimeID string, priority *gqlschema.OperationPriority) (*gqlschema.OperationStatus, error) {
+	log.Infof("Requested to wake up Runtime : %s.", runtimeID)
+
+	err := r.tenantUpdater.GetAndUpdateTenant(runtimeID, ctx)
+	if err != nil {
+		log.Errorf("Failed to wake up Runtime %s: %s", runtimeID, err)
+		return nil, err
+	}
+
+	status, err := r.startOperationOnce(ctx, "wakeUpRuntime", runtimeID, func(ctx context.Context) (*gqlschema.OperationStatus, apperrors.AppError) {
+		return r.provisioning.WakeUpCluster(ctx, runtimeID, priority)
+	})
+	if err != nil {
+		log.Errorf("Failed to wake up Runtime %s: %s", runtimeID, err)
+		return nil, err
+	}
+
+	log.Infof("Wake up of Runtime %s started", runtimeID)
+
+	return status, nil
+}
+
+// startOperationOnce returns status of the operation started earlier with the same idempotency key instead of starting a new one
+func (r *Resolver) startOperationOnce(ctx context.Context, mutation string, payload interface{}, start func(ctx context.Context) (*gqlschema.OperationStatus, apperrors.AppError)) (*gqlschema.OperationStatus, apperrors.AppError) {
+	var status *gqlschema.OperationStatus
+
+	operationID, started, err := r.idempotencyGuard.StartOnce(ctx, mutation, payload, func(ctx context.Context) (string, apperrors.AppError) {
+		var err apperrors.AppError
+		status, err = start(ctx)
+		if err != nil {
+			return "", err
+		}
+		return util.UnwrapOrZero(status.ID), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if started {
+		return status, nil
+	}
+
+	log.Infof("Operation %s was already started for the idempotency key, returning its status", operationID)
+
+	return r.provisioning.RuntimeOperationStatus(ctx, operationID)
 }
 
 func getSubAccount(ctx context.Context) string {
@@ -199,3 +438,84 @@ func getSubAccount(ctx context.Context) string {
 	}
 	return subAccount
 }
+
+func (r *Resolver) OperationStatusChanged(ctx context.Context, operationID string) (<-chan *gqlschema.OperationStatus, error) {
+	log.Infof("Requested to subscribe to status changes of Operation %s.", operationID)
+
+	subscriptionCtx, cancel := context.WithCancel(ctx)
+	operationEvents := r.subscriber.Subscribe(subscriptionCtx)
+
+	status, err := r.provisioning.RuntimeOperationStatus(ctx, operationID)
+	if err != nil {
+		cancel()
+		log.Errorf("Failed to subscribe to status changes of Operation %s: %s", operationID, err)
+		return nil, err
+	}
+
+	err = r.tenantUpdater.GetAndUpdateTenant(*status.RuntimeID, ctx)
+	if err != nil {
+		cancel()
+		log.Errorf("Failed to subscribe to status changes of Operation %s: %s", operationID, err)
+		return nil, err
+	}
+
+	return r.forwardOperationStatuses(subscriptionCtx, cancel, operationEvents, status, func(event events.OperationEvent) bool {
+		return event.OperationID == operationID
+	}), nil
+}
+
+func (r *Resolver) RuntimeEvents(ctx context.Context, runtimeID string) (<-chan *gqlschema.OperationStatus, error) {
+	log.Infof("Requested to subscribe to events of Runtime %s.", runtimeID)
+
+	err := r.tenantUpdater.GetAndUpdateTenant(runtimeID, ctx)
+	if err != nil {
+		log.Errorf("Failed to subscribe to events of Runtime %s: %s", runtimeID, err)
+		return nil, err
+	}
+
+	subscriptionCtx, cancel := context.WithCancel(ctx)
+	operationEvents := r.subscriber.Subscribe(subscriptionCtx)
+
+	return r.forwardOperationStatuses(subscriptionCtx, cancel, operationEvents, nil, func(event events.OperationEvent) bool {
+		return event.RuntimeID == runtimeID
+	}), nil
+}
+
+// forwardOperationStatuses sends current status of the operation for every matching event, starting with the initial status if provided
+func (r *Resolver) forwardOperationStatuses(
+	ctx context.Context,
+	cancel context.CancelFunc,
+	operationEvents <-chan events.OperationEvent,
+	initial *gqlschema.OperationStatus,
+	matches func(event events.OperationEvent) bool) <-chan *gqlschema.OperationStatus {
+
+	statuses := make(chan *gqlschema.OperationStatus, 1)
+	if initial != nil {
+		statuses <- initial
+	}
+
+	go func() {
+		defer close(statuses)
+		defer cancel()
+
+		for event := range operationEvents {
+			if !matches(event) {
+				continue
+			}
+
+			status, err := r.provisioning.RuntimeOperationStatus(ctx, event.OperationID)
+			if err != nil {
+				log.Errorf("Failed to get status of Operation %s for subscription: %s", event.OperationID, err)
+				continue
+			}
+
+			select {
+			case statuses <- status:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return statuses
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/events"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/metrics"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util/testkit"
 	"os"
 	"path/filepath"
@@ -21,6 +23,7 @@ import (
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util/k8s/mocks"
 
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/lease"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/queue"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
@@ -138,25 +141,36 @@ func TestProvisioning_ProvisionRuntimeWithDatabase(t *testing.T) {
 	kubeconfigProviderMock.On("FetchFromRequest", mock.AnythingOfType("string")).Return([]byte(mockedKubeconfig), nil)
 
 	provisioningQueue := queue.CreateProvisioningQueue(
+		testQueueConfig(),
 		testProvisioningTimeouts(),
 		dbsFactory,
 		shootInterface,
 		testOperatorRoleBinding(),
 		mockK8sClientProvider,
-		kubeconfigProviderMock)
+		kubeconfigProviderMock,
+		false,
+		events.NewNoopPublisher(),
+		metrics.NewOperationsCollector(),
+		queue.NewInMemoryBackend(lease.NewNoopManager()))
 	provisioningQueue.Run(queueCtx.Done())
 
-	deprovisioningQueue := queue.CreateDeprovisioningQueue(testDeprovisioningTimeouts(), dbsFactory, shootInterface)
+	deprovisioningQueue := queue.CreateDeprovisioningQueue(testQueueConfig(), testDeprovisioningTimeouts(), dbsFactory, shootInterface, events.NewNoopPublisher(), metrics.NewOperationsCollector(), queue.NewInMemoryBackend(lease.NewNoopManager()))
 	deprovisioningQueue.Run(queueCtx.Done())
 
-	shootUpgradeQueue := queue.CreateShootUpgradeQueue(testProvisioningTimeouts(), dbsFactory, shootInterface, testOperatorRoleBinding(), mockK8sClientProvider, kubeconfigProviderMock)
+	shootUpgradeQueue := queue.CreateShootUpgradeQueue(testQueueConfig(), testProvisioningTimeouts(), dbsFactory, shootInterface, testOperatorRoleBinding(), mockK8sClientProvider, kubeconfigProviderMock, events.NewNoopPublisher(), metrics.NewOperationsCollector(), queue.NewInMemoryBackend(lease.NewNoopManager()))
 	shootUpgradeQueue.Run(queueCtx.Done())
 
-	controler, err := gardener.NewShootController(mgr, dbsFactory, auditLogsConfigPath)
+	hibernationQueue := queue.CreateHibernationQueue(testQueueConfig(), testHibernationTimeouts(), dbsFactory, shootInterface, events.NewNoopPublisher(), metrics.NewOperationsCollector(), queue.NewInMemoryBackend(lease.NewNoopManager()))
+	hibernationQueue.Run(queueCtx.Done())
+
+	wakeUpQueue := queue.CreateWakeUpQueue(testQueueConfig(), testHibernationTimeouts(), dbsFactory, shootInterface, events.NewNoopPublisher(), metrics.NewOperationsCollector(), queue.NewInMemoryBackend(lease.NewNoopManager()))
+	wakeUpQueue.Run(queueCtx.Done())
+
+	controler, err := gardener.NewShootController(mgr, dbsFactory, auditLogsConfigPath, nil)
 	require.NoError(t, err)
 
 	go func() {
-		err := controler.StartShootController()
+		err := controler.StartShootController(queueCtx)
 		require.NoError(t, err)
 	}()
 
@@ -200,13 +214,17 @@ func TestProvisioning_ProvisionRuntimeWithDatabase(t *testing.T) {
 				provisioningQueue,
 				deprovisioningQueue,
 				shootUpgradeQueue,
-				kubeconfigProviderMock)
+				hibernationQueue,
+				wakeUpQueue,
+				kubeconfigProviderMock,
+				provisioning.Quotas{},
+				events.NewNoopPublisher())
 
-			validator := api.NewValidator()
+			validator := api.NewValidator(dbsFactory.NewReadSession())
 
 			tenantUpdater := api.NewTenantUpdater(dbsFactory.NewReadWriteSession())
 
-			resolver := api.NewResolver(provisioningService, validator, tenantUpdater, testkit.NewTestDataWriter("kyma-dev", tmpDir, true))
+			resolver := api.NewResolver(provisioningService, validator, tenantUpdater, testkit.NewTestDataWriter("kyma-dev", tmpDir, true), nil, api.NewIdempotencyGuard(dbsFactory.NewReadWriteSession(), time.Minute))
 
 			fullConfig := gqlschema.ProvisionRuntimeInput{RuntimeInput: &runtimeInput, ClusterConfig: &clusterConfig}
 
@@ -222,7 +240,7 @@ func TestProvisioning_ProvisionRuntimeWithDatabase(t *testing.T) {
 func testProvisionRuntime(t *testing.T, ctx context.Context, resolver *api.Resolver, fullConfig gqlschema.ProvisionRuntimeInput, runtimeID string, shootInterface gardener_apis.ShootInterface, secretsInterface v1core.SecretInterface, auditLogConfig *gardener.AuditLogConfig) {
 
 	// when Provisioning Runtime
-	provisionRuntime, err := resolver.ProvisionRuntime(ctx, fullConfig)
+	provisionRuntime, err := resolver.ProvisionRuntime(ctx, fullConfig, nil)
 
 	// then
 	require.NoError(t, err)
@@ -298,7 +316,7 @@ func testUpgradeGardenerShoot(t *testing.T, ctx context.Context, resolver *api.R
 	runtimeBeforeUpgrade, err := readSession.GetCluster(runtimeID)
 	require.NoError(t, err)
 
-	upgradeShootOp, err := resolver.UpgradeShoot(ctx, runtimeID, upgradeShootInput)
+	upgradeShootOp, err := resolver.UpgradeShoot(ctx, runtimeID, upgradeShootInput, nil)
 	require.NoError(t, err)
 
 	// for wait for shoot new version step
@@ -340,7 +358,7 @@ func testDeprovisionRuntime(t *testing.T, ctx context.Context, resolver *api.Res
 	require.NoError(t, err)
 
 	// when
-	deprovisionRuntimeID, err := resolver.DeprovisionRuntime(ctx, runtimeID)
+	deprovisionRuntimeID, err := resolver.DeprovisionRuntime(ctx, runtimeID, nil)
 	require.NoError(t, err)
 	require.NotEmpty(t, deprovisionRuntimeID)
 
@@ -386,6 +404,16 @@ func fixOperationStatusProvisioned(runtimeId, operationId *string) *gqlschema.Op
 	}
 }
 
+func testQueueConfig() queue.Config {
+	return queue.Config{
+		Workers:     5,
+		BaseBackoff: 5 * time.Millisecond,
+		MaxBackoff:  time.Minute,
+		QPS:         100,
+		Burst:       100,
+	}
+}
+
 func testProvisioningTimeouts() queue.ProvisioningTimeouts {
 	return queue.ProvisioningTimeouts{
 		ClusterCreation:        5 * time.Minute,
@@ -407,6 +435,13 @@ func testDeprovisioningTimeouts() queue.DeprovisioningTimeouts {
 	}
 }
 
+func testHibernationTimeouts() queue.HibernationTimeouts {
+	return queue.HibernationTimeouts{
+		WaitingForClusterHibernation: 5 * time.Minute,
+		WaitingForClusterWakeUp:      5 * time.Minute,
+	}
+}
+
 func testOperatorRoleBinding() provisioning2.OperatorRoleBinding {
 	return provisioning2.OperatorRoleBinding{
 		CreatingForAdmin: true,
@@ -4,20 +4,27 @@ import (
 	"context"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util/testkit"
 	"testing"
+	"time"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/api"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/api/middlewares"
 	validatorMocks "github.com/kyma-project/control-plane/components/provisioner/internal/api/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/events"
+	eventsMocks "github.com/kyma-project/control-plane/components/provisioner/internal/events/mocks"
 	kubeconfigprovidermock "github.com/kyma-project/control-plane/components/provisioner/internal/operations/queue/mocks"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/mocks"
+	sessionMocks "github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/mocks"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -28,6 +35,9 @@ const (
 
 var kubeconfigProviderMock = kubeconfigprovidermock.KubeconfigProvider{}
 
+// idempotencyGuard does not access the database for requests without idempotency key
+var idempotencyGuard = api.NewIdempotencyGuard(&sessionMocks.ReadWriteSession{}, time.Minute)
+
 func TestResolver_ProvisionRuntime(t *testing.T) {
 	ctx := context.WithValue(context.Background(), middlewares.Tenant, tenant)
 
@@ -62,7 +72,7 @@ func TestResolver_ProvisionRuntime(t *testing.T) {
 		provisioningService := &mocks.Service{}
 		validator := &validatorMocks.Validator{}
 		tenantUpdater := &validatorMocks.TenantUpdater{}
-		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{})
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, nil, idempotencyGuard)
 
 		tenantUpdater.On("GetTenant", ctx).Return(tenant, nil)
 
@@ -90,11 +100,11 @@ func TestResolver_ProvisionRuntime(t *testing.T) {
 			KymaConfig:    kymaConfig,
 		}
 
-		provisioningService.On("ProvisionRuntime", config, tenant, "").Return(operation, nil)
+		provisioningService.On("ProvisionRuntime", mock.Anything, config, tenant, "").Return(operation, nil)
 		validator.On("ValidateProvisioningInput", config).Return(nil)
 
 		//when
-		status, err := resolver.ProvisionRuntime(ctx, config)
+		status, err := resolver.ProvisionRuntime(ctx, config, nil)
 
 		//then
 		require.NoError(t, err)
@@ -108,12 +118,130 @@ func TestResolver_ProvisionRuntime(t *testing.T) {
 		assert.Equal(t, util.PtrTo("Message"), status.Message)
 	})
 
+	t.Run("Should return status of operation started earlier with the same idempotency key", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+		readWriteSession := &sessionMocks.ReadWriteSession{}
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, nil, api.NewIdempotencyGuard(readWriteSession, time.Minute))
+
+		ctxWithKey := context.WithValue(ctx, middlewares.IdempotencyKey, "idempotency-key")
+
+		operation := &gqlschema.OperationStatus{
+			ID:        util.PtrTo(operationID),
+			Operation: gqlschema.OperationTypeProvision,
+			State:     gqlschema.OperationStateInProgress,
+			RuntimeID: util.PtrTo(runtimeID),
+		}
+
+		config := gqlschema.ProvisionRuntimeInput{
+			RuntimeInput:  runtimeInput,
+			ClusterConfig: clusterConfig,
+		}
+
+		var payloadHash string
+		tenantUpdater.On("GetTenant", ctxWithKey).Return(tenant, nil)
+		validator.On("ValidateProvisioningInput", config).Return(nil)
+		readWriteSession.On("InsertIdempotencyKey", mock.AnythingOfType("model.IdempotencyKey"), mock.AnythingOfType("time.Time")).
+			Run(func(args mock.Arguments) {
+				payloadHash = args.Get(0).(model.IdempotencyKey).PayloadHash
+			}).Return(nil).Once()
+		provisioningService.On("ProvisionRuntime", mock.Anything, config, tenant, "").Return(operation, nil).Once()
+
+		status, err := resolver.ProvisionRuntime(ctxWithKey, config, nil)
+		require.NoError(t, err)
+		require.Equal(t, operation, status)
+
+		readWriteSession.On("InsertIdempotencyKey", mock.AnythingOfType("model.IdempotencyKey"), mock.AnythingOfType("time.Time")).Return(dberrors.AlreadyExists("exists"))
+		readWriteSession.On("GetIdempotencyKey", tenant, "idempotency-key").Return(model.IdempotencyKey{
+			Key:         "idempotency-key",
+			PayloadHash: payloadHash,
+			OperationID: util.PtrTo(operationID),
+		}, nil)
+		provisioningService.On("RuntimeOperationStatus", mock.Anything, operationID).Return(operation, nil)
+
+		//when
+		status, err = resolver.ProvisionRuntime(ctxWithKey, config, nil)
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, operation, status)
+		provisioningService.AssertNumberOfCalls(t, "ProvisionRuntime", 1)
+	})
+
+	t.Run("Should return conflict when idempotency key was used with different payload", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+		readWriteSession := &sessionMocks.ReadWriteSession{}
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, nil, api.NewIdempotencyGuard(readWriteSession, time.Minute))
+
+		ctxWithKey := context.WithValue(ctx, middlewares.IdempotencyKey, "idempotency-key")
+
+		config := gqlschema.ProvisionRuntimeInput{
+			RuntimeInput:  runtimeInput,
+			ClusterConfig: clusterConfig,
+		}
+
+		tenantUpdater.On("GetTenant", ctxWithKey).Return(tenant, nil)
+		validator.On("ValidateProvisioningInput", config).Return(nil)
+		readWriteSession.On("InsertIdempotencyKey", mock.AnythingOfType("model.IdempotencyKey"), mock.AnythingOfType("time.Time")).Return(dberrors.AlreadyExists("exists"))
+		readWriteSession.On("GetIdempotencyKey", tenant, "idempotency-key").Return(model.IdempotencyKey{
+			Key:         "idempotency-key",
+			PayloadHash: "different",
+			OperationID: util.PtrTo(operationID),
+		}, nil)
+
+		//when
+		status, err := resolver.ProvisionRuntime(ctxWithKey, config, nil)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeConflict)
+		assert.Nil(t, status)
+		provisioningService.AssertNotCalled(t, "ProvisionRuntime", config, tenant, "")
+	})
+
+	t.Run("Should return dry run result without starting provisioning", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, nil, idempotencyGuard)
+
+		dryRunStatus := &gqlschema.OperationStatus{
+			Operation:    gqlschema.OperationTypeProvision,
+			State:        gqlschema.OperationStateSucceeded,
+			RuntimeID:    util.PtrTo(runtimeID),
+			DryRunResult: &gqlschema.DryRunResult{Shoot: "kind: Shoot"},
+		}
+
+		config := gqlschema.ProvisionRuntimeInput{
+			RuntimeInput:  runtimeInput,
+			ClusterConfig: clusterConfig,
+		}
+
+		tenantUpdater.On("GetTenant", ctx).Return(tenant, nil)
+		validator.On("ValidateProvisioningInput", config).Return(nil)
+		provisioningService.On("DryRunProvisionRuntime", mock.Anything, config, tenant, "").Return(dryRunStatus, nil)
+
+		//when
+		status, err := resolver.ProvisionRuntime(ctx, config, util.PtrTo(true))
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, dryRunStatus, status)
+		provisioningService.AssertNotCalled(t, "ProvisionRuntime", config, tenant, "")
+	})
+
 	t.Run("Should return error when Kyma config validation fails", func(t *testing.T) {
 		//given
 		provisioningService := &mocks.Service{}
 		validator := &validatorMocks.Validator{}
 		tenantUpdater := &validatorMocks.TenantUpdater{}
-		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{})
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, nil, idempotencyGuard)
 
 		kymaConfig := &gqlschema.KymaConfigInput{
 			Version: "1.5",
@@ -125,7 +253,7 @@ func TestResolver_ProvisionRuntime(t *testing.T) {
 		validator.On("ValidateProvisioningInput", config).Return(apperrors.BadRequest("Some error"))
 
 		//when
-		status, err := provisioner.ProvisionRuntime(ctx, config)
+		status, err := provisioner.ProvisionRuntime(ctx, config, nil)
 
 		//then
 		require.Error(t, err)
@@ -137,7 +265,7 @@ func TestResolver_ProvisionRuntime(t *testing.T) {
 		provisioningService := &mocks.Service{}
 		validator := &validatorMocks.Validator{}
 		tenantUpdater := &validatorMocks.TenantUpdater{}
-		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{})
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, nil, idempotencyGuard)
 
 		kymaConfig := &gqlschema.KymaConfigInput{
 			Version: "1.5",
@@ -152,11 +280,11 @@ func TestResolver_ProvisionRuntime(t *testing.T) {
 		config := gqlschema.ProvisionRuntimeInput{RuntimeInput: runtimeInput, ClusterConfig: clusterConfig, KymaConfig: kymaConfig}
 
 		tenantUpdater.On("GetTenant", ctx).Return(tenant, nil)
-		provisioningService.On("ProvisionRuntime", config, tenant, "").Return(nil, apperrors.Internal("Provisioning failed"))
+		provisioningService.On("ProvisionRuntime", mock.Anything, config, tenant, "").Return(nil, apperrors.Internal("Provisioning failed"))
 		validator.On("ValidateProvisioningInput", config).Return(nil)
 
 		//when
-		status, err := provisioner.ProvisionRuntime(ctx, config)
+		status, err := provisioner.ProvisionRuntime(ctx, config, nil)
 
 		//then
 		require.Error(t, err)
@@ -169,7 +297,7 @@ func TestResolver_ProvisionRuntime(t *testing.T) {
 		provisioningService := &mocks.Service{}
 		validator := &validatorMocks.Validator{}
 		tenantUpdater := &validatorMocks.TenantUpdater{}
-		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{})
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, nil, idempotencyGuard)
 
 		kymaConfig := &gqlschema.KymaConfigInput{
 			Version: "1.5",
@@ -193,7 +321,7 @@ func TestResolver_ProvisionRuntime(t *testing.T) {
 		validator.On("ValidateProvisioningInput", config).Return(nil)
 
 		//when
-		status, err := provisioner.ProvisionRuntime(ctx, config)
+		status, err := provisioner.ProvisionRuntime(ctx, config, nil)
 
 		//then
 		require.Error(t, err)
@@ -209,15 +337,15 @@ func TestResolver_DeprovisionRuntime(t *testing.T) {
 		provisioningService := &mocks.Service{}
 		validator := &validatorMocks.Validator{}
 		tenantUpdater := &validatorMocks.TenantUpdater{}
-		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{})
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, nil, idempotencyGuard)
 
 		expectedID := "ec781980-0533-4098-aab7-96b535569732"
 
-		provisioningService.On("DeprovisionRuntime", runtimeID).Return(expectedID, nil)
+		provisioningService.On("DeprovisionRuntime", mock.Anything, runtimeID, (*gqlschema.OperationPriority)(nil)).Return(expectedID, nil)
 		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
 
 		//when
-		operationID, err := provisioner.DeprovisionRuntime(ctx, runtimeID)
+		operationID, err := provisioner.DeprovisionRuntime(ctx, runtimeID, nil)
 
 		//then
 		require.NoError(t, err)
@@ -229,12 +357,12 @@ func TestResolver_DeprovisionRuntime(t *testing.T) {
 		provisioningService := &mocks.Service{}
 		validator := &validatorMocks.Validator{}
 		tenantUpdater := &validatorMocks.TenantUpdater{}
-		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{})
-		provisioningService.On("DeprovisionRuntime", runtimeID).Return("", apperrors.Internal("Deprovisioning fails because reasons"))
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, nil, idempotencyGuard)
+		provisioningService.On("DeprovisionRuntime", mock.Anything, runtimeID, (*gqlschema.OperationPriority)(nil)).Return("", apperrors.Internal("Deprovisioning fails because reasons"))
 		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
 
 		//when
-		operationID, err := provisioner.DeprovisionRuntime(ctx, runtimeID)
+		operationID, err := provisioner.DeprovisionRuntime(ctx, runtimeID, nil)
 
 		//then
 		require.Error(t, err)
@@ -247,16 +375,16 @@ func TestResolver_DeprovisionRuntime(t *testing.T) {
 		provisioningService := &mocks.Service{}
 		validator := &validatorMocks.Validator{}
 		tenantUpdater := &validatorMocks.TenantUpdater{}
-		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{})
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, nil, idempotencyGuard)
 		expectedID := "ec781980-0533-4098-aab7-96b535569732"
 
 		ctx := context.Background()
 
-		provisioningService.On("DeprovisionRuntime", runtimeID).Return(expectedID, nil, nil)
+		provisioningService.On("DeprovisionRuntime", mock.Anything, runtimeID, (*gqlschema.OperationPriority)(nil)).Return(expectedID, nil, nil)
 		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(apperrors.BadRequest("tenant header not passed"))
 
 		//when
-		operationID, err := provisioner.DeprovisionRuntime(ctx, runtimeID)
+		operationID, err := provisioner.DeprovisionRuntime(ctx, runtimeID, nil)
 
 		//then
 		require.Error(t, err)
@@ -274,7 +402,7 @@ func TestResolver_RuntimeStatus(t *testing.T) {
 		validator := &validatorMocks.Validator{}
 		tenantUpdater := &validatorMocks.TenantUpdater{}
 
-		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{})
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, nil, idempotencyGuard)
 
 		operationID := "acc5040c-3bb6-47b8-8651-07f6950bd0a7"
 		message := "some message"
@@ -291,7 +419,7 @@ func TestResolver_RuntimeStatus(t *testing.T) {
 			RuntimeConnectionStatus: &gqlschema.RuntimeConnectionStatus{},
 		}
 
-		provisioningService.On("RuntimeStatus", runtimeID).Return(status, nil)
+		provisioningService.On("RuntimeStatus", mock.Anything, runtimeID).Return(status, nil)
 		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
 
 		//when
@@ -308,9 +436,9 @@ func TestResolver_RuntimeStatus(t *testing.T) {
 		validator := &validatorMocks.Validator{}
 		tenantUpdater := &validatorMocks.TenantUpdater{}
 
-		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{})
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, nil, idempotencyGuard)
 
-		provisioningService.On("RuntimeStatus", runtimeID).Return(nil, apperrors.Internal("Runtime status fails"))
+		provisioningService.On("RuntimeStatus", mock.Anything, runtimeID).Return(nil, apperrors.Internal("Runtime status fails"))
 		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
 
 		//when
@@ -333,7 +461,7 @@ func TestResolver_RuntimeOperationStatus(t *testing.T) {
 		validator := &validatorMocks.Validator{}
 		tenantUpdater := &validatorMocks.TenantUpdater{}
 
-		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{})
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, nil, idempotencyGuard)
 
 		operationID := "acc5040c-3bb6-47b8-8651-07f6950bd0a7"
 		message := "some message"
@@ -346,7 +474,7 @@ func TestResolver_RuntimeOperationStatus(t *testing.T) {
 			Message:   &message,
 		}
 
-		provisioningService.On("RuntimeOperationStatus", operationID).Return(operationStatus, nil)
+		provisioningService.On("RuntimeOperationStatus", mock.Anything, operationID).Return(operationStatus, nil)
 		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
 
 		//when
@@ -364,9 +492,9 @@ func TestResolver_RuntimeOperationStatus(t *testing.T) {
 		tenantUpdater := &validatorMocks.TenantUpdater{}
 
 		validator.On("ValidateTenantForOperation", operationID, tenant).Return(nil)
-		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{})
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, nil, idempotencyGuard)
 
-		provisioningService.On("RuntimeOperationStatus", operationID).Return(nil, apperrors.Internal("Some error"))
+		provisioningService.On("RuntimeOperationStatus", mock.Anything, operationID).Return(nil, apperrors.Internal("Some error"))
 		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
 
 		//when
@@ -399,13 +527,13 @@ func TestResolver_UpgradeShoot(t *testing.T) {
 		}
 
 		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
-		validator.On("ValidateUpgradeShootInput", upgradeShootInput).Return(nil)
-		provisioningService.On("UpgradeGardenerShoot", runtimeID, upgradeShootInput).Return(operation, nil)
+		validator.On("ValidateUpgradeShootInput", runtimeID, upgradeShootInput).Return(nil)
+		provisioningService.On("UpgradeGardenerShoot", mock.Anything, runtimeID, upgradeShootInput).Return(operation, nil)
 
-		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{})
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, nil, idempotencyGuard)
 
 		//when
-		status, err := resolver.UpgradeShoot(ctx, runtimeID, upgradeShootInput)
+		status, err := resolver.UpgradeShoot(ctx, runtimeID, upgradeShootInput, nil)
 
 		//then
 		require.NoError(t, err)
@@ -421,17 +549,532 @@ func TestResolver_UpgradeShoot(t *testing.T) {
 		validator := &validatorMocks.Validator{}
 		tenantUpdater := &validatorMocks.TenantUpdater{}
 
-		validator.On("ValidateUpgradeShootInput", upgradeShootInput).Return(apperrors.BadRequest("error"))
+		validator.On("ValidateUpgradeShootInput", runtimeID, upgradeShootInput).Return(apperrors.BadRequest("error"))
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
+
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, nil, idempotencyGuard)
+
+		//when
+		_, err := resolver.UpgradeShoot(ctx, runtimeID, upgradeShootInput, nil)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+	})
+
+	t.Run("Should return dry run result without starting shoot upgrade", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		dryRunStatus := &gqlschema.OperationStatus{
+			Operation:    gqlschema.OperationTypeUpgradeShoot,
+			State:        gqlschema.OperationStateSucceeded,
+			RuntimeID:    util.PtrTo(runtimeID),
+			DryRunResult: &gqlschema.DryRunResult{Shoot: "kind: Shoot"},
+		}
+
+		tenantUpdater.On("CheckTenant", runtimeID, ctx).Return(nil)
+		validator.On("ValidateUpgradeShootInput", runtimeID, upgradeShootInput).Return(nil)
+		provisioningService.On("DryRunUpgradeGardenerShoot", mock.Anything, runtimeID, upgradeShootInput).Return(dryRunStatus, nil)
+
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, nil, idempotencyGuard)
+
+		//when
+		status, err := resolver.UpgradeShoot(ctx, runtimeID, upgradeShootInput, util.PtrTo(true))
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, dryRunStatus, status)
+		provisioningService.AssertNotCalled(t, "UpgradeGardenerShoot", runtimeID, upgradeShootInput)
+		tenantUpdater.AssertNotCalled(t, "GetAndUpdateTenant", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Should return error when dry run is requested for Runtime of other tenant", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		tenantUpdater.On("CheckTenant", runtimeID, ctx).Return(apperrors.InvalidTenant("error"))
+		validator.On("ValidateUpgradeShootInput", runtimeID, upgradeShootInput).Return(nil)
+
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, nil, idempotencyGuard)
+
+		//when
+		_, err := resolver.UpgradeShoot(ctx, runtimeID, upgradeShootInput, util.PtrTo(true))
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		provisioningService.AssertNotCalled(t, "DryRunUpgradeGardenerShoot", mock.Anything, mock.Anything, mock.Anything)
+		tenantUpdater.AssertNotCalled(t, "GetAndUpdateTenant", mock.Anything, mock.Anything)
+	})
+}
+
+func TestResolver_HibernateRuntime(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middlewares.Tenant, tenant)
+	priority := gqlschema.OperationPriorityHigh
+
+	t.Run("Should start hibernation and return operation status", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		operation := &gqlschema.OperationStatus{
+			ID:        util.PtrTo(operationID),
+			Operation: gqlschema.OperationTypeHibernate,
+			State:     gqlschema.OperationStateInProgress,
+			RuntimeID: util.PtrTo(runtimeID),
+		}
+
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
+		provisioningService.On("HibernateCluster", mock.Anything, runtimeID, &priority).Return(operation, nil)
+
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, nil, idempotencyGuard)
+
+		//when
+		status, err := resolver.HibernateRuntime(ctx, runtimeID, &priority)
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, operation, status)
+	})
+
+	t.Run("Should return error when hibernation fails", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
+		provisioningService.On("HibernateCluster", mock.Anything, runtimeID, (*gqlschema.OperationPriority)(nil)).Return(nil, apperrors.BadRequest("error"))
+
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, nil, idempotencyGuard)
+
+		//when
+		status, err := resolver.HibernateRuntime(ctx, runtimeID, nil)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		assert.Nil(t, status)
+	})
+}
+
+func TestResolver_WakeUpRuntime(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middlewares.Tenant, tenant)
+	priority := gqlschema.OperationPriorityHigh
+
+	t.Run("Should start wake up and return operation status", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		operation := &gqlschema.OperationStatus{
+			ID:        util.PtrTo(operationID),
+			Operation: gqlschema.OperationTypeWakeUp,
+			State:     gqlschema.OperationStateInProgress,
+			RuntimeID: util.PtrTo(runtimeID),
+		}
+
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
+		provisioningService.On("WakeUpCluster", mock.Anything, runtimeID, &priority).Return(operation, nil)
+
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, nil, idempotencyGuard)
+
+		//when
+		status, err := resolver.WakeUpRuntime(ctx, runtimeID, &priority)
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, operation, status)
+	})
+
+	t.Run("Should return error when wake up fails", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
+		provisioningService.On("WakeUpCluster", mock.Anything, runtimeID, (*gqlschema.OperationPriority)(nil)).Return(nil, apperrors.BadRequest("error"))
+
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, nil, idempotencyGuard)
+
+		//when
+		status, err := resolver.WakeUpRuntime(ctx, runtimeID, nil)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		assert.Nil(t, status)
+	})
+}
+
+func TestResolver_CancelOperation(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middlewares.Tenant, tenant)
+
+	operation := &gqlschema.OperationStatus{
+		ID:        util.PtrTo(operationID),
+		Operation: gqlschema.OperationTypeUpgradeShoot,
+		State:     gqlschema.OperationStateInProgress,
+		RuntimeID: util.PtrTo(runtimeID),
+	}
+
+	t.Run("Should cancel operation and return its status", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		cancelled := &gqlschema.OperationStatus{
+			ID:        util.PtrTo(operationID),
+			Operation: gqlschema.OperationTypeUpgradeShoot,
+			State:     gqlschema.OperationStateCancelled,
+			RuntimeID: util.PtrTo(runtimeID),
+		}
+
+		provisioningService.On("RuntimeOperationStatus", mock.Anything, operationID).Return(operation, nil)
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
+		provisioningService.On("CancelOperation", mock.Anything, operationID).Return(cancelled, nil)
+
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, nil, idempotencyGuard)
+
+		//when
+		status, err := resolver.CancelOperation(ctx, operationID)
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, cancelled, status)
+	})
+
+	t.Run("Should return error when tenant does not match", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		provisioningService.On("RuntimeOperationStatus", mock.Anything, operationID).Return(operation, nil)
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(apperrors.BadRequest("provided tenant does not match tenant used to provision cluster"))
+
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, nil, idempotencyGuard)
+
+		//when
+		status, err := resolver.CancelOperation(ctx, operationID)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		assert.Nil(t, status)
+	})
+
+	t.Run("Should return error when cancelling fails", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		provisioningService.On("RuntimeOperationStatus", mock.Anything, operationID).Return(operation, nil)
 		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
+		provisioningService.On("CancelOperation", mock.Anything, operationID).Return(nil, apperrors.BadRequest("error"))
 
-		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{})
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, nil, idempotencyGuard)
 
 		//when
-		_, err := resolver.UpgradeShoot(ctx, runtimeID, upgradeShootInput)
+		status, err := resolver.CancelOperation(ctx, operationID)
 
 		//then
 		require.Error(t, err)
 		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		assert.Nil(t, status)
+	})
+}
+
+func TestResolver_RetryOperation(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middlewares.Tenant, tenant)
+
+	operation := &gqlschema.OperationStatus{
+		ID:        util.PtrTo(operationID),
+		Operation: gqlschema.OperationTypeProvision,
+		State:     gqlschema.OperationStateFailed,
+		RuntimeID: util.PtrTo(runtimeID),
+	}
+
+	t.Run("Should retry operation and return its status", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		retried := &gqlschema.OperationStatus{
+			ID:        util.PtrTo(operationID),
+			Operation: gqlschema.OperationTypeProvision,
+			State:     gqlschema.OperationStateInProgress,
+			RuntimeID: util.PtrTo(runtimeID),
+		}
+
+		provisioningService.On("RuntimeOperationStatus", mock.Anything, operationID).Return(operation, nil)
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
+		provisioningService.On("RetryOperation", mock.Anything, operationID).Return(retried, nil)
+
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, nil, idempotencyGuard)
+
+		//when
+		status, err := resolver.RetryOperation(ctx, operationID)
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, retried, status)
+	})
+
+	t.Run("Should return error when retrying fails", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		provisioningService.On("RuntimeOperationStatus", mock.Anything, operationID).Return(operation, nil)
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
+		provisioningService.On("RetryOperation", mock.Anything, operationID).Return(nil, apperrors.BadRequest("error"))
+
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, nil, idempotencyGuard)
+
+		//when
+		status, err := resolver.RetryOperation(ctx, operationID)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		assert.Nil(t, status)
+	})
+}
+
+func TestResolver_ListRuntimes(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middlewares.Tenant, tenant)
+
+	filter := &gqlschema.RuntimeFilterInput{Tenant: util.PtrTo(tenant)}
+
+	t.Run("Should return page of Runtimes", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		page := &gqlschema.RuntimeSummaryPage{
+			Data:     []*gqlschema.RuntimeSummary{{ID: runtimeID, Tenant: tenant}},
+			PageInfo: &gqlschema.PageInfo{EndCursor: util.PtrTo("cursor"), HasNextPage: true},
+		}
+
+		tenantUpdater.On("GetTenant", ctx).Return(tenant, nil)
+		provisioningService.On("ListRuntimes", mock.Anything, filter, util.PtrTo(1), (*string)(nil)).Return(page, nil)
+
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, nil, idempotencyGuard)
+
+		//when
+		result, err := resolver.ListRuntimes(ctx, filter, util.PtrTo(1), nil)
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, page, result)
+	})
+
+	t.Run("Should return error when listing Runtimes fails", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		tenantUpdater.On("GetTenant", ctx).Return(tenant, nil)
+		provisioningService.On("ListRuntimes", mock.Anything, filter, (*int)(nil), (*string)(nil)).Return(nil, apperrors.BadRequest("error"))
+
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, nil, idempotencyGuard)
+
+		//when
+		result, err := resolver.ListRuntimes(ctx, filter, nil, nil)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Should list only Runtimes of the caller's tenant when no filter passed", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		page := &gqlschema.RuntimeSummaryPage{PageInfo: &gqlschema.PageInfo{}}
+
+		tenantUpdater.On("GetTenant", ctx).Return(tenant, nil)
+		provisioningService.On("ListRuntimes", mock.Anything, filter, (*int)(nil), (*string)(nil)).Return(page, nil)
+
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, nil, idempotencyGuard)
+
+		//when
+		result, err := resolver.ListRuntimes(ctx, nil, nil, nil)
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, page, result)
+		provisioningService.AssertExpectations(t)
+	})
+
+	t.Run("Should return error when listing Runtimes of other tenant", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		tenantUpdater.On("GetTenant", ctx).Return(tenant, nil)
+
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, nil, idempotencyGuard)
+
+		//when
+		result, err := resolver.ListRuntimes(ctx, &gqlschema.RuntimeFilterInput{Tenant: util.PtrTo("other-tenant")}, nil, nil)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeForbidden)
+		assert.Nil(t, result)
+		provisioningService.AssertNotCalled(t, "ListRuntimes", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Should list Runtimes of other tenant for admin", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		adminCtx := context.WithValue(ctx, middlewares.Admin, true)
+		otherTenantFilter := &gqlschema.RuntimeFilterInput{Tenant: util.PtrTo("other-tenant")}
+		page := &gqlschema.RuntimeSummaryPage{PageInfo: &gqlschema.PageInfo{}}
+
+		provisioningService.On("ListRuntimes", mock.Anything, otherTenantFilter, (*int)(nil), (*string)(nil)).Return(page, nil)
+
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, nil, idempotencyGuard)
+
+		//when
+		result, err := resolver.ListRuntimes(adminCtx, otherTenantFilter, nil, nil)
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, page, result)
+		provisioningService.AssertExpectations(t)
+	})
+
+	t.Run("Should list Runtimes of all tenants for admin without tenant", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		adminCtx := context.WithValue(context.Background(), middlewares.Admin, true)
+		page := &gqlschema.RuntimeSummaryPage{PageInfo: &gqlschema.PageInfo{}}
+
+		provisioningService.On("ListRuntimes", mock.Anything, (*gqlschema.RuntimeFilterInput)(nil), (*int)(nil), (*string)(nil)).Return(page, nil)
+
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, nil, idempotencyGuard)
+
+		//when
+		result, err := resolver.ListRuntimes(adminCtx, nil, nil, nil)
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, page, result)
+		tenantUpdater.AssertNotCalled(t, "GetTenant", mock.Anything)
+	})
+
+	t.Run("Should return error when tenant header is missing", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		tenantUpdater.On("GetTenant", context.Background()).Return("", apperrors.BadRequest("tenant header is empty"))
+
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, nil, idempotencyGuard)
+
+		//when
+		result, err := resolver.ListRuntimes(context.Background(), nil, nil, nil)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		assert.Nil(t, result)
+	})
+}
+
+func TestResolver_RuntimeOperations(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middlewares.Tenant, tenant)
+
+	types := []gqlschema.OperationType{gqlschema.OperationTypeUpgradeShoot}
+
+	t.Run("Should return page of operations", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		page := &gqlschema.OperationHistoryPage{
+			Data:     []*gqlschema.OperationHistoryEntry{{ID: operationID, Operation: gqlschema.OperationTypeUpgradeShoot}},
+			PageInfo: &gqlschema.PageInfo{EndCursor: util.PtrTo("cursor"), HasNextPage: true},
+		}
+
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
+		provisioningService.On("RuntimeOperations", mock.Anything, runtimeID, types, []gqlschema.OperationState(nil), util.PtrTo(1), (*string)(nil)).Return(page, nil)
+
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, nil, idempotencyGuard)
+
+		//when
+		result, err := resolver.RuntimeOperations(ctx, runtimeID, types, nil, util.PtrTo(1), nil)
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, page, result)
+	})
+
+	t.Run("Should return error when tenant does not match", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(apperrors.BadRequest("provided tenant does not match tenant used to provision cluster"))
+
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, nil, idempotencyGuard)
+
+		//when
+		result, err := resolver.RuntimeOperations(ctx, runtimeID, nil, nil, nil, nil)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Should return error when listing operations fails", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
+		provisioningService.On("RuntimeOperations", mock.Anything, runtimeID, []gqlschema.OperationType(nil), []gqlschema.OperationState(nil), (*int)(nil), (*string)(nil)).Return(nil, apperrors.Internal("error"))
+
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, nil, idempotencyGuard)
+
+		//when
+		result, err := resolver.RuntimeOperations(ctx, runtimeID, nil, nil, nil, nil)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeInternal)
+		assert.Nil(t, result)
 	})
 }
 
@@ -458,3 +1101,137 @@ func dnsInput() *gqlschema.DNSConfigInput {
 		},
 	}
 }
+
+func TestResolver_OperationStatusChanged(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middlewares.Tenant, tenant)
+
+	operation := &gqlschema.OperationStatus{
+		ID:        util.PtrTo(operationID),
+		Operation: gqlschema.OperationTypeProvision,
+		State:     gqlschema.OperationStateInProgress,
+		RuntimeID: util.PtrTo(runtimeID),
+	}
+
+	t.Run("Should send initial status and status after each event of the operation", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+		subscriber := &eventsMocks.Subscriber{}
+
+		succeeded := &gqlschema.OperationStatus{
+			ID:        util.PtrTo(operationID),
+			Operation: gqlschema.OperationTypeProvision,
+			State:     gqlschema.OperationStateSucceeded,
+			RuntimeID: util.PtrTo(runtimeID),
+		}
+
+		operationEvents := make(chan events.OperationEvent, 2)
+		operationEvents <- events.OperationEvent{OperationID: "other-operation", RuntimeID: runtimeID}
+		operationEvents <- events.OperationEvent{OperationID: operationID, RuntimeID: runtimeID}
+		close(operationEvents)
+
+		subscriber.On("Subscribe", mock.Anything).Return((<-chan events.OperationEvent)(operationEvents))
+		provisioningService.On("RuntimeOperationStatus", mock.Anything, operationID).Return(operation, nil).Once()
+		provisioningService.On("RuntimeOperationStatus", mock.Anything, operationID).Return(succeeded, nil).Once()
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
+
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, subscriber, idempotencyGuard)
+
+		//when
+		statuses, err := resolver.OperationStatusChanged(ctx, operationID)
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, operation, <-statuses)
+		assert.Equal(t, succeeded, <-statuses)
+
+		_, open := <-statuses
+		assert.False(t, open)
+		provisioningService.AssertExpectations(t)
+	})
+
+	t.Run("Should return error when tenant does not match", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+		subscriber := &eventsMocks.Subscriber{}
+
+		subscriber.On("Subscribe", mock.Anything).Return((<-chan events.OperationEvent)(make(chan events.OperationEvent)))
+		provisioningService.On("RuntimeOperationStatus", mock.Anything, operationID).Return(operation, nil)
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(apperrors.BadRequest("provided tenant does not match tenant used to provision cluster"))
+
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, subscriber, idempotencyGuard)
+
+		//when
+		statuses, err := resolver.OperationStatusChanged(ctx, operationID)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		assert.Nil(t, statuses)
+	})
+}
+
+func TestResolver_RuntimeEvents(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middlewares.Tenant, tenant)
+
+	t.Run("Should send status of operations of the runtime", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+		subscriber := &eventsMocks.Subscriber{}
+
+		operation := &gqlschema.OperationStatus{
+			ID:        util.PtrTo(operationID),
+			Operation: gqlschema.OperationTypeHibernate,
+			State:     gqlschema.OperationStateInProgress,
+			RuntimeID: util.PtrTo(runtimeID),
+		}
+
+		operationEvents := make(chan events.OperationEvent, 2)
+		operationEvents <- events.OperationEvent{OperationID: "other-operation", RuntimeID: "other-runtime"}
+		operationEvents <- events.OperationEvent{OperationID: operationID, RuntimeID: runtimeID}
+		close(operationEvents)
+
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
+		subscriber.On("Subscribe", mock.Anything).Return((<-chan events.OperationEvent)(operationEvents))
+		provisioningService.On("RuntimeOperationStatus", mock.Anything, operationID).Return(operation, nil)
+
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, subscriber, idempotencyGuard)
+
+		//when
+		statuses, err := resolver.RuntimeEvents(ctx, runtimeID)
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, operation, <-statuses)
+
+		_, open := <-statuses
+		assert.False(t, open)
+		provisioningService.AssertNumberOfCalls(t, "RuntimeOperationStatus", 1)
+	})
+
+	t.Run("Should return error when tenant does not match", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+		subscriber := &eventsMocks.Subscriber{}
+
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(apperrors.BadRequest("provided tenant does not match tenant used to provision cluster"))
+
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{}, subscriber, idempotencyGuard)
+
+		//when
+		statuses, err := resolver.RuntimeEvents(ctx, runtimeID)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		assert.Nil(t, statuses)
+		subscriber.AssertNotCalled(t, "Subscribe", mock.Anything)
+	})
+}
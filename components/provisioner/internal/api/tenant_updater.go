@@ -12,6 +12,8 @@ import (
 type TenantUpdater interface {
 	GetTenant(ctx context.Context) (string, apperrors.AppError)
 	GetAndUpdateTenant(runtimeID string, ctx context.Context) apperrors.AppError
+	// CheckTenant verifies that the Runtime belongs to the tenant of the request without updating it
+	CheckTenant(runtimeID string, ctx context.Context) apperrors.AppError
 }
 
 type updater struct {
@@ -51,3 +53,19 @@ func (u *updater) GetAndUpdateTenant(runtimeID string, ctx context.Context) appe
 	}
 	return nil
 }
+
+func (u *updater) CheckTenant(runtimeID string, ctx context.Context) apperrors.AppError {
+	tenant, err := u.GetTenant(ctx)
+	if err != nil {
+		return err
+	}
+	dbTenant, dberr := u.readWriteSession.GetTenant(runtimeID)
+	if dberr != nil {
+		return dberr
+	}
+
+	if tenant != dbTenant {
+		return apperrors.InvalidTenant("Runtime %s does not belong to tenant %s", runtimeID, tenant)
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/kyma-project/control-plane/components/provisioner/internal/api/middlewares"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -65,3 +66,32 @@ func TestTenantUpdater_GetAndUpdateTenant(t *testing.T) {
 		rwsMock.AssertExpectations(t)
 	})
 }
+
+func TestTenantUpdater_CheckTenant(t *testing.T) {
+	t.Run("should accept tenant equal to db tenant", func(t *testing.T) {
+		runtimeId := "runtimeID"
+		ctx := context.WithValue(context.Background(), middlewares.Tenant, "tenant")
+
+		rwsMock := &mocks.ReadWriteSession{}
+		tenantUpdater := NewTenantUpdater(rwsMock)
+
+		rwsMock.On("GetTenant", runtimeId).Return("tenant", nil)
+
+		err := tenantUpdater.CheckTenant(runtimeId, ctx)
+		require.NoError(t, err)
+		rwsMock.AssertExpectations(t)
+	})
+	t.Run("should return error without updating tenant when differs from db tenant", func(t *testing.T) {
+		runtimeId := "runtimeID"
+		ctx := context.WithValue(context.Background(), middlewares.Tenant, "tenant")
+
+		rwsMock := &mocks.ReadWriteSession{}
+		tenantUpdater := NewTenantUpdater(rwsMock)
+
+		rwsMock.On("GetTenant", runtimeId).Return("other-tenant", nil)
+
+		err := tenantUpdater.CheckTenant(runtimeId, ctx)
+		require.Error(t, err)
+		rwsMock.AssertNotCalled(t, "UpdateTenant", mock.Anything, mock.Anything)
+	})
+}
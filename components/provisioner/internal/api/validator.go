@@ -3,7 +3,12 @@ package api
 import (
 	"strings"
 
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+
 	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
 
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
@@ -11,17 +16,26 @@ import (
 
 const RuntimeAgent = "compass-runtime-agent"
 
+var allowedTaintEffects = map[corev1.TaintEffect]bool{
+	corev1.TaintEffectNoSchedule:       true,
+	corev1.TaintEffectPreferNoSchedule: true,
+	corev1.TaintEffectNoExecute:        true,
+}
+
 //go:generate mockery --name=Validator
 type Validator interface {
 	ValidateProvisioningInput(input gqlschema.ProvisionRuntimeInput) apperrors.AppError
-	ValidateUpgradeShootInput(input gqlschema.UpgradeShootInput) apperrors.AppError
+	ValidateUpgradeShootInput(runtimeID string, input gqlschema.UpgradeShootInput) apperrors.AppError
 }
 
 type validator struct {
+	readSession dbsession.ReadSession
 }
 
-func NewValidator() Validator {
-	return &validator{}
+func NewValidator(readSession dbsession.ReadSession) Validator {
+	return &validator{
+		readSession: readSession,
+	}
 }
 
 func (v *validator) ValidateProvisioningInput(input gqlschema.ProvisionRuntimeInput) apperrors.AppError {
@@ -42,7 +56,7 @@ func (v *validator) ValidateProvisioningInput(input gqlschema.ProvisionRuntimeIn
 	return nil
 }
 
-func (v *validator) ValidateUpgradeShootInput(input gqlschema.UpgradeShootInput) apperrors.AppError {
+func (v *validator) ValidateUpgradeShootInput(runtimeID string, input gqlschema.UpgradeShootInput) apperrors.AppError {
 
 	config := input.GardenerConfig
 
@@ -66,6 +80,45 @@ func (v *validator) ValidateUpgradeShootInput(input gqlschema.UpgradeShootInput)
 		return apperrors.BadRequest("empty purpose provided")
 	}
 
+	if err := v.validateNodeConfig(config.NodeConfig); err != nil {
+		return err
+	}
+
+	if err := v.validateWorkerPools(config.AdditionalWorkerPools); err != nil {
+		return err
+	}
+
+	if err := v.validateUpgradedWorkerPoolsVolume(runtimeID, config.AdditionalWorkerPools); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateUpgradedWorkerPoolsVolume reads the provider of the Runtime only when some worker pool sets the volume
+func (v *validator) validateUpgradedWorkerPoolsVolume(runtimeID string, pools []*gqlschema.WorkerPoolInput) apperrors.AppError {
+	withVolume := false
+	for _, pool := range pools {
+		if pool.DiskType != nil || pool.VolumeSizeGb != nil {
+			withVolume = true
+			break
+		}
+	}
+	if !withVolume {
+		return nil
+	}
+
+	cluster, dberr := v.readSession.GetCluster(runtimeID)
+	if dberr != nil {
+		return dberr.Append("failed to get Runtime %s", runtimeID)
+	}
+
+	for _, pool := range pools {
+		if err := v.validateOpenStackVolume(pool.DiskType, pool.VolumeSizeGb, cluster.ClusterConfig.Provider); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -105,6 +158,86 @@ func (v *validator) validateClusterConfig(clusterConfig *gqlschema.ClusterConfig
 		return err
 	}
 
+	if err := v.validateNodeConfig(gardenerConfig.NodeConfig); err != nil {
+		return err
+	}
+
+	if err := v.validateWorkerPools(gardenerConfig.AdditionalWorkerPools); err != nil {
+		return err
+	}
+
+	for _, pool := range gardenerConfig.AdditionalWorkerPools {
+		if err := v.validateOpenStackVolume(pool.DiskType, pool.VolumeSizeGb, gardenerConfig.Provider); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (v *validator) validateWorkerPools(pools []*gqlschema.WorkerPoolInput) apperrors.AppError {
+	names := map[string]bool{model.DefaultWorkerName: true}
+
+	for _, pool := range pools {
+		if pool.Name == "" {
+			return apperrors.BadRequest("error: empty worker pool name provided")
+		}
+		if names[pool.Name] {
+			return apperrors.BadRequest("error: worker pool name %s is not unique", pool.Name)
+		}
+		names[pool.Name] = true
+
+		if pool.MachineType == "" {
+			return apperrors.BadRequest("error: empty machine type provided for worker pool %s", pool.Name)
+		}
+		if util.NotNilOrEmpty(pool.MachineImageVersion) && util.IsNilOrEmpty(pool.MachineImage) {
+			return apperrors.BadRequest("error: Machine Image Version passed while Machine Image is empty for worker pool %s", pool.Name)
+		}
+		if pool.AutoScalerMin > pool.AutoScalerMax {
+			return apperrors.BadRequest("error: autoScalerMin is greater than autoScalerMax for worker pool %s", pool.Name)
+		}
+		if err := v.validateNodeConfig(pool.NodeConfig); err != nil {
+			return err.Append("error: invalid node config of worker pool %s", pool.Name)
+		}
+	}
+
+	return nil
+}
+
+func (v *validator) validateNodeConfig(nodeConfig *gqlschema.NodeConfigInput) apperrors.AppError {
+	if nodeConfig == nil {
+		return nil
+	}
+
+	for key, value := range nodeConfig.Labels {
+		if _, ok := value.(string); !ok {
+			return apperrors.BadRequest("error: value of node label %s is not a string", key)
+		}
+	}
+
+	for _, taint := range nodeConfig.Taints {
+		if taint.Key == "" {
+			return apperrors.BadRequest("error: empty taint key provided")
+		}
+		if !allowedTaintEffects[corev1.TaintEffect(taint.Effect)] {
+			return apperrors.BadRequest("error: taint effect %s of taint %s is not allowed, allowed effects are %s, %s and %s",
+				taint.Effect, taint.Key, corev1.TaintEffectNoSchedule, corev1.TaintEffectPreferNoSchedule, corev1.TaintEffectNoExecute)
+		}
+	}
+
+	if nodeConfig.CriName != nil && *nodeConfig.CriName != string(gardener_types.CRINameContainerD) {
+		return apperrors.BadRequest("error: container runtime %s is not supported", *nodeConfig.CriName)
+	}
+
+	if kubeletConfig := nodeConfig.KubeletConfig; kubeletConfig != nil {
+		if kubeletConfig.MaxPods != nil && *kubeletConfig.MaxPods <= 0 {
+			return apperrors.BadRequest("error: kubelet maxPods must be greater than 0")
+		}
+		if kubeletConfig.PodPidsLimit != nil && *kubeletConfig.PodPidsLimit <= 0 {
+			return apperrors.BadRequest("error: kubelet podPidsLimit must be greater than 0")
+		}
+	}
+
 	return nil
 }
 
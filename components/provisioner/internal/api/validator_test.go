@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/mocks"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 	"github.com/stretchr/testify/require"
@@ -14,7 +16,7 @@ func TestValidator_ValidateProvisioningInput(t *testing.T) {
 
 	t.Run("Should return nil when config is correct", func(t *testing.T) {
 		//given
-		validator := NewValidator()
+		validator := NewValidator(nil)
 
 		config := gqlschema.ProvisionRuntimeInput{
 			RuntimeInput:  runtimeInput,
@@ -31,7 +33,7 @@ func TestValidator_ValidateProvisioningInput(t *testing.T) {
 
 	t.Run("Should return nil when kyma config input not provided", func(t *testing.T) {
 		//given
-		validator := NewValidator()
+		validator := NewValidator(nil)
 
 		config := gqlschema.ProvisionRuntimeInput{
 			RuntimeInput:  runtimeInput,
@@ -47,7 +49,7 @@ func TestValidator_ValidateProvisioningInput(t *testing.T) {
 
 	t.Run("Should return error when config is incorrect", func(t *testing.T) {
 		//given
-		validator := NewValidator()
+		validator := NewValidator(nil)
 
 		config := gqlschema.ProvisionRuntimeInput{}
 
@@ -60,7 +62,7 @@ func TestValidator_ValidateProvisioningInput(t *testing.T) {
 
 	t.Run("Should return error when Runtime Agent component is not passed in installation config", func(t *testing.T) {
 		//given
-		validator := NewValidator()
+		validator := NewValidator(nil)
 
 		kymaConfig := &gqlschema.KymaConfigInput{
 			Version: "1.5",
@@ -87,7 +89,7 @@ func TestValidator_ValidateProvisioningInput(t *testing.T) {
 
 	t.Run("should return error when machine image version is set, but machine image is empty", func(t *testing.T) {
 		//given
-		validator := NewValidator()
+		validator := NewValidator(nil)
 
 		testClusterConfig := clusterConfig
 		testClusterConfig.GardenerConfig.MachineImageVersion = util.PtrTo("24.3")
@@ -132,7 +134,7 @@ func TestValidator_ValidateProvisioningInput(t *testing.T) {
 			KymaConfig:    kymaConfig,
 		}
 
-		validator := NewValidator()
+		validator := NewValidator(nil)
 
 		//when
 		err := validator.ValidateProvisioningInput(config)
@@ -149,13 +151,149 @@ func TestValidator_ValidateProvisioningInput(t *testing.T) {
 		//then
 		require.Error(t, err)
 	})
+
+	t.Run("should return nil when additional worker pools are correct", func(t *testing.T) {
+		//given
+		validator := NewValidator(nil)
+		clusterConfig, runtimeInput, kymaConfig := initializeConfigs()
+		clusterConfig.GardenerConfig.AdditionalWorkerPools = []*gqlschema.WorkerPoolInput{
+			fixWorkerPoolInput("spot"),
+			fixWorkerPoolInput("high-memory"),
+		}
+
+		config := gqlschema.ProvisionRuntimeInput{
+			RuntimeInput:  runtimeInput,
+			ClusterConfig: clusterConfig,
+			KymaConfig:    kymaConfig,
+		}
+
+		//when
+		err := validator.ValidateProvisioningInput(config)
+
+		//then
+		require.NoError(t, err)
+	})
+
+	for _, testCase := range []struct {
+		description string
+		pools       []*gqlschema.WorkerPoolInput
+	}{
+		{description: "worker pool name is empty", pools: []*gqlschema.WorkerPoolInput{fixWorkerPoolInput("")}},
+		{description: "worker pool names are not unique", pools: []*gqlschema.WorkerPoolInput{fixWorkerPoolInput("spot"), fixWorkerPoolInput("spot")}},
+		{description: "worker pool uses the default worker name", pools: []*gqlschema.WorkerPoolInput{fixWorkerPoolInput("cpu-worker-0")}},
+		{description: "worker pool machine type is empty", pools: []*gqlschema.WorkerPoolInput{func() *gqlschema.WorkerPoolInput {
+			pool := fixWorkerPoolInput("spot")
+			pool.MachineType = ""
+			return pool
+		}()}},
+		{description: "worker pool autoScalerMin is greater than autoScalerMax", pools: []*gqlschema.WorkerPoolInput{func() *gqlschema.WorkerPoolInput {
+			pool := fixWorkerPoolInput("spot")
+			pool.AutoScalerMin = 6
+			return pool
+		}()}},
+		{description: "worker pool taint effect is not allowed", pools: []*gqlschema.WorkerPoolInput{func() *gqlschema.WorkerPoolInput {
+			pool := fixWorkerPoolInput("spot")
+			pool.NodeConfig = &gqlschema.NodeConfigInput{Taints: []*gqlschema.TaintInput{{Key: "dedicated", Effect: "NoWay"}}}
+			return pool
+		}()}},
+	} {
+		t.Run("should return error when "+testCase.description, func(t *testing.T) {
+			//given
+			validator := NewValidator(nil)
+			clusterConfig, runtimeInput, kymaConfig := initializeConfigs()
+			clusterConfig.GardenerConfig.AdditionalWorkerPools = testCase.pools
+
+			config := gqlschema.ProvisionRuntimeInput{
+				RuntimeInput:  runtimeInput,
+				ClusterConfig: clusterConfig,
+				KymaConfig:    kymaConfig,
+			}
+
+			//when
+			err := validator.ValidateProvisioningInput(config)
+
+			//then
+			require.Error(t, err)
+			util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		})
+	}
+}
+
+func TestValidator_ValidateNodeConfig(t *testing.T) {
+	runtimeID := "runtime-id"
+	t.Run("should return nil when node config is correct", func(t *testing.T) {
+		//given
+		validator := NewValidator(nil)
+		clusterConfig, runtimeInput, kymaConfig := initializeConfigs()
+		clusterConfig.GardenerConfig.NodeConfig = &gqlschema.NodeConfigInput{
+			Labels: gqlschema.Labels{"workload": "memory"},
+			Taints: []*gqlschema.TaintInput{
+				{Key: "dedicated", Value: util.PtrTo("memory"), Effect: "NoSchedule"},
+				{Key: "spot", Effect: "PreferNoSchedule"},
+				{Key: "maintenance", Effect: "NoExecute"},
+			},
+			CriName:       util.PtrTo("containerd"),
+			KubeletConfig: &gqlschema.KubeletConfigInput{MaxPods: util.PtrTo(200), PodPidsLimit: util.PtrTo(4096)},
+		}
+
+		config := gqlschema.ProvisionRuntimeInput{
+			RuntimeInput:  runtimeInput,
+			ClusterConfig: clusterConfig,
+			KymaConfig:    kymaConfig,
+		}
+
+		//when
+		err := validator.ValidateProvisioningInput(config)
+
+		//then
+		require.NoError(t, err)
+	})
+
+	for _, testCase := range []struct {
+		description string
+		nodeConfig  *gqlschema.NodeConfigInput
+	}{
+		{description: "taint effect is not allowed", nodeConfig: &gqlschema.NodeConfigInput{Taints: []*gqlschema.TaintInput{{Key: "dedicated", Effect: "NoWay"}}}},
+		{description: "taint effect is empty", nodeConfig: &gqlschema.NodeConfigInput{Taints: []*gqlschema.TaintInput{{Key: "dedicated"}}}},
+		{description: "taint key is empty", nodeConfig: &gqlschema.NodeConfigInput{Taints: []*gqlschema.TaintInput{{Effect: "NoSchedule"}}}},
+		{description: "label value is not a string", nodeConfig: &gqlschema.NodeConfigInput{Labels: gqlschema.Labels{"replicas": 3}}},
+		{description: "container runtime is not supported", nodeConfig: &gqlschema.NodeConfigInput{CriName: util.PtrTo("docker")}},
+		{description: "kubelet maxPods is not positive", nodeConfig: &gqlschema.NodeConfigInput{KubeletConfig: &gqlschema.KubeletConfigInput{MaxPods: util.PtrTo(0)}}},
+		{description: "kubelet podPidsLimit is not positive", nodeConfig: &gqlschema.NodeConfigInput{KubeletConfig: &gqlschema.KubeletConfigInput{PodPidsLimit: util.PtrTo(-1)}}},
+	} {
+		t.Run("should return error when "+testCase.description, func(t *testing.T) {
+			//given
+			validator := NewValidator(nil)
+			clusterConfig, runtimeInput, kymaConfig := initializeConfigs()
+			clusterConfig.GardenerConfig.NodeConfig = testCase.nodeConfig
+
+			config := gqlschema.ProvisionRuntimeInput{
+				RuntimeInput:  runtimeInput,
+				ClusterConfig: clusterConfig,
+				KymaConfig:    kymaConfig,
+			}
+
+			//when
+			err := validator.ValidateProvisioningInput(config)
+			upgradeErr := validator.ValidateUpgradeShootInput(runtimeID, gqlschema.UpgradeShootInput{
+				GardenerConfig: &gqlschema.GardenerUpgradeInput{NodeConfig: testCase.nodeConfig},
+			})
+
+			//then
+			require.Error(t, err)
+			util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+			require.Error(t, upgradeErr)
+			util.CheckErrorType(t, upgradeErr, apperrors.CodeBadRequest)
+		})
+	}
 }
 
 func TestValidator_ValidateUpgradeShootInput(t *testing.T) {
+	runtimeID := "runtime-id"
 
 	t.Run("Should return nil when input is correct", func(t *testing.T) {
 		//given
-		validator := NewValidator()
+		validator := NewValidator(nil)
 
 		input := gqlschema.UpgradeShootInput{
 			GardenerConfig: &gqlschema.GardenerUpgradeInput{
@@ -173,7 +311,7 @@ func TestValidator_ValidateUpgradeShootInput(t *testing.T) {
 		}
 
 		//when
-		err := validator.ValidateUpgradeShootInput(input)
+		err := validator.ValidateUpgradeShootInput(runtimeID, input)
 
 		//then
 		require.NoError(t, err)
@@ -181,12 +319,12 @@ func TestValidator_ValidateUpgradeShootInput(t *testing.T) {
 
 	t.Run("Should return error when Gardener config input not provided", func(t *testing.T) {
 		//given
-		validator := NewValidator()
+		validator := NewValidator(nil)
 
 		config := gqlschema.UpgradeShootInput{}
 
 		//when
-		err := validator.ValidateUpgradeShootInput(config)
+		err := validator.ValidateUpgradeShootInput(runtimeID, config)
 
 		//then
 		require.Error(t, err)
@@ -195,7 +333,7 @@ func TestValidator_ValidateUpgradeShootInput(t *testing.T) {
 
 	t.Run("Should return error when Gardener config input provide empty value for machine type", func(t *testing.T) {
 		//given
-		validator := NewValidator()
+		validator := NewValidator(nil)
 
 		input := gqlschema.UpgradeShootInput{
 			GardenerConfig: &gqlschema.GardenerUpgradeInput{
@@ -213,7 +351,7 @@ func TestValidator_ValidateUpgradeShootInput(t *testing.T) {
 		}
 
 		//when
-		err := validator.ValidateUpgradeShootInput(input)
+		err := validator.ValidateUpgradeShootInput(runtimeID, input)
 
 		//then
 		require.Error(t, err)
@@ -222,7 +360,7 @@ func TestValidator_ValidateUpgradeShootInput(t *testing.T) {
 
 	t.Run("Should return error when Gardener config input provide empty value for disk type", func(t *testing.T) {
 		//given
-		validator := NewValidator()
+		validator := NewValidator(nil)
 
 		input := gqlschema.UpgradeShootInput{
 			GardenerConfig: &gqlschema.GardenerUpgradeInput{
@@ -240,7 +378,7 @@ func TestValidator_ValidateUpgradeShootInput(t *testing.T) {
 		}
 
 		//when
-		err := validator.ValidateUpgradeShootInput(input)
+		err := validator.ValidateUpgradeShootInput(runtimeID, input)
 
 		//then
 		require.Error(t, err)
@@ -249,7 +387,7 @@ func TestValidator_ValidateUpgradeShootInput(t *testing.T) {
 
 	t.Run("Should return error when Gardener config input provide empty value for purpose", func(t *testing.T) {
 		//given
-		validator := NewValidator()
+		validator := NewValidator(nil)
 
 		input := gqlschema.UpgradeShootInput{
 			GardenerConfig: &gqlschema.GardenerUpgradeInput{
@@ -267,7 +405,7 @@ func TestValidator_ValidateUpgradeShootInput(t *testing.T) {
 		}
 
 		//when
-		err := validator.ValidateUpgradeShootInput(input)
+		err := validator.ValidateUpgradeShootInput(runtimeID, input)
 
 		//then
 		require.Error(t, err)
@@ -276,7 +414,7 @@ func TestValidator_ValidateUpgradeShootInput(t *testing.T) {
 
 	t.Run("Should return error when Gardener config input provide empty value for kubernetes version", func(t *testing.T) {
 		//given
-		validator := NewValidator()
+		validator := NewValidator(nil)
 
 		input := gqlschema.UpgradeShootInput{
 			GardenerConfig: &gqlschema.GardenerUpgradeInput{
@@ -294,12 +432,85 @@ func TestValidator_ValidateUpgradeShootInput(t *testing.T) {
 		}
 
 		//when
-		err := validator.ValidateUpgradeShootInput(input)
+		err := validator.ValidateUpgradeShootInput(runtimeID, input)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+	})
+
+	t.Run("Should return error when Gardener config input provide duplicated worker pools", func(t *testing.T) {
+		//given
+		validator := NewValidator(nil)
+
+		input := gqlschema.UpgradeShootInput{
+			GardenerConfig: &gqlschema.GardenerUpgradeInput{
+				AdditionalWorkerPools: []*gqlschema.WorkerPoolInput{fixWorkerPoolInput("spot"), fixWorkerPoolInput("spot")},
+			},
+		}
+
+		//when
+		err := validator.ValidateUpgradeShootInput(runtimeID, input)
 
 		//then
 		require.Error(t, err)
 		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
 	})
+
+	t.Run("Should return error when worker pool of OpenStack Runtime sets volume", func(t *testing.T) {
+		//given
+		readSession := &mocks.ReadSession{}
+		readSession.On("GetCluster", runtimeID).Return(model.Cluster{ID: runtimeID, ClusterConfig: model.GardenerConfig{Provider: "openstack"}}, nil)
+		validator := NewValidator(readSession)
+
+		pool := fixWorkerPoolInput("spot")
+		pool.VolumeSizeGb = util.PtrTo(50)
+		input := gqlschema.UpgradeShootInput{
+			GardenerConfig: &gqlschema.GardenerUpgradeInput{
+				AdditionalWorkerPools: []*gqlschema.WorkerPoolInput{pool},
+			},
+		}
+
+		//when
+		err := validator.ValidateUpgradeShootInput(runtimeID, input)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+	})
+
+	t.Run("Should return nil when worker pool of other provider sets volume", func(t *testing.T) {
+		//given
+		readSession := &mocks.ReadSession{}
+		readSession.On("GetCluster", runtimeID).Return(model.Cluster{ID: runtimeID, ClusterConfig: model.GardenerConfig{Provider: "aws"}}, nil)
+		validator := NewValidator(readSession)
+
+		pool := fixWorkerPoolInput("spot")
+		pool.DiskType = util.PtrTo("gp3")
+		input := gqlschema.UpgradeShootInput{
+			GardenerConfig: &gqlschema.GardenerUpgradeInput{
+				AdditionalWorkerPools: []*gqlschema.WorkerPoolInput{pool},
+			},
+		}
+
+		//when
+		err := validator.ValidateUpgradeShootInput(runtimeID, input)
+
+		//then
+		require.NoError(t, err)
+		readSession.AssertExpectations(t)
+	})
+}
+
+func fixWorkerPoolInput(name string) *gqlschema.WorkerPoolInput {
+	return &gqlschema.WorkerPoolInput{
+		Name:           name,
+		MachineType:    "m5.4xlarge",
+		AutoScalerMin:  1,
+		AutoScalerMax:  5,
+		MaxSurge:       1,
+		MaxUnavailable: 0,
+	}
 }
 
 func initializeConfigs() (*gqlschema.ClusterConfigInput, *gqlschema.RuntimeInput, *gqlschema.KymaConfigInput) {
@@ -27,16 +27,20 @@ type ErrCode int
 type CauseCode int
 
 const (
-	CodeBadGateway ErrCode = 502
-	CodeInternal   ErrCode = 500
-	CodeExternal   ErrCode = 501
-	CodeForbidden  ErrCode = 403
-	CodeBadRequest ErrCode = 400
+	CodeBadGateway      ErrCode = 502
+	CodeInternal        ErrCode = 500
+	CodeExternal        ErrCode = 501
+	CodeForbidden       ErrCode = 403
+	CodeConflict        ErrCode = 409
+	CodeBadRequest      ErrCode = 400
+	CodeTooManyRequests ErrCode = 429
+	CodeQuotaExceeded   ErrCode = 422
 )
 
 const (
 	Unknown        CauseCode = 10
 	TenantNotFound CauseCode = 11
+	ShootNotFound  CauseCode = 12
 )
 
 type AppError interface {
@@ -83,10 +87,26 @@ func BadRequest(format string, a ...interface{}) AppError {
 	return errorf(CodeBadRequest, Unknown, format, a...)
 }
 
+func Conflict(format string, a ...interface{}) AppError {
+	return errorf(CodeConflict, Unknown, format, a...)
+}
+
+func TooManyRequests(format string, a ...interface{}) AppError {
+	return errorf(CodeTooManyRequests, Unknown, format, a...)
+}
+
+func QuotaExceeded(format string, a ...interface{}) AppError {
+	return errorf(CodeQuotaExceeded, Unknown, format, a...)
+}
+
 func InvalidTenant(format string, a ...interface{}) AppError {
 	return errorf(CodeBadRequest, TenantNotFound, format, a...)
 }
 
+func MissingShoot(format string, a ...interface{}) AppError {
+	return errorf(CodeInternal, ShootNotFound, format, a...)
+}
+
 func (ae appError) Append(additionalFormat string, a ...interface{}) AppError {
 	format := additionalFormat + ", " + ae.message
 	ae.message = fmt.Sprintf(format, a...)
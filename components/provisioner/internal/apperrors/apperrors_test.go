@@ -12,6 +12,9 @@ func TestAppError(t *testing.T) {
 		assert.Equal(t, CodeInternal, Internal("error").Code())
 		assert.Equal(t, CodeForbidden, Forbidden("error").Code())
 		assert.Equal(t, CodeBadRequest, BadRequest("error").Code())
+		assert.Equal(t, CodeConflict, Conflict("error").Code())
+		assert.Equal(t, CodeTooManyRequests, TooManyRequests("error").Code())
+		assert.Equal(t, CodeQuotaExceeded, QuotaExceeded("error").Code())
 	})
 
 	t.Run("should create error with simple message", func(t *testing.T) {
@@ -0,0 +1,190 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/api/middlewares"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+type Config struct {
+	Enabled             bool          `envconfig:"default=false"`
+	IssuerURL           string        `envconfig:"optional"`
+	JWKSFile            string        `envconfig:"optional"`
+	JWKSRefreshInterval time.Duration `envconfig:"default=1h"`
+	Audience            string        `envconfig:"optional"`
+	TenantClaim         string        `envconfig:"default=tenant"`
+	SubAccountClaim     string        `envconfig:"default=sub_account"`
+	ScopeClaim          string        `envconfig:"default=scope"`
+	ReadScope           string        `envconfig:"default=provisioner:read"`
+	WriteScope          string        `envconfig:"default=provisioner:write"`
+	// AdminScope allows listing Runtimes of any tenant
+	AdminScope string `envconfig:"default=provisioner:admin"`
+}
+
+type contextKey string
+
+const principalKey contextKey = "principal"
+
+var signingMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+// Principal is the authenticated caller
+type Principal struct {
+	Subject    string
+	Tenant     string
+	SubAccount string
+	Scopes     []string
+}
+
+func (p Principal) HasScope(scope string) bool {
+	for _, s := range p.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey).(Principal)
+	return principal, ok
+}
+
+type Authenticator struct {
+	keys   KeySource
+	config Config
+	parser *jwt.Parser
+}
+
+// NewKeySource returns keys from the JWKS file if configured, otherwise keys are fetched from the OIDC issuer
+func NewKeySource(config Config) (KeySource, error) {
+	if config.JWKSFile != "" {
+		return NewFileKeySource(config.JWKSFile)
+	}
+
+	if config.IssuerURL != "" {
+		return NewOIDCKeySource(config.IssuerURL, config.JWKSRefreshInterval, &http.Client{Timeout: 30 * time.Second}), nil
+	}
+
+	return nil, errors.New("either issuer URL or JWKS file must be configured")
+}
+
+func NewAuthenticator(keys KeySource, config Config) *Authenticator {
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods(signingMethods),
+		jwt.WithExpirationRequired(),
+	}
+	if config.IssuerURL != "" {
+		options = append(options, jwt.WithIssuer(config.IssuerURL))
+	}
+	if config.Audience != "" {
+		options = append(options, jwt.WithAudience(config.Audience))
+	}
+
+	return &Authenticator{
+		keys:   keys,
+		config: config,
+		parser: jwt.NewParser(options...),
+	}
+}
+
+// Authenticate verifies the token and returns the caller identified by its claims
+func (a *Authenticator) Authenticate(ctx context.Context, rawToken string) (Principal, error) {
+	claims := jwt.MapClaims{}
+
+	_, err := a.parser.ParseWithClaims(rawToken, claims, func(token *jwt.Token) (interface{}, error) {
+		keyID, _ := token.Header["kid"].(string)
+		return a.keys.Key(ctx, keyID)
+	})
+	if err != nil {
+		return Principal{}, errors.Wrap(err, "invalid token")
+	}
+
+	subject, _ := claims.GetSubject()
+
+	return Principal{
+		Subject:    subject,
+		Tenant:     stringClaim(claims, a.config.TenantClaim),
+		SubAccount: stringClaim(claims, a.config.SubAccountClaim),
+		Scopes:     scopesClaim(claims, a.config.ScopeClaim),
+	}, nil
+}
+
+// Middleware rejects requests without valid bearer token, tenant and sub account are taken from the token instead of the headers
+func (a *Authenticator) Middleware(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rawToken, found := bearerToken(r)
+		if !found {
+			unauthorized(w, "missing bearer token")
+			return
+		}
+
+		principal, err := a.Authenticate(r.Context(), rawToken)
+		if err != nil {
+			log.Warnf("Failed to authenticate request: %s", err.Error())
+			unauthorized(w, "invalid bearer token")
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), principalKey, principal)
+		ctx = context.WithValue(ctx, middlewares.Tenant, principal.Tenant)
+		ctx = context.WithValue(ctx, middlewares.SubAccountID, principal.SubAccount)
+		ctx = context.WithValue(ctx, middlewares.Admin, a.config.AdminScope != "" && principal.HasScope(a.config.AdminScope))
+
+		handler.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	header := r.Header.Get("Authorization")
+	if len(header) < len("Bearer ") || !strings.EqualFold(header[:len("Bearer ")], "Bearer ") {
+		return "", false
+	}
+
+	token := strings.TrimSpace(header[len("Bearer "):])
+	return token, token != ""
+}
+
+func unauthorized(w http.ResponseWriter, message string) {
+	w.Header().Set("WWW-Authenticate", `Bearer realm="provisioner"`)
+	http.Error(w, message, http.StatusUnauthorized)
+}
+
+func stringClaim(claims jwt.MapClaims, name string) string {
+	if name == "" {
+		return ""
+	}
+
+	switch value := claims[name].(type) {
+	case string:
+		return value
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+// scopesClaim supports both space separated scopes and the list of scopes
+func scopesClaim(claims jwt.MapClaims, name string) []string {
+	switch value := claims[name].(type) {
+	case string:
+		return strings.Fields(value)
+	case []interface{}:
+		scopes := make([]string, 0, len(value))
+		for _, scope := range value {
+			if s, ok := scope.(string); ok {
+				scopes = append(scopes, s)
+			}
+		}
+		return scopes
+	default:
+		return nil
+	}
+}
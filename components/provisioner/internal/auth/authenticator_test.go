@@ -0,0 +1,325 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/api/middlewares"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	issuer   = "https://issuer.local"
+	audience = "provisioner"
+	tenant   = "tenant"
+	keyID    = "test-key"
+)
+
+var testConfig = Config{
+	Enabled:         true,
+	IssuerURL:       issuer,
+	Audience:        audience,
+	TenantClaim:     "tenant",
+	SubAccountClaim: "sub_account",
+	ScopeClaim:      "scope",
+	ReadScope:       "provisioner:read",
+	WriteScope:      "provisioner:write",
+	AdminScope:      "provisioner:admin",
+}
+
+func TestAuthenticator_Authenticate(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	jwksFile := writeJWKS(t, rsaJWK(keyID, &rsaKey.PublicKey), ecJWK("ec-key", &ecKey.PublicKey))
+
+	keySource, err := NewFileKeySource(jwksFile)
+	require.NoError(t, err)
+
+	authenticator := NewAuthenticator(keySource, testConfig)
+
+	t.Run("should map claims of valid token", func(t *testing.T) {
+		// given
+		token := signToken(t, jwt.SigningMethodRS256, rsaKey, keyID, validClaims())
+
+		// when
+		principal, err := authenticator.Authenticate(context.Background(), token)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, Principal{
+			Subject:    "user",
+			Tenant:     tenant,
+			SubAccount: "sub-account",
+			Scopes:     []string{"provisioner:read", "other"},
+		}, principal)
+	})
+
+	t.Run("should accept token signed with EC key and scopes as list", func(t *testing.T) {
+		// given
+		claims := validClaims()
+		claims["scope"] = []interface{}{"provisioner:write"}
+		token := signToken(t, jwt.SigningMethodES256, ecKey, "ec-key", claims)
+
+		// when
+		principal, err := authenticator.Authenticate(context.Background(), token)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"provisioner:write"}, principal.Scopes)
+	})
+
+	for _, testCase := range []struct {
+		description string
+		token       func() string
+	}{
+		{
+			description: "expired token",
+			token: func() string {
+				claims := validClaims()
+				claims["exp"] = time.Now().Add(-time.Minute).Unix()
+				return signToken(t, jwt.SigningMethodRS256, rsaKey, keyID, claims)
+			},
+		},
+		{
+			description: "token without expiration",
+			token: func() string {
+				claims := validClaims()
+				delete(claims, "exp")
+				return signToken(t, jwt.SigningMethodRS256, rsaKey, keyID, claims)
+			},
+		},
+		{
+			description: "token issued by other issuer",
+			token: func() string {
+				claims := validClaims()
+				claims["iss"] = "https://other.local"
+				return signToken(t, jwt.SigningMethodRS256, rsaKey, keyID, claims)
+			},
+		},
+		{
+			description: "token for other audience",
+			token: func() string {
+				claims := validClaims()
+				claims["aud"] = "other"
+				return signToken(t, jwt.SigningMethodRS256, rsaKey, keyID, claims)
+			},
+		},
+		{
+			description: "token signed with unknown key",
+			token: func() string {
+				return signToken(t, jwt.SigningMethodRS256, rsaKey, "unknown", validClaims())
+			},
+		},
+		{
+			description: "token signed with other key",
+			token: func() string {
+				otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+				require.NoError(t, err)
+				return signToken(t, jwt.SigningMethodRS256, otherKey, keyID, validClaims())
+			},
+		},
+		{
+			description: "token signed with HMAC",
+			token: func() string {
+				return signToken(t, jwt.SigningMethodHS256, []byte("secret"), keyID, validClaims())
+			},
+		},
+	} {
+		t.Run("should reject "+testCase.description, func(t *testing.T) {
+			// when
+			_, err := authenticator.Authenticate(context.Background(), testCase.token())
+
+			// then
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestAuthenticator_Middleware(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	keySource, err := NewFileKeySource(writeJWKS(t, rsaJWK(keyID, &rsaKey.PublicKey)))
+	require.NoError(t, err)
+
+	authenticator := NewAuthenticator(keySource, testConfig)
+
+	handler := authenticator.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, found := PrincipalFromContext(r.Context())
+		require.True(t, found)
+		assert.Equal(t, "user", principal.Subject)
+		assert.Equal(t, tenant, r.Context().Value(middlewares.Tenant))
+		assert.Equal(t, "sub-account", r.Context().Value(middlewares.SubAccountID))
+		assert.Equal(t, principal.HasScope("provisioner:admin"), r.Context().Value(middlewares.Admin))
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	t.Run("should pass request with valid token", func(t *testing.T) {
+		// given
+		req := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+		req.Header.Set("Authorization", "Bearer "+signToken(t, jwt.SigningMethodRS256, rsaKey, keyID, validClaims()))
+		// Tenant from the header cannot override the tenant from the token
+		ctx := context.WithValue(req.Context(), middlewares.Tenant, "other-tenant")
+		rr := httptest.NewRecorder()
+
+		// when
+		handler.ServeHTTP(rr, req.WithContext(ctx))
+
+		// then
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("should mark caller with admin scope as admin", func(t *testing.T) {
+		// given
+		claims := validClaims()
+		claims["scope"] = "provisioner:read provisioner:admin"
+
+		req := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+		req.Header.Set("Authorization", "Bearer "+signToken(t, jwt.SigningMethodRS256, rsaKey, keyID, claims))
+		rr := httptest.NewRecorder()
+
+		// when
+		handler.ServeHTTP(rr, req)
+
+		// then
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("should reject request without token", func(t *testing.T) {
+		// given
+		req := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+		rr := httptest.NewRecorder()
+
+		// when
+		handler.ServeHTTP(rr, req)
+
+		// then
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		assert.NotEmpty(t, rr.Header().Get("WWW-Authenticate"))
+	})
+
+	t.Run("should reject request with invalid token", func(t *testing.T) {
+		// given
+		req := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+		req.Header.Set("Authorization", "Bearer invalid")
+		rr := httptest.NewRecorder()
+
+		// when
+		handler.ServeHTTP(rr, req)
+
+		// then
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+}
+
+func TestOIDCKeySource(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	jwksRequests := 0
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{"issuer": server.URL, "jwks_uri": server.URL + "/keys"})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, _ *http.Request) {
+		jwksRequests++
+		_ = json.NewEncoder(w).Encode(jsonWebKeySet{Keys: []jsonWebKey{rsaJWK(keyID, &rsaKey.PublicKey)}})
+	})
+
+	config := testConfig
+	config.IssuerURL = server.URL
+	authenticator := NewAuthenticator(NewOIDCKeySource(server.URL, time.Hour, server.Client()), config)
+
+	claims := validClaims()
+	claims["iss"] = server.URL
+
+	// when
+	principal, err := authenticator.Authenticate(context.Background(), signToken(t, jwt.SigningMethodRS256, rsaKey, keyID, claims))
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, tenant, principal.Tenant)
+
+	// when
+	_, err = authenticator.Authenticate(context.Background(), signToken(t, jwt.SigningMethodRS256, rsaKey, "unknown", claims))
+
+	// then
+	require.Error(t, err)
+	assert.Equal(t, 1, jwksRequests, "keys should not be fetched again before the refresh interval")
+}
+
+func validClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":         issuer,
+		"aud":         audience,
+		"sub":         "user",
+		"exp":         time.Now().Add(time.Hour).Unix(),
+		"tenant":      tenant,
+		"sub_account": "sub-account",
+		"scope":       "provisioner:read other",
+	}
+}
+
+func signToken(t *testing.T, method jwt.SigningMethod, key interface{}, keyID string, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = keyID
+
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+
+	return signed
+}
+
+func writeJWKS(t *testing.T, keys ...jsonWebKey) string {
+	data, err := json.Marshal(jsonWebKeySet{Keys: keys})
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, data, 0600))
+
+	return path
+}
+
+func rsaJWK(keyID string, key *rsa.PublicKey) jsonWebKey {
+	return jsonWebKey{
+		KeyID:   keyID,
+		KeyType: "RSA",
+		Use:     "sig",
+		N:       encodeBigInt(key.N),
+		E:       encodeBigInt(big.NewInt(int64(key.E))),
+	}
+}
+
+func ecJWK(keyID string, key *ecdsa.PublicKey) jsonWebKey {
+	return jsonWebKey{
+		KeyID:   keyID,
+		KeyType: "EC",
+		Curve:   "P-256",
+		X:       encodeBigInt(key.X),
+		Y:       encodeBigInt(key.Y),
+	}
+}
+
+func encodeBigInt(value *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(value.Bytes())
+}
@@ -0,0 +1,251 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// minKeysRefreshInterval limits fetching keys when tokens signed with unknown keys are received
+const minKeysRefreshInterval = time.Minute
+
+type KeySource interface {
+	Key(ctx context.Context, keyID string) (crypto.PublicKey, error)
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+type jsonWebKey struct {
+	KeyID   string `json:"kid"`
+	KeyType string `json:"kty"`
+	Use     string `json:"use"`
+	N       string `json:"n"`
+	E       string `json:"e"`
+	Curve   string `json:"crv"`
+	X       string `json:"x"`
+	Y       string `json:"y"`
+}
+
+// parseJWKS returns signature verification keys from JSON Web Key Set, keys of unsupported types are skipped
+func parseJWKS(data []byte) (map[string]crypto.PublicKey, error) {
+	var keySet jsonWebKeySet
+	err := json.Unmarshal(data, &keySet)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode JWKS")
+	}
+
+	keys := map[string]crypto.PublicKey{}
+	for _, key := range keySet.Keys {
+		if key.Use != "" && key.Use != "sig" {
+			continue
+		}
+
+		publicKey, err := key.publicKey()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode key %s", key.KeyID)
+		}
+		if publicKey != nil {
+			keys[key.KeyID] = publicKey
+		}
+	}
+
+	return keys, nil
+}
+
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.KeyType {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		curve, err := ellipticCurve(k.Curve)
+		if err != nil {
+			return nil, err
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, nil
+	}
+}
+
+func ellipticCurve(name string) (elliptic.Curve, error) {
+	switch name {
+	case "P-256":
+		return elliptic.P256(), nil
+	case "P-384":
+		return elliptic.P384(), nil
+	case "P-521":
+		return elliptic.P521(), nil
+	default:
+		return nil, fmt.Errorf("unsupported curve %s", name)
+	}
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetBytes(data), nil
+}
+
+type staticKeySource struct {
+	keys map[string]crypto.PublicKey
+}
+
+// NewFileKeySource loads keys from JWKS file, the file is read only once
+func NewFileKeySource(path string) (KeySource, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read JWKS file %s", path)
+	}
+
+	keys, err := parseJWKS(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &staticKeySource{keys: keys}, nil
+}
+
+func (s *staticKeySource) Key(_ context.Context, keyID string) (crypto.PublicKey, error) {
+	key, found := s.keys[keyID]
+	if !found {
+		return nil, fmt.Errorf("unknown key %s", keyID)
+	}
+
+	return key, nil
+}
+
+type oidcKeySource struct {
+	issuerURL       string
+	refreshInterval time.Duration
+	httpClient      *http.Client
+
+	mutex       sync.Mutex
+	jwksURL     string
+	keys        map[string]crypto.PublicKey
+	lastRefresh time.Time
+}
+
+// NewOIDCKeySource fetches keys published by the OIDC issuer, keys are fetched again after the refresh interval or when unknown key is requested
+func NewOIDCKeySource(issuerURL string, refreshInterval time.Duration, httpClient *http.Client) KeySource {
+	return &oidcKeySource{
+		issuerURL:       strings.TrimSuffix(issuerURL, "/"),
+		refreshInterval: refreshInterval,
+		httpClient:      httpClient,
+	}
+}
+
+func (s *oidcKeySource) Key(ctx context.Context, keyID string) (crypto.PublicKey, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	key, found := s.keys[keyID]
+	sinceRefresh := time.Since(s.lastRefresh)
+
+	if found && sinceRefresh < s.refreshInterval {
+		return key, nil
+	}
+	if !found && sinceRefresh < minKeysRefreshInterval {
+		return nil, fmt.Errorf("unknown key %s", keyID)
+	}
+
+	err := s.refresh(ctx)
+	if err != nil {
+		if found {
+			// Keys fetched earlier are still used when the issuer is temporarily unavailable
+			return key, nil
+		}
+		return nil, err
+	}
+
+	key, found = s.keys[keyID]
+	if !found {
+		return nil, fmt.Errorf("unknown key %s", keyID)
+	}
+
+	return key, nil
+}
+
+func (s *oidcKeySource) refresh(ctx context.Context) error {
+	if s.jwksURL == "" {
+		var discovery struct {
+			JWKSURI string `json:"jwks_uri"`
+		}
+		err := s.getJSON(ctx, s.issuerURL+"/.well-known/openid-configuration", &discovery)
+		if err != nil {
+			return errors.Wrap(err, "failed to get OIDC discovery document")
+		}
+		if discovery.JWKSURI == "" {
+			return fmt.Errorf("OIDC discovery document of %s does not contain jwks_uri", s.issuerURL)
+		}
+		s.jwksURL = discovery.JWKSURI
+	}
+
+	var raw json.RawMessage
+	err := s.getJSON(ctx, s.jwksURL, &raw)
+	if err != nil {
+		return errors.Wrap(err, "failed to get JWKS")
+	}
+
+	keys, err := parseJWKS(raw)
+	if err != nil {
+		return err
+	}
+
+	s.keys = keys
+	s.lastRefresh = time.Now()
+
+	return nil
+}
+
+func (s *oidcKeySource) getJSON(ctx context.Context, url string, target interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d from %s", resp.StatusCode, url)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(target)
+}
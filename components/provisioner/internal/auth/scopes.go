@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+)
+
+// ScopeEnforcer is GraphQL extension verifying that the caller has scope required by the operation type,
+// queries and subscriptions require read scope and mutations require write scope, write scope grants read access as well
+type ScopeEnforcer struct {
+	ReadScope  string
+	WriteScope string
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.RootFieldInterceptor
+} = ScopeEnforcer{}
+
+func NewScopeEnforcer(config Config) ScopeEnforcer {
+	return ScopeEnforcer{
+		ReadScope:  config.ReadScope,
+		WriteScope: config.WriteScope,
+	}
+}
+
+func (e ScopeEnforcer) ExtensionName() string {
+	return "ScopeEnforcer"
+}
+
+func (e ScopeEnforcer) Validate(_ graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (e ScopeEnforcer) InterceptRootField(ctx context.Context, next graphql.RootResolver) graphql.Marshaler {
+	fieldCtx := graphql.GetRootFieldContext(ctx)
+
+	principal, found := PrincipalFromContext(ctx)
+	if !found {
+		graphql.AddError(ctx, apperrors.Forbidden("caller is not authenticated"))
+		return graphql.Null
+	}
+
+	if !e.allowed(principal, fieldCtx.Object) {
+		graphql.AddError(ctx, apperrors.Forbidden("caller is not allowed to execute %s %s", fieldCtx.Object, fieldCtx.Field.Name))
+		return graphql.Null
+	}
+
+	return next(ctx)
+}
+
+func (e ScopeEnforcer) allowed(principal Principal, object string) bool {
+	if principal.HasScope(e.WriteScope) {
+		return true
+	}
+
+	switch object {
+	case "Query", "Subscription":
+		return principal.HasScope(e.ReadScope)
+	default:
+		return false
+	}
+}
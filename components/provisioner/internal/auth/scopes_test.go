@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestScopeEnforcer_InterceptRootField(t *testing.T) {
+	enforcer := NewScopeEnforcer(testConfig)
+
+	readOnly := Principal{Tenant: tenant, Scopes: []string{"provisioner:read"}}
+	readWrite := Principal{Tenant: tenant, Scopes: []string{"provisioner:write"}}
+	noScopes := Principal{Tenant: tenant}
+
+	for _, testCase := range []struct {
+		description string
+		principal   *Principal
+		object      string
+		allowed     bool
+	}{
+		{description: "allow query with read scope", principal: &readOnly, object: "Query", allowed: true},
+		{description: "allow subscription with read scope", principal: &readOnly, object: "Subscription", allowed: true},
+		{description: "deny mutation with read scope", principal: &readOnly, object: "Mutation", allowed: false},
+		{description: "allow query with write scope", principal: &readWrite, object: "Query", allowed: true},
+		{description: "allow mutation with write scope", principal: &readWrite, object: "Mutation", allowed: true},
+		{description: "deny query without scopes", principal: &noScopes, object: "Query", allowed: false},
+		{description: "deny query without principal", principal: nil, object: "Query", allowed: false},
+	} {
+		t.Run("should "+testCase.description, func(t *testing.T) {
+			// given
+			ctx := graphql.WithResponseContext(context.Background(), graphql.DefaultErrorPresenter, graphql.DefaultRecover)
+			ctx = graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+				Object: testCase.object,
+				Field:  graphql.CollectedField{Field: &ast.Field{Name: "field"}},
+			})
+			if testCase.principal != nil {
+				ctx = context.WithValue(ctx, principalKey, *testCase.principal)
+			}
+
+			called := false
+			next := func(ctx context.Context) graphql.Marshaler {
+				called = true
+				return graphql.MarshalString("result")
+			}
+
+			// when
+			result := enforcer.InterceptRootField(ctx, next)
+
+			// then
+			assert.Equal(t, testCase.allowed, called)
+			if testCase.allowed {
+				assert.Empty(t, graphql.GetErrors(ctx))
+			} else {
+				assert.Equal(t, graphql.Null, result)
+				assert.Len(t, graphql.GetErrors(ctx), 1)
+			}
+		})
+	}
+}
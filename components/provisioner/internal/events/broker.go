@@ -0,0 +1,58 @@
+package events
+
+import (
+	"context"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+const subscriptionBufferSize = 32
+
+// Broker distributes events to all subscribers registered in the current Provisioner instance
+type Broker struct {
+	mutex       sync.RWMutex
+	subscribers map[chan OperationEvent]struct{}
+	log         logrus.FieldLogger
+}
+
+func NewBroker() *Broker {
+	return &Broker{
+		subscribers: map[chan OperationEvent]struct{}{},
+		log:         logrus.WithField("Component", "EventBroker"),
+	}
+}
+
+// Subscribe returns channel receiving events until the context is done
+func (b *Broker) Subscribe(ctx context.Context) <-chan OperationEvent {
+	subscription := make(chan OperationEvent, subscriptionBufferSize)
+
+	b.mutex.Lock()
+	b.subscribers[subscription] = struct{}{}
+	b.mutex.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		b.mutex.Lock()
+		delete(b.subscribers, subscription)
+		close(subscription)
+		b.mutex.Unlock()
+	}()
+
+	return subscription
+}
+
+// Dispatch passes the event to all subscribers, events are dropped for subscribers which do not keep up
+func (b *Broker) Dispatch(event OperationEvent) {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	for subscription := range b.subscribers {
+		select {
+		case subscription <- event:
+		default:
+			b.log.Warnf("Subscriber buffer full, dropping event for operation %s", event.OperationID)
+		}
+	}
+}
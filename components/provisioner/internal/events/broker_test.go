@@ -0,0 +1,67 @@
+package events
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBroker(t *testing.T) {
+
+	event := OperationEvent{OperationID: "operation", RuntimeID: "runtime"}
+
+	t.Run("should dispatch event to all subscribers", func(t *testing.T) {
+		// given
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		broker := NewBroker()
+		first := broker.Subscribe(ctx)
+		second := broker.Subscribe(ctx)
+
+		// when
+		broker.Dispatch(event)
+
+		// then
+		assert.Equal(t, event, <-first)
+		assert.Equal(t, event, <-second)
+	})
+
+	t.Run("should close subscription when context is done", func(t *testing.T) {
+		// given
+		ctx, cancel := context.WithCancel(context.Background())
+
+		broker := NewBroker()
+		subscription := broker.Subscribe(ctx)
+
+		// when
+		cancel()
+
+		// then
+		_, open := <-subscription
+		assert.False(t, open)
+
+		broker.mutex.RLock()
+		defer broker.mutex.RUnlock()
+		assert.Empty(t, broker.subscribers)
+	})
+
+	t.Run("should drop events when subscriber buffer is full", func(t *testing.T) {
+		// given
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		broker := NewBroker()
+		subscription := broker.Subscribe(ctx)
+
+		// when
+		for i := 0; i < subscriptionBufferSize+1; i++ {
+			broker.Dispatch(event)
+		}
+
+		// then
+		require.Len(t, subscription, subscriptionBufferSize)
+	})
+}
@@ -0,0 +1,31 @@
+package events
+
+import (
+	"context"
+)
+
+// OperationEvent notifies that the state or stage of the operation changed, current operation status should be read from the database
+type OperationEvent struct {
+	OperationID string `json:"operationID"`
+	RuntimeID   string `json:"runtimeID"`
+}
+
+//go:generate mockery --name=Publisher
+type Publisher interface {
+	Publish(event OperationEvent)
+}
+
+//go:generate mockery --name=Subscriber
+type Subscriber interface {
+	Subscribe(ctx context.Context) <-chan OperationEvent
+}
+
+type NoopPublisher struct {
+}
+
+func NewNoopPublisher() *NoopPublisher {
+	return &NoopPublisher{}
+}
+
+func (p NoopPublisher) Publish(OperationEvent) {
+}
@@ -0,0 +1,108 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	dbr "github.com/gocraft/dbr/v2"
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	notificationChannel = "operation_events"
+
+	minReconnectInterval = 1 * time.Second
+	maxReconnectInterval = 1 * time.Minute
+	pingInterval         = 90 * time.Second
+)
+
+// PostgresPublisher sends events with NOTIFY so that they are received by all Provisioner instances
+type PostgresPublisher struct {
+	connection *dbr.Connection
+	log        logrus.FieldLogger
+}
+
+func NewPostgresPublisher(connection *dbr.Connection) *PostgresPublisher {
+	return &PostgresPublisher{
+		connection: connection,
+		log:        logrus.WithField("Component", "PostgresEventPublisher"),
+	}
+}
+
+func (p *PostgresPublisher) Publish(event OperationEvent) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		p.log.Errorf("Failed to marshal event for operation %s: %s", event.OperationID, err.Error())
+		return
+	}
+
+	_, err = p.connection.Exec("SELECT pg_notify($1, $2)", notificationChannel, string(payload))
+	if err != nil {
+		p.log.Errorf("Failed to publish event for operation %s: %s", event.OperationID, err.Error())
+	}
+}
+
+// PostgresListener receives events published by all Provisioner instances with LISTEN and passes them to the Broker
+type PostgresListener struct {
+	connectionString string
+	broker           *Broker
+	log              logrus.FieldLogger
+}
+
+func NewPostgresListener(connectionString string, broker *Broker) *PostgresListener {
+	return &PostgresListener{
+		connectionString: connectionString,
+		broker:           broker,
+		log:              logrus.WithField("Component", "PostgresEventListener"),
+	}
+}
+
+// Run listens for events until the context is done
+func (l *PostgresListener) Run(ctx context.Context) error {
+	listener := pq.NewListener(l.connectionString, minReconnectInterval, maxReconnectInterval, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			l.log.Warnf("Event listener connection problem: %s", err.Error())
+		}
+	})
+	defer func() {
+		if err := listener.Close(); err != nil {
+			l.log.Warnf("Failed to close event listener: %s", err.Error())
+		}
+	}()
+
+	err := listener.Listen(notificationChannel)
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case notification := <-listener.Notify:
+			// nil notification is sent after the connection is re-established
+			if notification == nil {
+				continue
+			}
+			l.dispatch(notification.Extra)
+		case <-time.After(pingInterval):
+			if err := listener.Ping(); err != nil {
+				l.log.Warnf("Event listener ping failed: %s", err.Error())
+			}
+		}
+	}
+}
+
+func (l *PostgresListener) dispatch(payload string) {
+	var event OperationEvent
+
+	err := json.Unmarshal([]byte(payload), &event)
+	if err != nil {
+		l.log.Errorf("Failed to unmarshal event %s: %s", payload, err.Error())
+		return
+	}
+
+	l.broker.Dispatch(event)
+}
@@ -62,10 +62,51 @@ type GardenerProvisioner struct {
 	testDataWriter              OutputDataWriter
 }
 
-func (g *GardenerProvisioner) ProvisionCluster(cluster model.Cluster, operationId string) apperrors.AppError {
+func (g *GardenerProvisioner) ProvisionCluster(ctx context.Context, cluster model.Cluster, operationId string) apperrors.AppError {
+	shootTemplate, err := g.prepareShootTemplate(cluster)
+	if err != nil {
+		return err
+	}
+
+	annotate(shootTemplate, operationIDAnnotation, operationId)
+	annotate(shootTemplate, legacyOperationIDAnnotation, operationId)
+
+	if g.testDataWriter.Enabled() {
+		log.Infof("Saving Shoot spec for %s Runtime", cluster.ID)
+		path, err := g.testDataWriter.PersistShoot(shootTemplate)
+
+		if err == nil {
+			log.Infof("Shoot spec dumped to %s", path)
+		} else {
+			log.Errorf("Error marshaling Shoot spec: %s", err.Error())
+		}
+	}
+
+	_, k8serr := g.shootClient.Create(ctx, shootTemplate, v1.CreateOptions{})
+	if k8serr != nil {
+		appError := util.K8SErrorToAppError(k8serr).SetComponent(apperrors.ErrGardenerClient)
+		return appError.Append("error creating Shoot for %s cluster: %s", cluster.ID)
+	}
+
+	return nil
+}
+
+// DryRunProvisionCluster returns Shoot which would be created for the cluster without creating it
+func (g *GardenerProvisioner) DryRunProvisionCluster(ctx context.Context, cluster model.Cluster) (model.DryRunResult, apperrors.AppError) {
+	shootTemplate, err := g.prepareShootTemplate(cluster)
+	if err != nil {
+		return model.DryRunResult{}, err
+	}
+
+	setObjectFields(shootTemplate)
+
+	return newDryRunResult(&v1beta1.Shoot{}, shootTemplate)
+}
+
+func (g *GardenerProvisioner) prepareShootTemplate(cluster model.Cluster) (*v1beta1.Shoot, apperrors.AppError) {
 	shootTemplate, err := cluster.ClusterConfig.ToShootTemplate(g.namespace, cluster.Tenant, util.UnwrapOrZero(cluster.SubAccountId), cluster.ClusterConfig.OIDCConfig, cluster.ClusterConfig.DNSConfig)
 	if err != nil {
-		return err.Append("failed to convert cluster config to Shoot template")
+		return nil, err.Append("failed to convert cluster config to Shoot template")
 	}
 
 	region := cluster.ClusterConfig.Region
@@ -86,42 +127,23 @@ func (g *GardenerProvisioner) ProvisionCluster(cluster model.Cluster, operationI
 		err := g.setMaintenanceWindow(shootTemplate, region)
 
 		if err != nil {
-			return err.Append("error setting maintenance window for %s cluster", cluster.ID)
+			return nil, err.Append("error setting maintenance window for %s cluster", cluster.ID)
 		}
 	}
 
 	annotate(shootTemplate, runtimeIDAnnotation, cluster.ID)
-	annotate(shootTemplate, operationIDAnnotation, operationId)
 	annotate(shootTemplate, legacyRuntimeIDAnnotation, cluster.ID)
-	annotate(shootTemplate, legacyOperationIDAnnotation, operationId)
 
 	if g.policyConfigMapName != "" {
 		g.applyAuditConfig(shootTemplate)
 	}
 
-	if g.testDataWriter.Enabled() {
-		log.Infof("Saving Shoot spec for %s Runtime", cluster.ID)
-		path, err := g.testDataWriter.PersistShoot(shootTemplate)
-
-		if err == nil {
-			log.Infof("Shoot spec dumped to %s", path)
-		} else {
-			log.Errorf("Error marshaling Shoot spec: %s", err.Error())
-		}
-	}
-
-	_, k8serr := g.shootClient.Create(context.Background(), shootTemplate, v1.CreateOptions{})
-	if k8serr != nil {
-		appError := util.K8SErrorToAppError(k8serr).SetComponent(apperrors.ErrGardenerClient)
-		return appError.Append("error creating Shoot for %s cluster: %s", cluster.ID)
-	}
-
-	return nil
+	return shootTemplate, nil
 }
 
-func (g *GardenerProvisioner) UpgradeCluster(clusterID string, upgradeConfig model.GardenerConfig) apperrors.AppError {
+func (g *GardenerProvisioner) UpgradeCluster(ctx context.Context, clusterID string, upgradeConfig model.GardenerConfig) apperrors.AppError {
 	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
-		shoot, err := g.shootClient.Get(context.Background(), upgradeConfig.Name, v1.GetOptions{})
+		shoot, err := g.shootClient.Get(ctx, upgradeConfig.Name, v1.GetOptions{})
 		if err != nil {
 			appErr := util.K8SErrorToAppError(err).SetComponent(apperrors.ErrGardenerClient)
 			return appErr.Append("error getting Shoot for cluster ID %s and name %s", clusterID, upgradeConfig.Name)
@@ -141,7 +163,7 @@ func (g *GardenerProvisioner) UpgradeCluster(clusterID string, upgradeConfig mod
 			return apperr.Append("error during marshaling Shoot data")
 		}
 
-		_, err = g.shootClient.Patch(context.Background(), shoot.Name, types.ApplyPatchType, shootData, v1.PatchOptions{FieldManager: "provisioner", Force: util.PtrTo(true)})
+		_, err = g.shootClient.Patch(ctx, shoot.Name, types.ApplyPatchType, shootData, v1.PatchOptions{FieldManager: "provisioner", Force: util.PtrTo(true)})
 		return err
 	})
 	if err != nil {
@@ -152,8 +174,69 @@ func (g *GardenerProvisioner) UpgradeCluster(clusterID string, upgradeConfig mod
 	return nil
 }
 
-func (g *GardenerProvisioner) DeprovisionCluster(cluster model.Cluster, operationId string) (model.Operation, apperrors.AppError) {
-	shoot, err := g.shootClient.Get(context.Background(), cluster.ClusterConfig.Name, v1.GetOptions{})
+// DryRunUpgradeCluster returns Shoot with the upgrade applied and its difference from the current Shoot without modifying it
+func (g *GardenerProvisioner) DryRunUpgradeCluster(ctx context.Context, clusterID string, upgradeConfig model.GardenerConfig) (model.DryRunResult, apperrors.AppError) {
+	shoot, err := g.shootClient.Get(ctx, upgradeConfig.Name, v1.GetOptions{})
+	if err != nil {
+		appErr := util.K8SErrorToAppError(err).SetComponent(apperrors.ErrGardenerClient)
+		return model.DryRunResult{}, appErr.Append("error getting Shoot for cluster ID %s and name %s", clusterID, upgradeConfig.Name)
+	}
+
+	current := shoot.DeepCopy()
+	setObjectFields(current)
+
+	appErr := upgradeConfig.GardenerProviderConfig.EditShootConfig(upgradeConfig, shoot)
+	if appErr != nil {
+		return model.DryRunResult{}, appErr.Append("error while updating Gardener shoot configuration")
+	}
+
+	setObjectFields(shoot)
+
+	return newDryRunResult(current, shoot)
+}
+
+func (g *GardenerProvisioner) HibernateCluster(ctx context.Context, clusterID string, gardenerConfig model.GardenerConfig) apperrors.AppError {
+	return g.setHibernation(ctx, clusterID, gardenerConfig.Name, true)
+}
+
+func (g *GardenerProvisioner) WakeUpCluster(ctx context.Context, clusterID string, gardenerConfig model.GardenerConfig) apperrors.AppError {
+	return g.setHibernation(ctx, clusterID, gardenerConfig.Name, false)
+}
+
+func (g *GardenerProvisioner) setHibernation(ctx context.Context, clusterID, shootName string, enabled bool) apperrors.AppError {
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		shoot, err := g.shootClient.Get(ctx, shootName, v1.GetOptions{})
+		if err != nil {
+			appErr := util.K8SErrorToAppError(err).SetComponent(apperrors.ErrGardenerClient)
+			return appErr.Append("error getting Shoot for cluster ID %s and name %s", clusterID, shootName)
+		}
+
+		if shoot.Spec.Hibernation == nil {
+			shoot.Spec.Hibernation = &v1beta1.Hibernation{}
+		}
+		shoot.Spec.Hibernation.Enabled = util.PtrTo(enabled)
+
+		setObjectFields(shoot)
+
+		shootData, err := json.Marshal(shoot)
+		if err != nil {
+			apperr := util.K8SErrorToAppError(err).SetComponent(apperrors.ErrProvisioner)
+			return apperr.Append("error during marshaling Shoot data")
+		}
+
+		_, err = g.shootClient.Patch(ctx, shoot.Name, types.ApplyPatchType, shootData, v1.PatchOptions{FieldManager: "provisioner", Force: util.PtrTo(true)})
+		return err
+	})
+	if err != nil {
+		apperr := util.K8SErrorToAppError(err).SetComponent(apperrors.ErrGardenerClient)
+		return apperr.Append("error setting hibernation of Shoot %s to %t", shootName, enabled)
+	}
+
+	return nil
+}
+
+func (g *GardenerProvisioner) DeprovisionCluster(ctx context.Context, cluster model.Cluster, operationId string) (model.Operation, apperrors.AppError) {
+	shoot, err := g.shootClient.Get(ctx, cluster.ClusterConfig.Name, v1.GetOptions{})
 	if err != nil {
 		if k8sErrors.IsNotFound(err) {
 			message := fmt.Sprintf("Cluster %s already deleted. Proceeding to DeprovisionCluster stage.", cluster.ID)
@@ -184,7 +267,7 @@ func (g *GardenerProvisioner) DeprovisionCluster(cluster model.Cluster, operatio
 		apperr := util.K8SErrorToAppError(err).SetComponent(apperrors.ErrProvisioner)
 		return model.Operation{}, apperr.Append("error during marshaling Shoot data")
 	}
-	_, err = g.shootClient.Patch(context.Background(), shoot.Name, types.ApplyPatchType, shootData, v1.PatchOptions{FieldManager: "provisioner", Force: util.PtrTo(true)})
+	_, err = g.shootClient.Patch(ctx, shoot.Name, types.ApplyPatchType, shootData, v1.PatchOptions{FieldManager: "provisioner", Force: util.PtrTo(true)})
 
 	if err != nil {
 		appError := util.K8SErrorToAppError(err).SetComponent(apperrors.ErrGardenerClient)
@@ -55,7 +55,7 @@ func TestGardenerProvisioner_ProvisionCluster(t *testing.T) {
 		provisionerClient := NewProvisioner(gardenerNamespace, shootClient, nil, auditLogsPolicyCMName, maintWindowConfigPath, &testkit.TestDataWriter{})
 
 		// when
-		apperr := provisionerClient.ProvisionCluster(cluster, operationId)
+		apperr := provisionerClient.ProvisionCluster(context.Background(), cluster, operationId)
 		require.NoError(t, apperr)
 
 		// then
@@ -109,7 +109,7 @@ func TestGardenerProvisioner_DeprovisionCluster(t *testing.T) {
 		// when
 		sessionFactoryMock.On("NewWriteSession").Return(session)
 
-		operation, apperr := provisionerClient.DeprovisionCluster(cluster, operationId)
+		operation, apperr := provisionerClient.DeprovisionCluster(context.Background(), cluster, operationId)
 		require.NoError(t, apperr)
 
 		// then
@@ -137,7 +137,7 @@ func TestGardenerProvisioner_DeprovisionCluster(t *testing.T) {
 		sessionFactoryMock.On("NewWriteSession").Return(session)
 		session.On("MarkClusterAsDeleted", cluster.ID).Return(nil)
 
-		operation, apperr := provisionerClient.DeprovisionCluster(cluster, operationId)
+		operation, apperr := provisionerClient.DeprovisionCluster(context.Background(), cluster, operationId)
 		require.NoError(t, apperr)
 
 		// then
@@ -188,7 +188,7 @@ func TestGardenerProvisioner_UpgradeCluster(t *testing.T) {
 		provisioner := NewProvisioner(gardenerNamespace, shootClient, sessionFactory, auditLogsPolicyCMName, "", &testkit.TestDataWriter{})
 
 		// when
-		apperr := provisioner.UpgradeCluster(cluster.ID, cluster.ClusterConfig)
+		apperr := provisioner.UpgradeCluster(context.Background(), cluster.ID, cluster.ClusterConfig)
 		require.NoError(t, apperr)
 
 		// then
@@ -205,7 +205,119 @@ func TestGardenerProvisioner_UpgradeCluster(t *testing.T) {
 		provisioner := NewProvisioner(gardenerNamespace, shootClient, sessionFactory, auditLogsPolicyCMName, "", &testkit.TestDataWriter{})
 
 		// when
-		apperr := provisioner.UpgradeCluster(cluster.ID, cluster.ClusterConfig)
+		apperr := provisioner.UpgradeCluster(context.Background(), cluster.ID, cluster.ClusterConfig)
+
+		// then
+		require.Error(t, apperr)
+		assert.Equal(t, apperrors.CodeInternal, apperr.Code())
+	})
+}
+
+func TestGardenerProvisioner_DryRunUpgradeCluster(t *testing.T) {
+	initialShoot := testkit.NewTestShoot(clusterName).
+		InNamespace(gardenerNamespace).
+		WithAutoUpdate(false, false).
+		WithWorkers(testkit.NewTestWorker("peon").ToWorker()).
+		ToShoot()
+
+	gcpGardenerConfig, err := model.NewGCPGardenerConfig(&gqlschema.GCPProviderConfigInput{Zones: []string{"zone-1"}})
+	require.NoError(t, err)
+	cluster := newClusterConfig(clusterName, nil, gcpGardenerConfig, region, purpose)
+
+	t.Run("should return upgraded shoot without modifying it", func(t *testing.T) {
+		// given
+		clientset := fake.NewSimpleClientset(initialShoot)
+		shootClient := clientset.CoreV1beta1().Shoots(gardenerNamespace)
+
+		sessionFactory := &sessionMocks.Factory{}
+		provisioner := NewProvisioner(gardenerNamespace, shootClient, sessionFactory, auditLogsPolicyCMName, "", &testkit.TestDataWriter{})
+
+		// when
+		result, apperr := provisioner.DryRunUpgradeCluster(context.Background(), cluster.ID, cluster.ClusterConfig)
+		require.NoError(t, apperr)
+
+		// then
+		assert.Contains(t, result.Manifest, "kind: Shoot")
+		assert.Contains(t, result.Diff, model.ShootFieldDiff{
+			Path:     "spec.kubernetes.version",
+			OldValue: util.PtrTo(`""`),
+			NewValue: util.PtrTo(`"1.16"`),
+		})
+
+		shoot, err := shootClient.Get(context.Background(), clusterName, v1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, initialShoot, shoot)
+	})
+
+	t.Run("should return error when failed to get shoot from Gardener", func(t *testing.T) {
+		clientset := fake.NewSimpleClientset()
+		shootClient := clientset.CoreV1beta1().Shoots(gardenerNamespace)
+
+		sessionFactory := &sessionMocks.Factory{}
+		provisioner := NewProvisioner(gardenerNamespace, shootClient, sessionFactory, auditLogsPolicyCMName, "", &testkit.TestDataWriter{})
+
+		// when
+		_, apperr := provisioner.DryRunUpgradeCluster(context.Background(), cluster.ID, cluster.ClusterConfig)
+
+		// then
+		require.Error(t, apperr)
+		assert.Equal(t, apperrors.CodeInternal, apperr.Code())
+	})
+}
+
+func TestGardenerProvisioner_HibernateCluster(t *testing.T) {
+	gcpGardenerConfig, err := model.NewGCPGardenerConfig(&gqlschema.GCPProviderConfigInput{Zones: []string{"zone-1"}})
+	require.NoError(t, err)
+	cluster := newClusterConfig(clusterName, nil, gcpGardenerConfig, region, purpose)
+
+	t.Run("should enable hibernation of the shoot", func(t *testing.T) {
+		// given
+		clientset := fake.NewSimpleClientset(testkit.NewTestShoot(clusterName).InNamespace(gardenerNamespace).ToShoot())
+		shootClient := clientset.CoreV1beta1().Shoots(gardenerNamespace)
+
+		provisioner := NewProvisioner(gardenerNamespace, shootClient, &sessionMocks.Factory{}, auditLogsPolicyCMName, "", &testkit.TestDataWriter{})
+
+		// when
+		apperr := provisioner.HibernateCluster(context.Background(), cluster.ID, cluster.ClusterConfig)
+		require.NoError(t, apperr)
+
+		// then
+		shoot, err := shootClient.Get(context.Background(), clusterName, v1.GetOptions{})
+		require.NoError(t, err)
+		require.NotNil(t, shoot.Spec.Hibernation)
+		assert.True(t, *shoot.Spec.Hibernation.Enabled)
+	})
+
+	t.Run("should disable hibernation of the shoot on wake up", func(t *testing.T) {
+		// given
+		initialShoot := testkit.NewTestShoot(clusterName).InNamespace(gardenerNamespace).ToShoot()
+		initialShoot.Spec.Hibernation = &gardener_types.Hibernation{Enabled: util.PtrTo(true)}
+
+		clientset := fake.NewSimpleClientset(initialShoot)
+		shootClient := clientset.CoreV1beta1().Shoots(gardenerNamespace)
+
+		provisioner := NewProvisioner(gardenerNamespace, shootClient, &sessionMocks.Factory{}, auditLogsPolicyCMName, "", &testkit.TestDataWriter{})
+
+		// when
+		apperr := provisioner.WakeUpCluster(context.Background(), cluster.ID, cluster.ClusterConfig)
+		require.NoError(t, apperr)
+
+		// then
+		shoot, err := shootClient.Get(context.Background(), clusterName, v1.GetOptions{})
+		require.NoError(t, err)
+		require.NotNil(t, shoot.Spec.Hibernation)
+		assert.False(t, *shoot.Spec.Hibernation.Enabled)
+	})
+
+	t.Run("should return error when failed to get shoot from Gardener", func(t *testing.T) {
+		// given
+		clientset := fake.NewSimpleClientset()
+		shootClient := clientset.CoreV1beta1().Shoots(gardenerNamespace)
+
+		provisioner := NewProvisioner(gardenerNamespace, shootClient, &sessionMocks.Factory{}, auditLogsPolicyCMName, "", &testkit.TestDataWriter{})
+
+		// when
+		apperr := provisioner.HibernateCluster(context.Background(), cluster.ID, cluster.ClusterConfig)
 
 		// then
 		require.Error(t, apperr)
@@ -260,9 +372,9 @@ func TestGardenerProvisioner_ClusterPurpose(t *testing.T) {
 		provisionerClient_B := NewProvisioner(gardenerNamespace, shootClient_B, nil, auditLogsPolicyCMName, maintWindowConfigPath, &testkit.TestDataWriter{})
 
 		//when
-		apperr_A := provisionerClient_A.ProvisionCluster(cluster_A, operationId)
+		apperr_A := provisionerClient_A.ProvisionCluster(context.Background(), cluster_A, operationId)
 		require.NoError(t, apperr_A)
-		apperr_B := provisionerClient_B.ProvisionCluster(cluster_B, operationId)
+		apperr_B := provisionerClient_B.ProvisionCluster(context.Background(), cluster_B, operationId)
 		require.NoError(t, apperr_B)
 
 		//then
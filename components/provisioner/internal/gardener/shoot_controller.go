@@ -1,9 +1,13 @@
 package gardener
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/uuid"
 
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
@@ -17,7 +21,8 @@ import (
 func NewShootController(
 	mgr manager.Manager,
 	dbsFactory dbsession.Factory,
-	auditLogTenantConfigPath string) (*ShootController, error) {
+	auditLogTenantConfigPath string,
+	driftPolicies map[string]model.DriftPolicy) (*ShootController, error) {
 
 	err := gardener_types.AddToScheme(mgr.GetScheme())
 	if err != nil {
@@ -26,7 +31,7 @@ func NewShootController(
 
 	err = ctrl.NewControllerManagedBy(mgr).
 		For(&gardener_types.Shoot{}).
-		Complete(NewReconciler(mgr, dbsFactory, NewAuditLogConfigurator(auditLogTenantConfigPath)))
+		Complete(NewReconciler(mgr, dbsFactory, NewAuditLogConfigurator(auditLogTenantConfigPath), NewDriftDetector(driftPolicies), uuid.NewUUIDGenerator()))
 	if err != nil {
 		return nil, fmt.Errorf("unable to create controller: %w", err)
 	}
@@ -43,11 +48,21 @@ type ShootController struct {
 	log               *logrus.Entry
 }
 
-func (sc *ShootController) StartShootController() error {
+// StartShootController blocks until the context is done and the controller manager is stopped
+func (sc *ShootController) StartShootController(ctx context.Context) error {
 	// Start Controller
-	if err := sc.controllerManager.Start(ctrl.SetupSignalHandler()); err != nil {
+	if err := sc.controllerManager.Start(ctx); err != nil {
 		return fmt.Errorf("error starting shoot controller: %w", err)
 	}
 
 	return nil
 }
+
+// CacheSynced returns error when the cache of shoots is not synced before the context is done
+func (sc *ShootController) CacheSynced(ctx context.Context) error {
+	if !sc.controllerManager.GetCache().WaitForCacheSync(ctx) {
+		return errors.New("cache of shoots is not synced")
+	}
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package gardener
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"sigs.k8s.io/yaml"
+)
+
+func newDryRunResult(current, desired *v1beta1.Shoot) (model.DryRunResult, apperrors.AppError) {
+	manifest, err := yaml.Marshal(desired)
+	if err != nil {
+		return model.DryRunResult{}, apperrors.Internal("error during marshaling Shoot data: %s", err.Error())
+	}
+
+	diff, err := diffShoots(current, desired)
+	if err != nil {
+		return model.DryRunResult{}, apperrors.Internal("error while comparing Shoots: %s", err.Error())
+	}
+
+	return model.DryRunResult{
+		Manifest: string(manifest),
+		Diff:     diff,
+	}, nil
+}
+
+// diffShoots compares Shoots field by field, nested objects and lists are compared element by element
+func diffShoots(current, desired *v1beta1.Shoot) ([]model.ShootFieldDiff, error) {
+	currentFields, err := toFields(current)
+	if err != nil {
+		return nil, err
+	}
+
+	desiredFields, err := toFields(desired)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := make([]model.ShootFieldDiff, 0)
+	err = diffFields("", currentFields, desiredFields, &diff)
+	if err != nil {
+		return nil, err
+	}
+
+	return diff, nil
+}
+
+func toFields(shoot *v1beta1.Shoot) (interface{}, error) {
+	data, err := json.Marshal(shoot)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields interface{}
+	err = json.Unmarshal(data, &fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+func diffFields(path string, oldValue, newValue interface{}, diff *[]model.ShootFieldDiff) error {
+	oldObject, oldIsObject := asObject(oldValue)
+	newObject, newIsObject := asObject(newValue)
+	if oldIsObject && newIsObject {
+		for _, key := range sortedKeys(oldObject, newObject) {
+			err := diffFields(joinPath(path, key), oldObject[key], newObject[key], diff)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	oldList, oldIsList := asList(oldValue)
+	newList, newIsList := asList(newValue)
+	if oldIsList && newIsList {
+		for i := 0; i < len(oldList) || i < len(newList); i++ {
+			err := diffFields(fmt.Sprintf("%s[%d]", path, i), elementAt(oldList, i), elementAt(newList, i), diff)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if reflect.DeepEqual(oldValue, newValue) {
+		return nil
+	}
+
+	oldEncoded, err := encodeField(oldValue)
+	if err != nil {
+		return err
+	}
+
+	newEncoded, err := encodeField(newValue)
+	if err != nil {
+		return err
+	}
+
+	*diff = append(*diff, model.ShootFieldDiff{
+		Path:     path,
+		OldValue: oldEncoded,
+		NewValue: newEncoded,
+	})
+
+	return nil
+}
+
+// asObject treats missing value as an empty object so that added or removed objects are reported field by field
+func asObject(value interface{}) (map[string]interface{}, bool) {
+	if value == nil {
+		return map[string]interface{}{}, true
+	}
+
+	object, ok := value.(map[string]interface{})
+	return object, ok
+}
+
+// asList treats missing value as an empty list so that added or removed lists are reported element by element
+func asList(value interface{}) ([]interface{}, bool) {
+	if value == nil {
+		return []interface{}{}, true
+	}
+
+	list, ok := value.([]interface{})
+	return list, ok
+}
+
+func elementAt(list []interface{}, index int) interface{} {
+	if index < len(list) {
+		return list[index]
+	}
+
+	return nil
+}
+
+func sortedKeys(objects ...map[string]interface{}) []string {
+	keySet := map[string]struct{}{}
+	for _, object := range objects {
+		for key := range object {
+			keySet[key] = struct{}{}
+		}
+	}
+
+	keys := make([]string, 0, len(keySet))
+	for key := range keySet {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+func encodeField(value interface{}) (*string, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return util.PtrTo(string(encoded)), nil
+}
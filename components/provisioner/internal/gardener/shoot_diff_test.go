@@ -0,0 +1,64 @@
+package gardener
+
+import (
+	"testing"
+
+	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_diffShoots(t *testing.T) {
+
+	t.Run("should return changed, added and removed fields", func(t *testing.T) {
+		// given
+		current := &v1beta1.Shoot{
+			ObjectMeta: v1.ObjectMeta{Name: "shoot", Labels: map[string]string{"removed": "value"}},
+			Spec: v1beta1.ShootSpec{
+				Kubernetes: v1beta1.Kubernetes{Version: "1.25"},
+				Provider: v1beta1.Provider{
+					Workers: []v1beta1.Worker{{Name: "cpu-worker-0", Maximum: 3}},
+				},
+			},
+		}
+
+		desired := current.DeepCopy()
+		desired.Labels = nil
+		desired.Spec.Kubernetes.Version = "1.26"
+		desired.Spec.Provider.Workers[0].Maximum = 5
+		desired.Spec.Provider.Workers = append(desired.Spec.Provider.Workers, v1beta1.Worker{Name: "cpu-worker-1", Machine: v1beta1.Machine{Type: "m5.xlarge"}})
+
+		// when
+		diff, err := diffShoots(current, desired)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []model.ShootFieldDiff{
+			{Path: "metadata.labels.removed", OldValue: util.PtrTo(`"value"`)},
+			{Path: "spec.kubernetes.version", OldValue: util.PtrTo(`"1.25"`), NewValue: util.PtrTo(`"1.26"`)},
+			{Path: "spec.provider.workers[0].maximum", OldValue: util.PtrTo("3"), NewValue: util.PtrTo("5")},
+			{Path: "spec.provider.workers[1].machine.type", NewValue: util.PtrTo(`"m5.xlarge"`)},
+			{Path: "spec.provider.workers[1].maximum", NewValue: util.PtrTo("0")},
+			{Path: "spec.provider.workers[1].minimum", NewValue: util.PtrTo("0")},
+			{Path: "spec.provider.workers[1].name", NewValue: util.PtrTo(`"cpu-worker-1"`)},
+		}, diff)
+	})
+
+	t.Run("should return empty diff for equal shoots", func(t *testing.T) {
+		// given
+		shoot := &v1beta1.Shoot{
+			ObjectMeta: v1.ObjectMeta{Name: "shoot"},
+			Spec:       v1beta1.ShootSpec{Region: "eu-west-1"},
+		}
+
+		// when
+		diff, err := diffShoots(shoot, shoot.DeepCopy())
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, diff)
+	})
+}
@@ -0,0 +1,467 @@
+package gardener
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	DriftFieldKubernetesVersion             = "kubernetesVersion"
+	DriftFieldMachineType                   = "machineType"
+	DriftFieldMachineImage                  = "machineImage"
+	DriftFieldMachineImageVersion           = "machineImageVersion"
+	DriftFieldAutoScalerMin                 = "autoScalerMin"
+	DriftFieldAutoScalerMax                 = "autoScalerMax"
+	DriftFieldMaxSurge                      = "maxSurge"
+	DriftFieldMaxUnavailable                = "maxUnavailable"
+	DriftFieldOIDCConfig                    = "oidcConfig"
+	DriftFieldShootNetworkingFilterDisabled = "shootNetworkingFilterDisabled"
+)
+
+// driftField compares a single Shoot field with the stored configuration, revert and adopt are nil when the policy is not supported for the field
+type driftField struct {
+	name string
+	// actual returns false when the field is not set in the Shoot
+	actual   func(shoot gardener_types.Shoot) (string, bool)
+	expected func(config model.GardenerConfig) string
+	revert   func(config model.GardenerConfig, shoot *gardener_types.Shoot) error
+	adopt    func(shoot gardener_types.Shoot, config *model.GardenerConfig) error
+}
+
+var driftFields = []driftField{
+	{
+		name: DriftFieldKubernetesVersion,
+		actual: func(shoot gardener_types.Shoot) (string, bool) {
+			return shoot.Spec.Kubernetes.Version, shoot.Spec.Kubernetes.Version != ""
+		},
+		expected: func(config model.GardenerConfig) string {
+			return config.KubernetesVersion
+		},
+		// Kubernetes version cannot be downgraded, so it is never reverted
+		adopt: func(shoot gardener_types.Shoot, config *model.GardenerConfig) error {
+			config.KubernetesVersion = shoot.Spec.Kubernetes.Version
+			return nil
+		},
+	},
+	{
+		name: DriftFieldMachineType,
+		actual: func(shoot gardener_types.Shoot) (string, bool) {
+			worker, found := firstWorker(shoot)
+			if !found {
+				return "", false
+			}
+			return worker.Machine.Type, true
+		},
+		expected: func(config model.GardenerConfig) string {
+			return config.MachineType
+		},
+		revert: func(config model.GardenerConfig, shoot *gardener_types.Shoot) error {
+			shoot.Spec.Provider.Workers[0].Machine.Type = config.MachineType
+			return nil
+		},
+		adopt: func(shoot gardener_types.Shoot, config *model.GardenerConfig) error {
+			config.MachineType = shoot.Spec.Provider.Workers[0].Machine.Type
+			return nil
+		},
+	},
+	{
+		name: DriftFieldMachineImage,
+		actual: func(shoot gardener_types.Shoot) (string, bool) {
+			worker, found := firstWorker(shoot)
+			if !found || worker.Machine.Image == nil {
+				return "", false
+			}
+			return worker.Machine.Image.Name, true
+		},
+		expected: func(config model.GardenerConfig) string {
+			return util.UnwrapOrZero(config.MachineImage)
+		},
+		// Machine image is maintained by Gardener, so it is never reverted
+		adopt: func(shoot gardener_types.Shoot, config *model.GardenerConfig) error {
+			config.MachineImage = util.PtrTo(shoot.Spec.Provider.Workers[0].Machine.Image.Name)
+			return nil
+		},
+	},
+	{
+		name: DriftFieldMachineImageVersion,
+		actual: func(shoot gardener_types.Shoot) (string, bool) {
+			worker, found := firstWorker(shoot)
+			if !found || worker.Machine.Image == nil || worker.Machine.Image.Version == nil {
+				return "", false
+			}
+			return *worker.Machine.Image.Version, true
+		},
+		expected: func(config model.GardenerConfig) string {
+			return util.UnwrapOrZero(config.MachineImageVersion)
+		},
+		// Machine image version is updated by Gardener during maintenance and cannot be downgraded, so it is never reverted
+		adopt: func(shoot gardener_types.Shoot, config *model.GardenerConfig) error {
+			config.MachineImageVersion = util.PtrTo(*shoot.Spec.Provider.Workers[0].Machine.Image.Version)
+			return nil
+		},
+	},
+	{
+		name: DriftFieldAutoScalerMin,
+		actual: func(shoot gardener_types.Shoot) (string, bool) {
+			worker, found := firstWorker(shoot)
+			if !found {
+				return "", false
+			}
+			return strconv.Itoa(int(worker.Minimum)), true
+		},
+		expected: func(config model.GardenerConfig) string {
+			return strconv.Itoa(config.AutoScalerMin)
+		},
+		revert: func(config model.GardenerConfig, shoot *gardener_types.Shoot) error {
+			shoot.Spec.Provider.Workers[0].Minimum = int32(config.AutoScalerMin)
+			return nil
+		},
+		adopt: func(shoot gardener_types.Shoot, config *model.GardenerConfig) error {
+			config.AutoScalerMin = int(shoot.Spec.Provider.Workers[0].Minimum)
+			return nil
+		},
+	},
+	{
+		name: DriftFieldAutoScalerMax,
+		actual: func(shoot gardener_types.Shoot) (string, bool) {
+			worker, found := firstWorker(shoot)
+			if !found {
+				return "", false
+			}
+			return strconv.Itoa(int(worker.Maximum)), true
+		},
+		expected: func(config model.GardenerConfig) string {
+			return strconv.Itoa(config.AutoScalerMax)
+		},
+		revert: func(config model.GardenerConfig, shoot *gardener_types.Shoot) error {
+			shoot.Spec.Provider.Workers[0].Maximum = int32(config.AutoScalerMax)
+			return nil
+		},
+		adopt: func(shoot gardener_types.Shoot, config *model.GardenerConfig) error {
+			config.AutoScalerMax = int(shoot.Spec.Provider.Workers[0].Maximum)
+			return nil
+		},
+	},
+	{
+		name: DriftFieldMaxSurge,
+		actual: func(shoot gardener_types.Shoot) (string, bool) {
+			worker, found := firstWorker(shoot)
+			if !found || worker.MaxSurge == nil {
+				return "", false
+			}
+			return worker.MaxSurge.String(), true
+		},
+		expected: func(config model.GardenerConfig) string {
+			return strconv.Itoa(config.MaxSurge)
+		},
+		revert: func(config model.GardenerConfig, shoot *gardener_types.Shoot) error {
+			shoot.Spec.Provider.Workers[0].MaxSurge = util.PtrTo(intstr.FromInt(config.MaxSurge))
+			return nil
+		},
+		adopt: func(shoot gardener_types.Shoot, config *model.GardenerConfig) error {
+			maxSurge, err := intValue(*shoot.Spec.Provider.Workers[0].MaxSurge)
+			if err != nil {
+				return err
+			}
+			config.MaxSurge = maxSurge
+			return nil
+		},
+	},
+	{
+		name: DriftFieldMaxUnavailable,
+		actual: func(shoot gardener_types.Shoot) (string, bool) {
+			worker, found := firstWorker(shoot)
+			if !found || worker.MaxUnavailable == nil {
+				return "", false
+			}
+			return worker.MaxUnavailable.String(), true
+		},
+		expected: func(config model.GardenerConfig) string {
+			return strconv.Itoa(config.MaxUnavailable)
+		},
+		revert: func(config model.GardenerConfig, shoot *gardener_types.Shoot) error {
+			shoot.Spec.Provider.Workers[0].MaxUnavailable = util.PtrTo(intstr.FromInt(config.MaxUnavailable))
+			return nil
+		},
+		adopt: func(shoot gardener_types.Shoot, config *model.GardenerConfig) error {
+			maxUnavailable, err := intValue(*shoot.Spec.Provider.Workers[0].MaxUnavailable)
+			if err != nil {
+				return err
+			}
+			config.MaxUnavailable = maxUnavailable
+			return nil
+		},
+	},
+	{
+		name: DriftFieldOIDCConfig,
+		actual: func(shoot gardener_types.Shoot) (string, bool) {
+			return oidcConfigValue(shootOIDCConfig(shoot)), true
+		},
+		expected: func(config model.GardenerConfig) string {
+			return oidcConfigValue(config.OIDCConfig)
+		},
+		revert: func(config model.GardenerConfig, shoot *gardener_types.Shoot) error {
+			if config.OIDCConfig == nil {
+				return errors.New("OIDC config is not stored")
+			}
+			if shoot.Spec.Kubernetes.KubeAPIServer == nil {
+				shoot.Spec.Kubernetes.KubeAPIServer = &gardener_types.KubeAPIServerConfig{}
+			}
+			shoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig = &gardener_types.OIDCConfig{
+				ClientID:       util.PtrTo(config.OIDCConfig.ClientID),
+				GroupsClaim:    util.PtrTo(config.OIDCConfig.GroupsClaim),
+				IssuerURL:      util.PtrTo(config.OIDCConfig.IssuerURL),
+				SigningAlgs:    config.OIDCConfig.SigningAlgs,
+				UsernameClaim:  util.PtrTo(config.OIDCConfig.UsernameClaim),
+				UsernamePrefix: util.PtrTo(config.OIDCConfig.UsernamePrefix),
+			}
+			return nil
+		},
+		adopt: func(shoot gardener_types.Shoot, config *model.GardenerConfig) error {
+			oidcConfig := shootOIDCConfig(shoot)
+			if oidcConfig == nil {
+				return errors.New("removal of OIDC config cannot be stored")
+			}
+			config.OIDCConfig = oidcConfig
+			return nil
+		},
+	},
+	{
+		name: DriftFieldShootNetworkingFilterDisabled,
+		actual: func(shoot gardener_types.Shoot) (string, bool) {
+			extension, found := shootNetworkingFilterExtension(shoot)
+			if !found || extension.Disabled == nil {
+				return "", false
+			}
+			return strconv.FormatBool(*extension.Disabled), true
+		},
+		expected: func(config model.GardenerConfig) string {
+			return strconv.FormatBool(util.UnwrapOrDefault(config.ShootNetworkingFilterDisabled, model.ShootNetworkingFilterDisabledDefault))
+		},
+		revert: func(config model.GardenerConfig, shoot *gardener_types.Shoot) error {
+			disabled := util.UnwrapOrDefault(config.ShootNetworkingFilterDisabled, model.ShootNetworkingFilterDisabledDefault)
+			for i := range shoot.Spec.Extensions {
+				if shoot.Spec.Extensions[i].Type == model.ShootNetworkingFilterExtensionType {
+					shoot.Spec.Extensions[i].Disabled = util.PtrTo(disabled)
+				}
+			}
+			return nil
+		},
+		adopt: func(shoot gardener_types.Shoot, config *model.GardenerConfig) error {
+			extension, _ := shootNetworkingFilterExtension(shoot)
+			config.ShootNetworkingFilterDisabled = util.PtrTo(*extension.Disabled)
+			return nil
+		},
+	},
+}
+
+// defaultDriftPolicies keep the stored configuration in sync with the fields maintained by Gardener, i.e. versions updated during maintenance
+// and worker rollout settings defaulted by Gardener, so that the Runtime status reports what is really running.
+// Drift of fields which are changed only by manual edits of the Shoot is only recorded
+var defaultDriftPolicies = map[string]model.DriftPolicy{
+	DriftFieldKubernetesVersion:   model.DriftPolicyAdopt,
+	DriftFieldMachineImage:        model.DriftPolicyAdopt,
+	DriftFieldMachineImageVersion: model.DriftPolicyAdopt,
+	DriftFieldMaxSurge:            model.DriftPolicyAdopt,
+	DriftFieldMaxUnavailable:      model.DriftPolicyAdopt,
+}
+
+// ParseDriftPolicies parses entries in the form of "field=policy" which override default policies, drift of other fields is only recorded
+func ParseDriftPolicies(entries []string) (map[string]model.DriftPolicy, error) {
+	policies := make(map[string]model.DriftPolicy, len(entries))
+
+	for _, entry := range entries {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+
+		field, policy, found := strings.Cut(entry, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid drift policy %q, expected field=policy", entry)
+		}
+		field = strings.TrimSpace(field)
+		policy = strings.TrimSpace(policy)
+
+		driftField, found := findDriftField(field)
+		if !found {
+			return nil, fmt.Errorf("unknown drift field %q", field)
+		}
+
+		switch model.DriftPolicy(policy) {
+		case model.DriftPolicyRecord:
+		case model.DriftPolicyRevert:
+			if driftField.revert == nil {
+				return nil, fmt.Errorf("drift of %q field cannot be reverted", field)
+			}
+		case model.DriftPolicyAdopt:
+			if driftField.adopt == nil {
+				return nil, fmt.Errorf("drift of %q field cannot be adopted", field)
+			}
+		default:
+			return nil, fmt.Errorf("unknown drift policy %q for %q field", policy, field)
+		}
+
+		policies[field] = model.DriftPolicy(policy)
+	}
+
+	return policies, nil
+}
+
+// DriftDetector compares the Shoot with the configuration stored in the database
+type DriftDetector struct {
+	policies map[string]model.DriftPolicy
+}
+
+func NewDriftDetector(policies map[string]model.DriftPolicy) DriftDetector {
+	return DriftDetector{policies: policies}
+}
+
+// DriftResult contains the detected drift, Shoot and Config are modified according to policies of the drifted fields
+type DriftResult struct {
+	Drifts         []model.ShootDrift
+	Shoot          *gardener_types.Shoot
+	ShootModified  bool
+	Config         model.GardenerConfig
+	ConfigModified bool
+}
+
+// Detect returns the drift of the Shoot, drift which cannot be reverted or adopted is recorded only
+func (d DriftDetector) Detect(logger logrus.FieldLogger, shoot gardener_types.Shoot, config model.GardenerConfig, detectedAt time.Time) DriftResult {
+	result := DriftResult{
+		Shoot:  shoot.DeepCopy(),
+		Config: config,
+	}
+
+	for _, field := range driftFields {
+		actual, found := field.actual(shoot)
+		if !found {
+			continue
+		}
+		expected := field.expected(config)
+		if actual == expected {
+			continue
+		}
+
+		policy := d.policy(field.name)
+
+		switch policy {
+		case model.DriftPolicyRevert:
+			if err := field.revert(config, result.Shoot); err != nil {
+				logger.Warnf("Cannot revert drift of %s field: %s", field.name, err.Error())
+				policy = model.DriftPolicyRecord
+			} else {
+				result.ShootModified = true
+			}
+		case model.DriftPolicyAdopt:
+			if err := field.adopt(shoot, &result.Config); err != nil {
+				logger.Warnf("Cannot adopt drift of %s field: %s", field.name, err.Error())
+				policy = model.DriftPolicyRecord
+			} else {
+				result.ConfigModified = true
+			}
+		}
+
+		result.Drifts = append(result.Drifts, model.ShootDrift{
+			ClusterID:     config.ClusterID,
+			Field:         field.name,
+			ExpectedValue: expected,
+			ActualValue:   actual,
+			Policy:        policy,
+			DetectedAt:    detectedAt,
+		})
+	}
+
+	return result
+}
+
+func (d DriftDetector) policy(field string) model.DriftPolicy {
+	if policy, found := d.policies[field]; found {
+		return policy
+	}
+	if policy, found := defaultDriftPolicies[field]; found {
+		return policy
+	}
+
+	return model.DriftPolicyRecord
+}
+
+func findDriftField(name string) (driftField, bool) {
+	for _, field := range driftFields {
+		if field.name == name {
+			return field, true
+		}
+	}
+
+	return driftField{}, false
+}
+
+func firstWorker(shoot gardener_types.Shoot) (gardener_types.Worker, bool) {
+	// We support only single working group during provisioning
+	if len(shoot.Spec.Provider.Workers) == 0 {
+		return gardener_types.Worker{}, false
+	}
+
+	return shoot.Spec.Provider.Workers[0], true
+}
+
+func intValue(value intstr.IntOrString) (int, error) {
+	if value.Type != intstr.Int {
+		return 0, fmt.Errorf("value %s is not a number", value.String())
+	}
+
+	return value.IntValue(), nil
+}
+
+func shootNetworkingFilterExtension(shoot gardener_types.Shoot) (gardener_types.Extension, bool) {
+	for _, extension := range shoot.Spec.Extensions {
+		if extension.Type == model.ShootNetworkingFilterExtensionType {
+			return extension, true
+		}
+	}
+
+	return gardener_types.Extension{}, false
+}
+
+func shootOIDCConfig(shoot gardener_types.Shoot) *model.OIDCConfig {
+	if shoot.Spec.Kubernetes.KubeAPIServer == nil || shoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig == nil {
+		return nil
+	}
+	oidcConfig := shoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig
+
+	return &model.OIDCConfig{
+		ClientID:       util.UnwrapOrZero(oidcConfig.ClientID),
+		GroupsClaim:    util.UnwrapOrZero(oidcConfig.GroupsClaim),
+		IssuerURL:      util.UnwrapOrZero(oidcConfig.IssuerURL),
+		SigningAlgs:    oidcConfig.SigningAlgs,
+		UsernameClaim:  util.UnwrapOrZero(oidcConfig.UsernameClaim),
+		UsernamePrefix: util.UnwrapOrZero(oidcConfig.UsernamePrefix),
+	}
+}
+
+// oidcConfigValue treats missing and empty configuration as the same value
+func oidcConfigValue(oidcConfig *model.OIDCConfig) string {
+	if oidcConfig == nil {
+		oidcConfig = &model.OIDCConfig{}
+	}
+	value := *oidcConfig
+	if len(value.SigningAlgs) == 0 {
+		value.SigningAlgs = nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+
+	return string(data)
+}
@@ -0,0 +1,292 @@
+package gardener
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestParseDriftPolicies(t *testing.T) {
+
+	t.Run("should parse policies", func(t *testing.T) {
+		// when
+		policies, err := ParseDriftPolicies([]string{"machineType=revert", " kubernetesVersion = adopt", "", "oidcConfig=record"})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, map[string]model.DriftPolicy{
+			DriftFieldMachineType:       model.DriftPolicyRevert,
+			DriftFieldKubernetesVersion: model.DriftPolicyAdopt,
+			DriftFieldOIDCConfig:        model.DriftPolicyRecord,
+		}, policies)
+	})
+
+	for _, testCase := range []struct {
+		description string
+		entry       string
+	}{
+		{description: "entry without policy", entry: "machineType"},
+		{description: "unknown field", entry: "purpose=revert"},
+		{description: "unknown policy", entry: "machineType=ignore"},
+		{description: "policy not supported by the field", entry: "kubernetesVersion=revert"},
+	} {
+		t.Run("should return error for "+testCase.description, func(t *testing.T) {
+			// when
+			_, err := ParseDriftPolicies([]string{testCase.entry})
+
+			// then
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestDriftDetector_Detect(t *testing.T) {
+	detectedAt := time.Now()
+
+	config := model.GardenerConfig{
+		ClusterID:         "runtime-id",
+		KubernetesVersion: "1.27.5",
+		MachineType:       "m5.xlarge",
+		AutoScalerMin:     2,
+		AutoScalerMax:     4,
+		MaxSurge:          1,
+		MaxUnavailable:    0,
+		OIDCConfig: &model.OIDCConfig{
+			ClientID:       "client",
+			GroupsClaim:    "groups",
+			IssuerURL:      "https://issuer.example.com",
+			SigningAlgs:    []string{"RS256"},
+			UsernameClaim:  "sub",
+			UsernamePrefix: "-",
+		},
+		ShootNetworkingFilterDisabled: util.PtrTo(true),
+	}
+
+	newShoot := func() v1beta1.Shoot {
+		return v1beta1.Shoot{
+			ObjectMeta: v1.ObjectMeta{Name: "shoot"},
+			Spec: v1beta1.ShootSpec{
+				Kubernetes: v1beta1.Kubernetes{
+					Version: "1.27.5",
+					KubeAPIServer: &v1beta1.KubeAPIServerConfig{
+						OIDCConfig: &v1beta1.OIDCConfig{
+							ClientID:       util.PtrTo("client"),
+							GroupsClaim:    util.PtrTo("groups"),
+							IssuerURL:      util.PtrTo("https://issuer.example.com"),
+							SigningAlgs:    []string{"RS256"},
+							UsernameClaim:  util.PtrTo("sub"),
+							UsernamePrefix: util.PtrTo("-"),
+						},
+					},
+				},
+				Provider: v1beta1.Provider{
+					Workers: []v1beta1.Worker{{
+						Name:           "cpu-worker-0",
+						Machine:        v1beta1.Machine{Type: "m5.xlarge"},
+						Minimum:        2,
+						Maximum:        4,
+						MaxSurge:       util.PtrTo(intstr.FromInt(1)),
+						MaxUnavailable: util.PtrTo(intstr.FromInt(0)),
+					}},
+				},
+				Extensions: []v1beta1.Extension{
+					{Type: model.ShootNetworkingFilterExtensionType, Disabled: util.PtrTo(true)},
+				},
+			},
+		}
+	}
+
+	t.Run("should not detect drift of shoot matching the configuration", func(t *testing.T) {
+		// when
+		result := NewDriftDetector(nil).Detect(logrus.New(), newShoot(), config, detectedAt)
+
+		// then
+		assert.Empty(t, result.Drifts)
+		assert.False(t, result.ShootModified)
+		assert.False(t, result.ConfigModified)
+	})
+
+	t.Run("should detect drift of modified fields with default policies", func(t *testing.T) {
+		// given
+		shoot := newShoot()
+		shoot.Spec.Kubernetes.Version = "1.28.2"
+		shoot.Spec.Provider.Workers[0].Machine.Type = "m5.2xlarge"
+		shoot.Spec.Provider.Workers[0].Maximum = 10
+		shoot.Spec.Provider.Workers[0].MaxSurge = util.PtrTo(intstr.FromString("10%"))
+		shoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig = nil
+		shoot.Spec.Extensions[0].Disabled = util.PtrTo(false)
+
+		// when
+		result := NewDriftDetector(nil).Detect(logrus.New(), shoot, config, detectedAt)
+
+		// then
+		assert.Equal(t, []model.ShootDrift{
+			{ClusterID: "runtime-id", Field: DriftFieldKubernetesVersion, ExpectedValue: "1.27.5", ActualValue: "1.28.2", Policy: model.DriftPolicyAdopt, DetectedAt: detectedAt},
+			{ClusterID: "runtime-id", Field: DriftFieldMachineType, ExpectedValue: "m5.xlarge", ActualValue: "m5.2xlarge", Policy: model.DriftPolicyRecord, DetectedAt: detectedAt},
+			{ClusterID: "runtime-id", Field: DriftFieldAutoScalerMax, ExpectedValue: "4", ActualValue: "10", Policy: model.DriftPolicyRecord, DetectedAt: detectedAt},
+			{ClusterID: "runtime-id", Field: DriftFieldMaxSurge, ExpectedValue: "1", ActualValue: "10%", Policy: model.DriftPolicyRecord, DetectedAt: detectedAt},
+			{
+				ClusterID:     "runtime-id",
+				Field:         DriftFieldOIDCConfig,
+				ExpectedValue: `{"clientID":"client","groupsClaim":"groups","issuerURL":"https://issuer.example.com","signingAlgs":["RS256"],"usernameClaim":"sub","usernamePrefix":"-"}`,
+				ActualValue:   `{"clientID":"","groupsClaim":"","issuerURL":"","signingAlgs":null,"usernameClaim":"","usernamePrefix":""}`,
+				Policy:        model.DriftPolicyRecord,
+				DetectedAt:    detectedAt,
+			},
+			{ClusterID: "runtime-id", Field: DriftFieldShootNetworkingFilterDisabled, ExpectedValue: "true", ActualValue: "false", Policy: model.DriftPolicyRecord, DetectedAt: detectedAt},
+		}, result.Drifts)
+		assert.False(t, result.ShootModified)
+		assert.True(t, result.ConfigModified)
+		assert.Equal(t, "1.28.2", result.Config.KubernetesVersion)
+		assert.Equal(t, "m5.xlarge", result.Config.MachineType)
+		assert.Equal(t, 4, result.Config.AutoScalerMax)
+	})
+
+	t.Run("should adopt worker settings defaulted by Gardener", func(t *testing.T) {
+		// given
+		shoot := newShoot()
+		shoot.Spec.Provider.Workers[0].MaxSurge = util.PtrTo(intstr.FromInt(2))
+		shoot.Spec.Provider.Workers[0].MaxUnavailable = util.PtrTo(intstr.FromInt(1))
+
+		// when
+		result := NewDriftDetector(nil).Detect(logrus.New(), shoot, config, detectedAt)
+
+		// then
+		require.Len(t, result.Drifts, 2)
+		assert.Equal(t, model.DriftPolicyAdopt, result.Drifts[0].Policy)
+		assert.Equal(t, model.DriftPolicyAdopt, result.Drifts[1].Policy)
+		assert.True(t, result.ConfigModified)
+		assert.Equal(t, 2, result.Config.MaxSurge)
+		assert.Equal(t, 1, result.Config.MaxUnavailable)
+	})
+
+	t.Run("should only record drift with record policy", func(t *testing.T) {
+		// given
+		shoot := newShoot()
+		shoot.Spec.Provider.Workers[0].Machine.Type = "m5.2xlarge"
+
+		detector := NewDriftDetector(map[string]model.DriftPolicy{
+			DriftFieldMachineType: model.DriftPolicyRecord,
+		})
+
+		// when
+		result := detector.Detect(logrus.New(), shoot, config, detectedAt)
+
+		// then
+		require.Len(t, result.Drifts, 1)
+		assert.Equal(t, model.DriftPolicyRecord, result.Drifts[0].Policy)
+		assert.False(t, result.ShootModified)
+		assert.False(t, result.ConfigModified)
+	})
+
+	t.Run("should adopt machine image version updated by Gardener", func(t *testing.T) {
+		// given
+		shoot := newShoot()
+		shoot.Spec.Provider.Workers[0].Machine.Image = &v1beta1.ShootMachineImage{Name: "gardenlinux", Version: util.PtrTo("1312.3.0")}
+
+		configWithImage := config
+		configWithImage.MachineImage = util.PtrTo("gardenlinux")
+		configWithImage.MachineImageVersion = util.PtrTo("1092.1.0")
+
+		// when
+		result := NewDriftDetector(nil).Detect(logrus.New(), shoot, configWithImage, detectedAt)
+
+		// then
+		assert.Equal(t, []model.ShootDrift{
+			{ClusterID: "runtime-id", Field: DriftFieldMachineImageVersion, ExpectedValue: "1092.1.0", ActualValue: "1312.3.0", Policy: model.DriftPolicyAdopt, DetectedAt: detectedAt},
+		}, result.Drifts)
+		assert.True(t, result.ConfigModified)
+		assert.Equal(t, "1312.3.0", *result.Config.MachineImageVersion)
+		assert.Equal(t, "1092.1.0", *configWithImage.MachineImageVersion)
+	})
+
+	t.Run("should revert drift in the shoot", func(t *testing.T) {
+		// given
+		shoot := newShoot()
+		shoot.Spec.Provider.Workers[0].Machine.Type = "m5.2xlarge"
+		shoot.Spec.Extensions[0].Disabled = util.PtrTo(false)
+
+		detector := NewDriftDetector(map[string]model.DriftPolicy{
+			DriftFieldMachineType:                   model.DriftPolicyRevert,
+			DriftFieldShootNetworkingFilterDisabled: model.DriftPolicyRevert,
+		})
+
+		// when
+		result := detector.Detect(logrus.New(), shoot, config, detectedAt)
+
+		// then
+		require.Len(t, result.Drifts, 2)
+		assert.Equal(t, model.DriftPolicyRevert, result.Drifts[0].Policy)
+		assert.True(t, result.ShootModified)
+		assert.False(t, result.ConfigModified)
+		assert.Equal(t, "m5.xlarge", result.Shoot.Spec.Provider.Workers[0].Machine.Type)
+		assert.True(t, *result.Shoot.Spec.Extensions[0].Disabled)
+		assert.Equal(t, "m5.2xlarge", shoot.Spec.Provider.Workers[0].Machine.Type)
+	})
+
+	t.Run("should adopt drift in the configuration", func(t *testing.T) {
+		// given
+		shoot := newShoot()
+		shoot.Spec.Kubernetes.Version = "1.28.2"
+		shoot.Spec.Provider.Workers[0].Minimum = 3
+		shoot.Spec.Provider.Workers[0].MaxUnavailable = util.PtrTo(intstr.FromInt(1))
+
+		detector := NewDriftDetector(map[string]model.DriftPolicy{
+			DriftFieldKubernetesVersion: model.DriftPolicyAdopt,
+			DriftFieldAutoScalerMin:     model.DriftPolicyAdopt,
+			DriftFieldMaxUnavailable:    model.DriftPolicyAdopt,
+		})
+
+		// when
+		result := detector.Detect(logrus.New(), shoot, config, detectedAt)
+
+		// then
+		require.Len(t, result.Drifts, 3)
+		assert.False(t, result.ShootModified)
+		assert.True(t, result.ConfigModified)
+		assert.Equal(t, "1.28.2", result.Config.KubernetesVersion)
+		assert.Equal(t, 3, result.Config.AutoScalerMin)
+		assert.Equal(t, 1, result.Config.MaxUnavailable)
+		assert.Equal(t, "1.27.5", config.KubernetesVersion)
+	})
+
+	t.Run("should only record drift which cannot be adopted", func(t *testing.T) {
+		// given
+		shoot := newShoot()
+		shoot.Spec.Provider.Workers[0].MaxSurge = util.PtrTo(intstr.FromString("10%"))
+
+		detector := NewDriftDetector(map[string]model.DriftPolicy{
+			DriftFieldMaxSurge: model.DriftPolicyAdopt,
+		})
+
+		// when
+		result := detector.Detect(logrus.New(), shoot, config, detectedAt)
+
+		// then
+		require.Len(t, result.Drifts, 1)
+		assert.Equal(t, model.DriftPolicyRecord, result.Drifts[0].Policy)
+		assert.False(t, result.ConfigModified)
+		assert.Equal(t, 1, result.Config.MaxSurge)
+	})
+
+	t.Run("should skip fields missing in the shoot", func(t *testing.T) {
+		// given
+		shoot := newShoot()
+		shoot.Spec.Provider.Workers = nil
+		shoot.Spec.Extensions = nil
+
+		// when
+		result := NewDriftDetector(nil).Detect(logrus.New(), shoot, config, detectedAt)
+
+		// then
+		assert.Empty(t, result.Drifts)
+	})
+}
@@ -42,5 +42,5 @@ func (s ShootProvider) Get(runtimeID string, tenant string) (gardener_Types.Shoo
 		}
 	}
 
-	return gardener_Types.Shoot{}, apperrors.Internal("failed to find shoot for Runtime %s", runtimeID)
+	return gardener_Types.Shoot{}, apperrors.MissingShoot("failed to find shoot for Runtime %s", runtimeID)
 }
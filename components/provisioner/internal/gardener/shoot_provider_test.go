@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	gardener_Types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/gardener/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -71,6 +72,7 @@ func TestShootProvider(t *testing.T) {
 
 		// then
 		require.Error(t, err)
+		assert.Equal(t, apperrors.ShootNotFound, err.Cause())
 		assert.Equal(t, gardener_Types.Shoot{}, shoot)
 	})
 
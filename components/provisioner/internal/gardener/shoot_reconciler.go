@@ -2,6 +2,10 @@ package gardener
 
 import (
 	"context"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/uuid"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
 	"k8s.io/apimachinery/pkg/types"
@@ -18,10 +22,15 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// driftRequeueDelay is the delay after which drift of the shoot with operation in progress is checked again
+const driftRequeueDelay = time.Minute
+
 func NewReconciler(
 	mgr ctrl.Manager,
 	dbsFactory dbsession.Factory,
-	auditLogConfigurator AuditLogConfigurator) *Reconciler {
+	auditLogConfigurator AuditLogConfigurator,
+	driftDetector DriftDetector,
+	uuidGenerator uuid.UUIDGenerator) *Reconciler {
 	return &Reconciler{
 		client: mgr.GetClient(),
 		scheme: mgr.GetScheme(),
@@ -29,6 +38,8 @@ func NewReconciler(
 
 		dbsFactory:           dbsFactory,
 		auditLogConfigurator: auditLogConfigurator,
+		driftDetector:        driftDetector,
+		uuidGenerator:        uuidGenerator,
 	}
 }
 
@@ -40,6 +51,8 @@ type Reconciler struct {
 	log *logrus.Entry
 
 	auditLogConfigurator AuditLogConfigurator
+	driftDetector        DriftDetector
+	uuidGenerator        uuid.UUIDGenerator
 }
 
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -76,7 +89,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		}
 	}
 
-	return ctrl.Result{}, nil
+	result, err := r.reconcileDrift(log, &shoot, runtimeId)
+	if err != nil {
+		log.Errorf("Failed to reconcile drift of %s shoot: %s", shoot.Name, err.Error())
+		return ctrl.Result{}, err
+	}
+
+	return result, nil
 }
 
 func (r *Reconciler) shouldReconcileShoot(shoot gardener_types.Shoot) (bool, error) {
@@ -128,6 +147,152 @@ func (r *Reconciler) enableAuditLogs(logger logrus.FieldLogger, shoot *gardener_
 	return nil
 }
 
+// reconcileDrift compares the shoot with its stored configuration, the drift is stored and reverted or adopted according to the field policy.
+// The shoot with operation in progress is requeued, as Gardener updates during maintenance would not be synced until the next Shoot event otherwise
+func (r *Reconciler) reconcileDrift(logger logrus.FieldLogger, shoot *gardener_types.Shoot, runtimeID string) (ctrl.Result, error) {
+	if shoot.DeletionTimestamp != nil {
+		return ctrl.Result{}, nil
+	}
+
+	session := r.dbsFactory.NewReadSession()
+
+	operation, dberr := session.GetLastOperation(runtimeID)
+	if dberr != nil {
+		return ctrl.Result{}, dberr
+	}
+	if operation.State == model.InProgress {
+		logger.Debugf("Operation %s is in progress, postponing drift detection", operation.ID)
+		return ctrl.Result{RequeueAfter: driftRequeueDelay}, nil
+	}
+
+	cluster, dberr := session.GetCluster(runtimeID)
+	if dberr != nil {
+		return ctrl.Result{}, dberr
+	}
+
+	storedDrifts, dberr := session.ListShootDrifts(runtimeID)
+	if dberr != nil {
+		return ctrl.Result{}, dberr
+	}
+
+	result := r.driftDetector.Detect(logger, *shoot, cluster.ClusterConfig, time.Now())
+	drifts := keepDetectionTime(result.Drifts, storedDrifts)
+
+	if !result.ShootModified && !result.ConfigModified && equalDrifts(drifts, storedDrifts) {
+		return ctrl.Result{}, nil
+	}
+
+	transaction, dberr := r.dbsFactory.NewSessionWithinTransaction()
+	if dberr != nil {
+		return ctrl.Result{}, dberr
+	}
+	defer transaction.RollbackUnlessCommitted()
+
+	// Operation could have been started since the configuration was read, neither the Shoot nor the configuration is overwritten with the drift.
+	// The lock is held until the reverted Shoot and the drift are stored
+	lockedOperation, dberr := transaction.LockLastOperation(runtimeID)
+	if dberr != nil {
+		return ctrl.Result{}, dberr
+	}
+	if lockedOperation.ID != operation.ID || lockedOperation.State == model.InProgress {
+		logger.Debugf("Operation %s was started, postponing drift detection", lockedOperation.ID)
+		return ctrl.Result{RequeueAfter: driftRequeueDelay}, nil
+	}
+
+	if result.ShootModified {
+		logger.Infof("Reverting drift of shoot: %v", driftFieldNames(drifts, model.DriftPolicyRevert))
+		if err := r.updateShoot(result.Shoot); err != nil {
+			return ctrl.Result{}, err
+		}
+		*shoot = *result.Shoot
+	}
+
+	if result.ConfigModified {
+		adoptedFields := driftFieldNames(drifts, model.DriftPolicyAdopt)
+		logger.Infof("Adopting drift of shoot: %v", adoptedFields)
+		if dberr := transaction.UpdateAdoptedGardenerConfig(result.Config, adoptedFields); dberr != nil {
+			return ctrl.Result{}, dberr
+		}
+		if dberr := transaction.InsertGardenerConfigChanges(r.adoptedChanges(drifts)); dberr != nil {
+			return ctrl.Result{}, dberr
+		}
+	}
+
+	if dberr := transaction.ReplaceShootDrifts(runtimeID, drifts); dberr != nil {
+		return ctrl.Result{}, dberr
+	}
+
+	return ctrl.Result{}, transaction.Commit()
+}
+
+// adoptedChanges returns history entries of the configuration updated with values found in the shoot
+func (r *Reconciler) adoptedChanges(drifts []model.ShootDrift) []model.GardenerConfigChange {
+	var changes []model.GardenerConfigChange
+	for _, drift := range drifts {
+		if drift.Policy != model.DriftPolicyAdopt {
+			continue
+		}
+		changes = append(changes, model.GardenerConfigChange{
+			ID:        r.uuidGenerator.New(),
+			ClusterID: drift.ClusterID,
+			Field:     drift.Field,
+			OldValue:  drift.ExpectedValue,
+			NewValue:  drift.ActualValue,
+			ChangedAt: time.Now(),
+		})
+	}
+
+	return changes
+}
+
+// keepDetectionTime preserves the time when the drift was detected for the first time
+func keepDetectionTime(drifts, storedDrifts []model.ShootDrift) []model.ShootDrift {
+	for i := range drifts {
+		for _, stored := range storedDrifts {
+			if stored.Field == drifts[i].Field && stored.ActualValue == drifts[i].ActualValue {
+				drifts[i].DetectedAt = stored.DetectedAt
+			}
+		}
+	}
+
+	return drifts
+}
+
+func equalDrifts(drifts, storedDrifts []model.ShootDrift) bool {
+	if len(drifts) != len(storedDrifts) {
+		return false
+	}
+
+	for _, drift := range drifts {
+		found := false
+		for _, stored := range storedDrifts {
+			if drift.Field == stored.Field &&
+				drift.ExpectedValue == stored.ExpectedValue &&
+				drift.ActualValue == stored.ActualValue &&
+				drift.Policy == stored.Policy {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func driftFieldNames(drifts []model.ShootDrift, policy model.DriftPolicy) []string {
+	var names []string
+	for _, drift := range drifts {
+		if drift.Policy == policy {
+			names = append(names, drift.Field)
+		}
+	}
+
+	return names
+}
+
 func getSeedName(shoot gardener_types.Shoot) string {
 	if shoot.Spec.SeedName != nil {
 		return *shoot.Spec.SeedName
@@ -0,0 +1,280 @@
+package gardener
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestReconciler_reconcileDrift(t *testing.T) {
+	runtimeID := "runtime-id"
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, gardener_types.AddToScheme(scheme))
+
+	cluster := model.Cluster{
+		ID: runtimeID,
+		ClusterConfig: model.GardenerConfig{
+			ClusterID:         runtimeID,
+			KubernetesVersion: "1.27.5",
+			MachineType:       "m5.xlarge",
+		},
+	}
+
+	newShoot := func(machineType string) *gardener_types.Shoot {
+		return &gardener_types.Shoot{
+			ObjectMeta: v1.ObjectMeta{Name: "shoot", Namespace: "garden-project"},
+			Spec: gardener_types.ShootSpec{
+				Kubernetes: gardener_types.Kubernetes{Version: "1.27.5"},
+				Provider: gardener_types.Provider{
+					Workers: []gardener_types.Worker{{Name: "cpu-worker-0", Machine: gardener_types.Machine{Type: machineType}}},
+				},
+			},
+		}
+	}
+
+	recordMachineType := map[string]model.DriftPolicy{DriftFieldMachineType: model.DriftPolicyRecord}
+
+	newReconciler := func(shoot *gardener_types.Shoot, policies map[string]model.DriftPolicy) (*Reconciler, *mocks.Factory, *mocks.ReadSession) {
+		sessionFactory := &mocks.Factory{}
+		readSession := &mocks.ReadSession{}
+		sessionFactory.On("NewReadSession").Return(readSession)
+
+		return &Reconciler{
+			client:        fake.NewClientBuilder().WithScheme(scheme).WithObjects(shoot).Build(),
+			scheme:        scheme,
+			dbsFactory:    sessionFactory,
+			log:           logrus.WithField("Component", "ShootReconciler"),
+			driftDetector: NewDriftDetector(policies),
+			uuidGenerator: uuid.NewUUIDGenerator(),
+		}, sessionFactory, readSession
+	}
+
+	t.Run("should store detected drift", func(t *testing.T) {
+		// given
+		shoot := newShoot("m5.2xlarge")
+		reconciler, sessionFactory, readSession := newReconciler(shoot, recordMachineType)
+		transaction := &mocks.WriteSessionWithinTransaction{}
+
+		readSession.On("GetLastOperation", runtimeID).Return(model.Operation{ID: "operation-id", State: model.Succeeded}, nil)
+		readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+		readSession.On("ListShootDrifts", runtimeID).Return(nil, nil)
+		sessionFactory.On("NewSessionWithinTransaction").Return(transaction, nil)
+		transaction.On("LockLastOperation", runtimeID).Return(model.Operation{ID: "operation-id", State: model.Succeeded}, nil)
+		transaction.On("ReplaceShootDrifts", runtimeID, mock.MatchedBy(func(drifts []model.ShootDrift) bool {
+			return len(drifts) == 1 && drifts[0].Field == DriftFieldMachineType && drifts[0].ActualValue == "m5.2xlarge" && drifts[0].Policy == model.DriftPolicyRecord
+		})).Return(nil)
+		transaction.On("Commit").Return(nil)
+		transaction.On("RollbackUnlessCommitted").Return()
+
+		// when
+		_, err := reconciler.reconcileDrift(reconciler.log, shoot, runtimeID)
+
+		// then
+		require.NoError(t, err)
+		sessionFactory.AssertExpectations(t)
+		readSession.AssertExpectations(t)
+		transaction.AssertExpectations(t)
+	})
+
+	t.Run("should not store drift which did not change", func(t *testing.T) {
+		// given
+		shoot := newShoot("m5.2xlarge")
+		reconciler, sessionFactory, readSession := newReconciler(shoot, recordMachineType)
+
+		readSession.On("GetLastOperation", runtimeID).Return(model.Operation{ID: "operation-id", State: model.Succeeded}, nil)
+		readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+		readSession.On("ListShootDrifts", runtimeID).Return([]model.ShootDrift{{
+			ClusterID:     runtimeID,
+			Field:         DriftFieldMachineType,
+			ExpectedValue: "m5.xlarge",
+			ActualValue:   "m5.2xlarge",
+			Policy:        model.DriftPolicyRecord,
+			DetectedAt:    time.Now().Add(-time.Hour),
+		}}, nil)
+
+		// when
+		_, err := reconciler.reconcileDrift(reconciler.log, shoot, runtimeID)
+
+		// then
+		require.NoError(t, err)
+		sessionFactory.AssertNotCalled(t, "NewSessionWithinTransaction")
+		readSession.AssertExpectations(t)
+	})
+
+	t.Run("should revert drift in the shoot", func(t *testing.T) {
+		// given
+		shoot := newShoot("m5.2xlarge")
+		reconciler, sessionFactory, readSession := newReconciler(shoot, map[string]model.DriftPolicy{DriftFieldMachineType: model.DriftPolicyRevert})
+		transaction := &mocks.WriteSessionWithinTransaction{}
+
+		readSession.On("GetLastOperation", runtimeID).Return(model.Operation{ID: "operation-id", State: model.Succeeded}, nil)
+		readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+		readSession.On("ListShootDrifts", runtimeID).Return(nil, nil)
+		sessionFactory.On("NewSessionWithinTransaction").Return(transaction, nil)
+		transaction.On("LockLastOperation", runtimeID).Return(model.Operation{ID: "operation-id", State: model.Succeeded}, nil)
+		transaction.On("ReplaceShootDrifts", runtimeID, mock.Anything).Return(nil)
+		transaction.On("Commit").Return(nil)
+		transaction.On("RollbackUnlessCommitted").Return()
+
+		// when
+		_, err := reconciler.reconcileDrift(reconciler.log, shoot, runtimeID)
+
+		// then
+		require.NoError(t, err)
+
+		var updatedShoot gardener_types.Shoot
+		require.NoError(t, reconciler.client.Get(context.Background(), types.NamespacedName{Name: "shoot", Namespace: "garden-project"}, &updatedShoot))
+		assert.Equal(t, "m5.xlarge", updatedShoot.Spec.Provider.Workers[0].Machine.Type)
+		transaction.AssertExpectations(t)
+	})
+
+	t.Run("should adopt drift in the database", func(t *testing.T) {
+		// given
+		shoot := newShoot("m5.2xlarge")
+		reconciler, sessionFactory, readSession := newReconciler(shoot, map[string]model.DriftPolicy{DriftFieldMachineType: model.DriftPolicyAdopt})
+		transaction := &mocks.WriteSessionWithinTransaction{}
+
+		readSession.On("GetLastOperation", runtimeID).Return(model.Operation{ID: "operation-id", State: model.Succeeded}, nil)
+		readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+		readSession.On("ListShootDrifts", runtimeID).Return(nil, nil)
+		sessionFactory.On("NewSessionWithinTransaction").Return(transaction, nil)
+		transaction.On("LockLastOperation", runtimeID).Return(model.Operation{ID: "operation-id", State: model.Succeeded}, nil)
+		transaction.On("UpdateAdoptedGardenerConfig", mock.MatchedBy(func(config model.GardenerConfig) bool {
+			return config.MachineType == "m5.2xlarge"
+		}), []string{DriftFieldMachineType}).Return(nil)
+		transaction.On("InsertGardenerConfigChanges", mock.MatchedBy(func(changes []model.GardenerConfigChange) bool {
+			return len(changes) == 1 && changes[0].Field == DriftFieldMachineType && changes[0].OldValue == "m5.xlarge" && changes[0].NewValue == "m5.2xlarge"
+		})).Return(nil)
+		transaction.On("ReplaceShootDrifts", runtimeID, mock.Anything).Return(nil)
+		transaction.On("Commit").Return(nil)
+		transaction.On("RollbackUnlessCommitted").Return()
+
+		// when
+		_, err := reconciler.reconcileDrift(reconciler.log, shoot, runtimeID)
+
+		// then
+		require.NoError(t, err)
+		transaction.AssertExpectations(t)
+	})
+
+	t.Run("should sync versions updated by Gardener during maintenance", func(t *testing.T) {
+		// given
+		shoot := newShoot("m5.xlarge")
+		shoot.Spec.Kubernetes.Version = "1.27.8"
+		shoot.Spec.Provider.Workers[0].Machine.Image = &gardener_types.ShootMachineImage{Name: "gardenlinux", Version: util.PtrTo("1312.3.0")}
+
+		syncedCluster := cluster
+		syncedCluster.ClusterConfig.MachineImage = util.PtrTo("gardenlinux")
+		syncedCluster.ClusterConfig.MachineImageVersion = util.PtrTo("1092.1.0")
+
+		reconciler, sessionFactory, readSession := newReconciler(shoot, nil)
+		transaction := &mocks.WriteSessionWithinTransaction{}
+
+		readSession.On("GetLastOperation", runtimeID).Return(model.Operation{ID: "operation-id", State: model.Succeeded}, nil)
+		readSession.On("GetCluster", runtimeID).Return(syncedCluster, nil)
+		readSession.On("ListShootDrifts", runtimeID).Return(nil, nil)
+		sessionFactory.On("NewSessionWithinTransaction").Return(transaction, nil)
+		transaction.On("LockLastOperation", runtimeID).Return(model.Operation{ID: "operation-id", State: model.Succeeded}, nil)
+		transaction.On("UpdateAdoptedGardenerConfig", mock.MatchedBy(func(config model.GardenerConfig) bool {
+			return config.KubernetesVersion == "1.27.8" && *config.MachineImageVersion == "1312.3.0"
+		}), []string{DriftFieldKubernetesVersion, DriftFieldMachineImageVersion}).Return(nil)
+		transaction.On("InsertGardenerConfigChanges", mock.MatchedBy(func(changes []model.GardenerConfigChange) bool {
+			return len(changes) == 2 &&
+				changes[0].Field == DriftFieldKubernetesVersion && changes[0].OldValue == "1.27.5" && changes[0].NewValue == "1.27.8" &&
+				changes[1].Field == DriftFieldMachineImageVersion && changes[1].OldValue == "1092.1.0" && changes[1].NewValue == "1312.3.0" &&
+				changes[0].ID != changes[1].ID
+		})).Return(nil)
+		transaction.On("ReplaceShootDrifts", runtimeID, mock.Anything).Return(nil)
+		transaction.On("Commit").Return(nil)
+		transaction.On("RollbackUnlessCommitted").Return()
+
+		// when
+		_, err := reconciler.reconcileDrift(reconciler.log, shoot, runtimeID)
+
+		// then
+		require.NoError(t, err)
+		transaction.AssertExpectations(t)
+	})
+
+	t.Run("should not adopt drift when operation was started after the configuration was read", func(t *testing.T) {
+		// given
+		shoot := newShoot("m5.2xlarge")
+		reconciler, sessionFactory, readSession := newReconciler(shoot, map[string]model.DriftPolicy{DriftFieldMachineType: model.DriftPolicyAdopt})
+		transaction := &mocks.WriteSessionWithinTransaction{}
+
+		readSession.On("GetLastOperation", runtimeID).Return(model.Operation{ID: "operation-id", State: model.Succeeded}, nil)
+		readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+		readSession.On("ListShootDrifts", runtimeID).Return(nil, nil)
+		sessionFactory.On("NewSessionWithinTransaction").Return(transaction, nil)
+		transaction.On("LockLastOperation", runtimeID).Return(model.Operation{ID: "upgrade-id", State: model.InProgress}, nil)
+		transaction.On("RollbackUnlessCommitted").Return()
+
+		// when
+		result, err := reconciler.reconcileDrift(reconciler.log, shoot, runtimeID)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, driftRequeueDelay, result.RequeueAfter)
+		transaction.AssertExpectations(t)
+		transaction.AssertNotCalled(t, "UpdateAdoptedGardenerConfig", mock.Anything, mock.Anything)
+		transaction.AssertNotCalled(t, "ReplaceShootDrifts", mock.Anything, mock.Anything)
+		transaction.AssertNotCalled(t, "Commit")
+	})
+
+	t.Run("should not revert drift when operation was started after the configuration was read", func(t *testing.T) {
+		// given
+		shoot := newShoot("m5.2xlarge")
+		reconciler, sessionFactory, readSession := newReconciler(shoot, map[string]model.DriftPolicy{DriftFieldMachineType: model.DriftPolicyRevert})
+		transaction := &mocks.WriteSessionWithinTransaction{}
+
+		readSession.On("GetLastOperation", runtimeID).Return(model.Operation{ID: "operation-id", State: model.Succeeded}, nil)
+		readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+		readSession.On("ListShootDrifts", runtimeID).Return(nil, nil)
+		sessionFactory.On("NewSessionWithinTransaction").Return(transaction, nil)
+		transaction.On("LockLastOperation", runtimeID).Return(model.Operation{ID: "upgrade-id", State: model.InProgress}, nil)
+		transaction.On("RollbackUnlessCommitted").Return()
+
+		// when
+		_, err := reconciler.reconcileDrift(reconciler.log, shoot, runtimeID)
+
+		// then
+		require.NoError(t, err)
+
+		var storedShoot gardener_types.Shoot
+		require.NoError(t, reconciler.client.Get(context.Background(), types.NamespacedName{Name: "shoot", Namespace: "garden-project"}, &storedShoot))
+		assert.Equal(t, "m5.2xlarge", storedShoot.Spec.Provider.Workers[0].Machine.Type)
+		transaction.AssertExpectations(t)
+		transaction.AssertNotCalled(t, "ReplaceShootDrifts", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should requeue shoot with operation in progress", func(t *testing.T) {
+		// given
+		shoot := newShoot("m5.2xlarge")
+		reconciler, _, readSession := newReconciler(shoot, nil)
+
+		readSession.On("GetLastOperation", runtimeID).Return(model.Operation{State: model.InProgress}, nil)
+
+		// when
+		result, err := reconciler.reconcileDrift(reconciler.log, shoot, runtimeID)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, driftRequeueDelay, result.RequeueAfter)
+		readSession.AssertNotCalled(t, "GetCluster", runtimeID)
+	})
+}
@@ -0,0 +1,121 @@
+package healthz
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	statusOK     = "ok"
+	statusFailed = "failed"
+)
+
+// Checker verifies that the dependency required to serve requests is available
+type Checker interface {
+	Name() string
+	Check(ctx context.Context) error
+}
+
+type checker struct {
+	name  string
+	check func(ctx context.Context) error
+}
+
+func NewChecker(name string, check func(ctx context.Context) error) Checker {
+	return checker{name: name, check: check}
+}
+
+func (c checker) Name() string {
+	return c.name
+}
+
+func (c checker) Check(ctx context.Context) error {
+	return c.check(ctx)
+}
+
+type CheckResult struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+type ReadinessResponse struct {
+	Status string                 `json:"status"`
+	Checks map[string]CheckResult `json:"checks"`
+}
+
+// NewReadinessHandler runs all checkers in parallel, the replica is ready only when every checker succeeds before the timeout
+func NewReadinessHandler(log *logrus.Logger, timeout time.Duration, checkers ...Checker) func(writer http.ResponseWriter, request *http.Request) {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		ctx, cancel := context.WithTimeout(request.Context(), timeout)
+		defer cancel()
+
+		response := check(ctx, checkers)
+
+		writer.Header().Set("Content-Type", "application/json")
+		if response.Status != statusOK {
+			log.Warnf("Provisioner is not ready: %v", response.Checks)
+			writer.WriteHeader(http.StatusServiceUnavailable)
+		} else {
+			writer.WriteHeader(http.StatusOK)
+		}
+
+		err := json.NewEncoder(writer).Encode(response)
+		if err != nil {
+			log.Errorf(errors.Wrapf(err, "while writing to response body").Error())
+		}
+	}
+}
+
+func check(ctx context.Context, checkers []Checker) ReadinessResponse {
+	results := make([]CheckResult, len(checkers))
+
+	var waitGroup sync.WaitGroup
+	for i, c := range checkers {
+		waitGroup.Add(1)
+		go func(i int, c Checker) {
+			defer waitGroup.Done()
+			results[i] = runCheck(ctx, c)
+		}(i, c)
+	}
+	waitGroup.Wait()
+
+	response := ReadinessResponse{
+		Status: statusOK,
+		Checks: make(map[string]CheckResult, len(checkers)),
+	}
+	for i, c := range checkers {
+		response.Checks[c.Name()] = results[i]
+		if results[i].Status != statusOK {
+			response.Status = statusFailed
+		}
+	}
+
+	return response
+}
+
+// runCheck does not wait for the checker which ignores the context so that the probe does not hang
+func runCheck(ctx context.Context, c Checker) CheckResult {
+	done := make(chan error, 1)
+	go func() {
+		done <- c.Check(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		err = errors.Wrap(ctx.Err(), "check not finished in time")
+	}
+
+	if err != nil {
+		return CheckResult{Status: statusFailed, Error: err.Error()}
+	}
+
+	return CheckResult{Status: statusOK}
+}
@@ -0,0 +1,80 @@
+package healthz
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReadinessHandler(t *testing.T) {
+	succeeding := NewChecker("database", func(ctx context.Context) error {
+		return nil
+	})
+	failing := NewChecker("gardener", func(ctx context.Context) error {
+		return errors.New("connection refused")
+	})
+	hanging := NewChecker("queues", func(ctx context.Context) error {
+		time.Sleep(time.Second)
+		return nil
+	})
+
+	serve := func(checkers ...Checker) (int, ReadinessResponse) {
+		req, err := http.NewRequest("GET", "/readyz", nil)
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		handler := http.HandlerFunc(NewReadinessHandler(logrus.StandardLogger(), 100*time.Millisecond, checkers...))
+		handler.ServeHTTP(rr, req)
+
+		response := ReadinessResponse{}
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+
+		return rr.Code, response
+	}
+
+	t.Run("should return 200 when all checks succeeded", func(t *testing.T) {
+		// when
+		code, response := serve(succeeding)
+
+		// then
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, ReadinessResponse{
+			Status: "ok",
+			Checks: map[string]CheckResult{"database": {Status: "ok"}},
+		}, response)
+	})
+
+	t.Run("should return 503 with the error of failed check", func(t *testing.T) {
+		// when
+		code, response := serve(succeeding, failing)
+
+		// then
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.Equal(t, ReadinessResponse{
+			Status: "failed",
+			Checks: map[string]CheckResult{
+				"database": {Status: "ok"},
+				"gardener": {Status: "failed", Error: "connection refused"},
+			},
+		}, response)
+	})
+
+	t.Run("should fail check which is not finished in time", func(t *testing.T) {
+		// when
+		code, response := serve(succeeding, hanging)
+
+		// then
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.Equal(t, "ok", response.Checks["database"].Status)
+		assert.Equal(t, "failed", response.Checks["queues"].Status)
+		assert.Contains(t, response.Checks["queues"].Error, "check not finished in time")
+	})
+}
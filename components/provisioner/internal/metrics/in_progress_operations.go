@@ -20,6 +20,9 @@ type InProgressOperationsCollector struct {
 
 	provisioningDesc   *prometheus.Desc
 	deprovisioningDesc *prometheus.Desc
+	shootUpgradeDesc   *prometheus.Desc
+	hibernationDesc    *prometheus.Desc
+	wakeUpDesc         *prometheus.Desc
 
 	log logrus.FieldLogger
 }
@@ -29,8 +32,8 @@ func NewInProgressOperationsCollector(statsGetter OperationsStatsGetter) *InProg
 		statsGetter: statsGetter,
 
 		provisioningDesc: prometheus.NewDesc(
-			buildFQName(model.ProvisionNoInstall),
-			"The number of provisioning without installation operations in progress",
+			buildFQName(model.Provision),
+			"The number of provisioning operations in progress",
 			[]string{},
 			nil),
 		deprovisioningDesc: prometheus.NewDesc(
@@ -38,6 +41,21 @@ func NewInProgressOperationsCollector(statsGetter OperationsStatsGetter) *InProg
 			"The number of deprovisioning without uninstallation operations in progress",
 			[]string{},
 			nil),
+		shootUpgradeDesc: prometheus.NewDesc(
+			buildFQName(model.UpgradeShoot),
+			"The number of shoot upgrade operations in progress",
+			[]string{},
+			nil),
+		hibernationDesc: prometheus.NewDesc(
+			buildFQName(model.Hibernate),
+			"The number of hibernation operations in progress",
+			[]string{},
+			nil),
+		wakeUpDesc: prometheus.NewDesc(
+			buildFQName(model.WakeUp),
+			"The number of wake up operations in progress",
+			[]string{},
+			nil),
 
 		log: logrus.WithField("collector", "in-progress-operations"),
 	}
@@ -46,6 +64,9 @@ func NewInProgressOperationsCollector(statsGetter OperationsStatsGetter) *InProg
 func (c *InProgressOperationsCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- c.provisioningDesc
 	ch <- c.deprovisioningDesc
+	ch <- c.shootUpgradeDesc
+	ch <- c.hibernationDesc
+	ch <- c.wakeUpDesc
 }
 
 func (c *InProgressOperationsCollector) Collect(ch chan<- prometheus.Metric) {
@@ -56,14 +77,27 @@ func (c *InProgressOperationsCollector) Collect(ch chan<- prometheus.Metric) {
 		return
 	}
 
+	// Operations stored by older versions have ProvisionNoInstall type
 	c.newMeasure(ch,
 		c.provisioningDesc,
-		inProgressOpsCounts.Count[model.ProvisionNoInstall],
+		inProgressOpsCounts.Count[model.Provision]+inProgressOpsCounts.Count[model.ProvisionNoInstall],
 	)
 	c.newMeasure(ch,
 		c.deprovisioningDesc,
 		inProgressOpsCounts.Count[model.DeprovisionNoInstall],
 	)
+	c.newMeasure(ch,
+		c.shootUpgradeDesc,
+		inProgressOpsCounts.Count[model.UpgradeShoot],
+	)
+	c.newMeasure(ch,
+		c.hibernationDesc,
+		inProgressOpsCounts.Count[model.Hibernate],
+	)
+	c.newMeasure(ch,
+		c.wakeUpDesc,
+		inProgressOpsCounts.Count[model.WakeUp],
+	)
 }
 
 func (c *InProgressOperationsCollector) newMeasure(ch chan<- prometheus.Metric, desc *prometheus.Desc, value int, labelValues ...string) {
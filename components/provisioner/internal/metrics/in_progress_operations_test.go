@@ -15,10 +15,14 @@ func Test_InProgressOperationsCollector_Collect(t *testing.T) {
 
 	operationsCounts := model.OperationsCount{
 		Count: map[model.OperationType]int{
-			model.ProvisionNoInstall:   6,
+			model.Provision:            5,
+			model.ProvisionNoInstall:   1,
 			model.Deprovision:          5,
 			model.DeprovisionNoInstall: 3,
 			model.Upgrade:              2,
+			model.UpgradeShoot:         4,
+			model.Hibernate:            7,
+			model.WakeUp:               8,
 		},
 	}
 
@@ -34,11 +38,23 @@ func Test_InProgressOperationsCollector_Collect(t *testing.T) {
 
 	provisionMetric := <-receiver
 	assertGaugeValue(t, provisionMetric, float64(6))
-	assert.Contains(t, provisionMetric.Desc().String(), "kcp_provisioner_in_progress_provision_no_install_operations_total")
+	assert.Contains(t, provisionMetric.Desc().String(), "kcp_provisioner_in_progress_provision_operations_total")
 
 	deprovisionMetric := <-receiver
 	assertGaugeValue(t, deprovisionMetric, float64(3))
 	assert.Contains(t, deprovisionMetric.Desc().String(), "kcp_provisioner_in_progress_deprovision_no_install_operations_total")
+
+	shootUpgradeMetric := <-receiver
+	assertGaugeValue(t, shootUpgradeMetric, float64(4))
+	assert.Contains(t, shootUpgradeMetric.Desc().String(), "kcp_provisioner_in_progress_upgrade_shoot_operations_total")
+
+	hibernationMetric := <-receiver
+	assertGaugeValue(t, hibernationMetric, float64(7))
+	assert.Contains(t, hibernationMetric.Desc().String(), "kcp_provisioner_in_progress_hibernate_operations_total")
+
+	wakeUpMetric := <-receiver
+	assertGaugeValue(t, wakeUpMetric, float64(8))
+	assert.Contains(t, wakeUpMetric.Desc().String(), "kcp_provisioner_in_progress_wake_up_operations_total")
 }
 
 func Test_InProgressOperationsCollector_Describe(t *testing.T) {
@@ -50,10 +66,19 @@ func Test_InProgressOperationsCollector_Describe(t *testing.T) {
 	collector.Describe(receiver)
 
 	provisionDesc := <-receiver
-	assert.Contains(t, provisionDesc.String(), "kcp_provisioner_in_progress_provision_no_install_operations_total")
+	assert.Contains(t, provisionDesc.String(), "kcp_provisioner_in_progress_provision_operations_total")
 
 	deprovisionDesc := <-receiver
 	assert.Contains(t, deprovisionDesc.String(), "kcp_provisioner_in_progress_deprovision_no_install_operations_total")
+
+	shootUpgradeDesc := <-receiver
+	assert.Contains(t, shootUpgradeDesc.String(), "kcp_provisioner_in_progress_upgrade_shoot_operations_total")
+
+	hibernationDesc := <-receiver
+	assert.Contains(t, hibernationDesc.String(), "kcp_provisioner_in_progress_hibernate_operations_total")
+
+	wakeUpDesc := <-receiver
+	assert.Contains(t, wakeUpDesc.String(), "kcp_provisioner_in_progress_wake_up_operations_total")
 }
 
 func assertGaugeValue(t *testing.T, metric prometheus.Metric, expected float64) {
@@ -1,17 +1,33 @@
 package metrics
 
-import "github.com/prometheus/client_golang/prometheus"
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
 
 const (
 	prometheusNamespace = "kcp"
 	prometheusSubsystem = "provisioner"
 )
 
-func Register(opsStatsGetter OperationsStatsGetter) error {
-	err := prometheus.Register(NewInProgressOperationsCollector(opsStatsGetter))
+// Register registers collectors in the controller-runtime registry which already holds Go runtime, client-go and workqueue metrics of operation queues
+func Register(opsStatsGetter OperationsStatsGetter, operationsCollector *OperationsCollector) error {
+	err := ctrlmetrics.Registry.Register(NewInProgressOperationsCollector(opsStatsGetter))
+	if err != nil {
+		return err
+	}
+
+	err = ctrlmetrics.Registry.Register(operationsCollector)
 	if err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// Handler exposes metrics of the registry used by Register
+func Handler() http.Handler {
+	return promhttp.HandlerFor(ctrlmetrics.Registry, promhttp.HandlerOpts{})
+}
@@ -0,0 +1,96 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	operationTypeLabel = "operation_type"
+	stageLabel         = "stage"
+	providerLabel      = "provider"
+	regionLabel        = "region"
+	stateLabel         = "state"
+	reasonLabel        = "reason"
+	componentLabel     = "component"
+)
+
+// OperationsRecorder observes operations processed by the executor
+//
+//go:generate mockery --name=OperationsRecorder
+type OperationsRecorder interface {
+	// ObserveStage records duration of the operation stage which ended at the given time with the given state
+	ObserveStage(operation model.Operation, cluster model.Cluster, state model.OperationState, finishedAt time.Time)
+	// ObserveOperation records duration of the operation which ended at the given time with the given state, failed operations are counted by the reason of the last error
+	ObserveOperation(operation model.Operation, cluster model.Cluster, state model.OperationState, lastError model.LastError, finishedAt time.Time)
+}
+
+type OperationsCollector struct {
+	operationDuration *prometheus.HistogramVec
+	stageDuration     *prometheus.HistogramVec
+	failures          *prometheus.CounterVec
+}
+
+var _ OperationsRecorder = &OperationsCollector{}
+
+func NewOperationsCollector() *OperationsCollector {
+	return &OperationsCollector{
+		operationDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: prometheusNamespace,
+			Subsystem: prometheusSubsystem,
+			Name:      "operation_duration_seconds",
+			Help:      "Duration of finished operations",
+			// From 30 seconds to about 2 hours
+			Buckets: prometheus.ExponentialBuckets(30, 2, 9),
+		}, []string{operationTypeLabel, providerLabel, regionLabel, stateLabel}),
+		stageDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: prometheusNamespace,
+			Subsystem: prometheusSubsystem,
+			Name:      "operation_stage_duration_seconds",
+			Help:      "Duration of finished operation stages",
+			// From 5 seconds to about 1.5 hours
+			Buckets: prometheus.ExponentialBuckets(5, 2, 11),
+		}, []string{operationTypeLabel, stageLabel, providerLabel, regionLabel, stateLabel}),
+		failures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: prometheusNamespace,
+			Subsystem: prometheusSubsystem,
+			Name:      "operation_failures_total",
+			Help:      "The number of failed operations by the reason and the component of the last error",
+		}, []string{operationTypeLabel, reasonLabel, componentLabel}),
+	}
+}
+
+func (c *OperationsCollector) ObserveStage(operation model.Operation, cluster model.Cluster, state model.OperationState, finishedAt time.Time) {
+	startedAt := operation.StartTimestamp
+	if operation.LastTransition != nil {
+		startedAt = *operation.LastTransition
+	}
+
+	c.stageDuration.
+		WithLabelValues(string(operation.Type), string(operation.Stage), cluster.ClusterConfig.Provider, cluster.ClusterConfig.Region, string(state)).
+		Observe(finishedAt.Sub(startedAt).Seconds())
+}
+
+func (c *OperationsCollector) ObserveOperation(operation model.Operation, cluster model.Cluster, state model.OperationState, lastError model.LastError, finishedAt time.Time) {
+	c.operationDuration.
+		WithLabelValues(string(operation.Type), cluster.ClusterConfig.Provider, cluster.ClusterConfig.Region, string(state)).
+		Observe(finishedAt.Sub(operation.StartTimestamp).Seconds())
+
+	if state == model.Failed {
+		c.failures.WithLabelValues(string(operation.Type), lastError.Reason, lastError.Component).Inc()
+	}
+}
+
+func (c *OperationsCollector) Describe(ch chan<- *prometheus.Desc) {
+	c.operationDuration.Describe(ch)
+	c.stageDuration.Describe(ch)
+	c.failures.Describe(ch)
+}
+
+func (c *OperationsCollector) Collect(ch chan<- prometheus.Metric) {
+	c.operationDuration.Collect(ch)
+	c.stageDuration.Collect(ch)
+	c.failures.Collect(ch)
+}
@@ -0,0 +1,104 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_OperationsCollector(t *testing.T) {
+	startTime := time.Now()
+	transitionTime := startTime.Add(10 * time.Minute)
+
+	operation := model.Operation{
+		Type:           model.Provision,
+		Stage:          model.WaitingForClusterCreation,
+		StartTimestamp: startTime,
+		LastTransition: &transitionTime,
+	}
+	cluster := model.Cluster{
+		ClusterConfig: model.GardenerConfig{Provider: "aws", Region: "eu-central-1"},
+	}
+
+	t.Run("should observe duration of the stage since the last transition", func(t *testing.T) {
+		// given
+		collector := NewOperationsCollector()
+
+		// when
+		collector.ObserveStage(operation, cluster, model.Succeeded, transitionTime.Add(5*time.Minute))
+
+		// then
+		histogram := collectHistogram(t, collector.stageDuration.WithLabelValues("PROVISION", "WaitingForClusterCreation", "aws", "eu-central-1", "SUCCEEDED"))
+		assert.Equal(t, uint64(1), histogram.GetSampleCount())
+		assert.Equal(t, float64(300), histogram.GetSampleSum())
+	})
+
+	t.Run("should observe duration of the operation since its start", func(t *testing.T) {
+		// given
+		collector := NewOperationsCollector()
+
+		// when
+		collector.ObserveOperation(operation, cluster, model.Succeeded, model.LastError{}, transitionTime.Add(5*time.Minute))
+
+		// then
+		histogram := collectHistogram(t, collector.operationDuration.WithLabelValues("PROVISION", "aws", "eu-central-1", "SUCCEEDED"))
+		assert.Equal(t, uint64(1), histogram.GetSampleCount())
+		assert.Equal(t, float64(900), histogram.GetSampleSum())
+		assert.Equal(t, 0, testutil.CollectAndCount(collector.failures))
+	})
+
+	t.Run("should count failed operations by reason and component of the last error", func(t *testing.T) {
+		// given
+		collector := NewOperationsCollector()
+		lastError := model.LastError{ErrMessage: "timeout", Reason: "err_provisioner_timeout", Component: "provisioner"}
+
+		// when
+		collector.ObserveOperation(operation, cluster, model.Failed, lastError, transitionTime)
+		collector.ObserveOperation(operation, cluster, model.Failed, lastError, transitionTime)
+
+		// then
+		assert.Equal(t, float64(2), testutil.ToFloat64(collector.failures.WithLabelValues("PROVISION", "err_provisioner_timeout", "provisioner")))
+	})
+
+	t.Run("should expose all metrics", func(t *testing.T) {
+		// given
+		collector := NewOperationsCollector()
+		registry := prometheus.NewPedanticRegistry()
+		require.NoError(t, registry.Register(collector))
+
+		// when
+		collector.ObserveStage(operation, cluster, model.Failed, transitionTime)
+		collector.ObserveOperation(operation, cluster, model.Failed, model.LastError{}, transitionTime)
+
+		// then
+		families, err := registry.Gather()
+		require.NoError(t, err)
+
+		names := make([]string, 0, len(families))
+		for _, family := range families {
+			names = append(names, family.GetName())
+		}
+		assert.ElementsMatch(t, []string{
+			"kcp_provisioner_operation_duration_seconds",
+			"kcp_provisioner_operation_stage_duration_seconds",
+			"kcp_provisioner_operation_failures_total",
+		}, names)
+	})
+}
+
+func collectHistogram(t *testing.T, observer prometheus.Observer) *dto.Histogram {
+	metric, ok := observer.(prometheus.Metric)
+	require.True(t, ok)
+
+	metricDto := dto.Metric{}
+	require.NoError(t, metric.Write(&metricDto))
+	require.NotNil(t, metricDto.Histogram)
+
+	return metricDto.Histogram
+}
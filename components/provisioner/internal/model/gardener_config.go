@@ -6,8 +6,10 @@ import (
 	"github.com/hashicorp/go-version"
 
 	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model/infrastructure/alicloud"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model/infrastructure/aws"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model/infrastructure/azure"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apimachineryRuntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -25,6 +27,7 @@ const (
 	EuAccessAnnotation                   = "support.gardener.cloud/eu-access-for-cluster-nodes"
 	ShootNetworkingFilterExtensionType   = "shoot-networking-filter"
 	ShootNetworkingFilterDisabledDefault = true
+	DefaultWorkerName                    = "cpu-worker-0"
 )
 
 var networkingType = "calico"
@@ -52,6 +55,7 @@ type DNSProvider struct {
 }
 
 type GardenerConfig struct {
+	AdditionalWorkerPools               []WorkerPool
 	AutoScalerMax                       int
 	AutoScalerMin                       int
 	ClusterID                           string
@@ -72,6 +76,7 @@ type GardenerConfig struct {
 	MaxSurge                            int
 	MaxUnavailable                      int
 	Name                                string
+	NodeConfig                          *NodeConfig `db:"-"`
 	OIDCConfig                          *OIDCConfig
 	PodsCIDR                            *string
 	ProjectName                         string
@@ -86,6 +91,49 @@ type GardenerConfig struct {
 	WorkerCidr                          string
 }
 
+// WorkerPool is a worker group created next to the default one, it is placed in the same zones as the default worker group
+type WorkerPool struct {
+	AutoScalerMax       int         `json:"autoScalerMax" db:"auto_scaler_max"`
+	AutoScalerMin       int         `json:"autoScalerMin" db:"auto_scaler_min"`
+	DiskType            *string     `json:"diskType" db:"disk_type"`
+	MachineImage        *string     `json:"machineImage" db:"machine_image"`
+	MachineImageVersion *string     `json:"machineImageVersion" db:"machine_image_version"`
+	MachineType         string      `json:"machineType" db:"machine_type"`
+	MaxSurge            int         `json:"maxSurge" db:"max_surge"`
+	MaxUnavailable      int         `json:"maxUnavailable" db:"max_unavailable"`
+	Name                string      `json:"name" db:"name"`
+	NodeConfig          *NodeConfig `json:"nodeConfig" db:"-"`
+	VolumeSizeGB        *int        `json:"volumeSizeGB" db:"volume_size_gb"`
+}
+
+// NodeConfig holds the settings of the nodes of a worker group
+type NodeConfig struct {
+	CRIName       *string           `json:"criName,omitempty"`
+	KubeletConfig *KubeletConfig    `json:"kubeletConfig,omitempty"`
+	Labels        map[string]string `json:"labels,omitempty"`
+	Taints        []Taint           `json:"taints,omitempty"`
+}
+
+type Taint struct {
+	Effect string `json:"effect"`
+	Key    string `json:"key"`
+	Value  string `json:"value,omitempty"`
+}
+
+type KubeletConfig struct {
+	EvictionHard *KubeletEviction `json:"evictionHard,omitempty"`
+	MaxPods      *int             `json:"maxPods,omitempty"`
+	PodPIDsLimit *int             `json:"podPIDsLimit,omitempty"`
+}
+
+type KubeletEviction struct {
+	ImageFSAvailable  *string `json:"imageFSAvailable,omitempty"`
+	ImageFSInodesFree *string `json:"imageFSInodesFree,omitempty"`
+	MemoryAvailable   *string `json:"memoryAvailable,omitempty"`
+	NodeFSAvailable   *string `json:"nodeFSAvailable,omitempty"`
+	NodeFSInodesFree  *string `json:"nodeFSInodesFree,omitempty"`
+}
+
 type ExtensionProviderConfig struct {
 	// ApiVersion is gardener extension api version
 	ApiVersion string `json:"apiVersion"`
@@ -305,6 +353,12 @@ func NewGardenerProviderConfigFromJSON(jsonData string) (GardenerProviderConfig,
 		return &OpenStackGardenerConfig{input: &openStackProviderConfig, ProviderSpecificConfig: ProviderSpecificConfig(jsonData)}, nil
 	}
 
+	var alicloudProviderConfig gqlschema.AlicloudProviderConfigInput
+	err = util.DecodeJson(jsonData, &alicloudProviderConfig)
+	if err == nil {
+		return &AlicloudGardenerConfig{input: &alicloudProviderConfig, ProviderSpecificConfig: ProviderSpecificConfig(jsonData)}, nil
+	}
+
 	return nil, apperrors.BadRequest("json data does not match any of Gardener providers")
 }
 
@@ -344,7 +398,7 @@ func (c GCPGardenerConfig) ValidateShootConfigChange(*gardener_types.Shoot) appe
 func (c GCPGardenerConfig) ExtendShootConfig(gardenerConfig GardenerConfig, shoot *gardener_types.Shoot) apperrors.AppError {
 	shoot.Spec.CloudProfileName = "gcp"
 
-	workers := []gardener_types.Worker{getWorkerConfig(gardenerConfig, c.input.Zones)}
+	workers := getWorkers(gardenerConfig, c.input.Zones)
 
 	gcpInfra := NewGCPInfrastructure(gardenerConfig.WorkerCidr)
 	jsonData, err := json.Marshal(gcpInfra)
@@ -502,7 +556,7 @@ func (c AzureGardenerConfig) ExtendShootConfig(gardenerConfig GardenerConfig, sh
 	if len(c.input.AzureZones) > 0 {
 		zoneNames = getAzureZonesNames(c.input.AzureZones)
 	}
-	workers := []gardener_types.Worker{getWorkerConfig(gardenerConfig, zoneNames)}
+	workers := getWorkers(gardenerConfig, zoneNames)
 
 	azInfra := NewAzureInfrastructure(gardenerConfig.WorkerCidr, c)
 	jsonData, err := json.Marshal(azInfra)
@@ -603,33 +657,44 @@ func (c AWSGardenerConfig) EditShootConfig(gardenerConfig GardenerConfig, shoot
 	}
 
 	if c.input.EnableIMDSv2 != nil && *c.input.EnableIMDSv2 {
-		var (
-			workerConfig *aws.WorkerConfig
-		)
-		if shoot.Spec.Provider.Workers[0].ProviderConfig == nil {
-			workerConfig = NewAWSWorkerConfig(awsIMDSv2HTTPPutResponseHopLimit)
-		} else {
-			workerConfig = &aws.WorkerConfig{}
-			err := json.Unmarshal(shoot.Spec.Provider.Workers[0].ProviderConfig.Raw, &workerConfig)
+		for i := range shoot.Spec.Provider.Workers {
+			err := enableAWSIMDSv2(&shoot.Spec.Provider.Workers[i])
 			if err != nil {
-				return apperrors.Internal("error decoding aws worker config: %s", err.Error())
-			}
-			if workerConfig.InstanceMetadataOptions == nil {
-				workerConfig.InstanceMetadataOptions = &aws.InstanceMetadataOptions{}
-			}
-			if workerConfig.InstanceMetadataOptions.HTTPTokens == nil || *workerConfig.InstanceMetadataOptions.HTTPTokens != aws.HTTPTokensRequired {
-				workerConfig.InstanceMetadataOptions.HTTPTokens = &aws.HTTPTokensRequired
-			}
-			if workerConfig.InstanceMetadataOptions.HTTPPutResponseHopLimit == nil || *workerConfig.InstanceMetadataOptions.HTTPPutResponseHopLimit != awsIMDSv2HTTPPutResponseHopLimit {
-				workerConfig.InstanceMetadataOptions.HTTPPutResponseHopLimit = &awsIMDSv2HTTPPutResponseHopLimit
+				return err
 			}
 		}
-		jsonWCData, err := json.Marshal(workerConfig)
+	}
+
+	return nil
+}
+
+func enableAWSIMDSv2(worker *gardener_types.Worker) apperrors.AppError {
+	var (
+		workerConfig *aws.WorkerConfig
+	)
+	if worker.ProviderConfig == nil {
+		workerConfig = NewAWSWorkerConfig(awsIMDSv2HTTPPutResponseHopLimit)
+	} else {
+		workerConfig = &aws.WorkerConfig{}
+		err := json.Unmarshal(worker.ProviderConfig.Raw, &workerConfig)
 		if err != nil {
-			return apperrors.Internal("error encoding aws worker config: %s", err.Error())
+			return apperrors.Internal("error decoding aws worker config: %s", err.Error())
+		}
+		if workerConfig.InstanceMetadataOptions == nil {
+			workerConfig.InstanceMetadataOptions = &aws.InstanceMetadataOptions{}
+		}
+		if workerConfig.InstanceMetadataOptions.HTTPTokens == nil || *workerConfig.InstanceMetadataOptions.HTTPTokens != aws.HTTPTokensRequired {
+			workerConfig.InstanceMetadataOptions.HTTPTokens = &aws.HTTPTokensRequired
+		}
+		if workerConfig.InstanceMetadataOptions.HTTPPutResponseHopLimit == nil || *workerConfig.InstanceMetadataOptions.HTTPPutResponseHopLimit != awsIMDSv2HTTPPutResponseHopLimit {
+			workerConfig.InstanceMetadataOptions.HTTPPutResponseHopLimit = &awsIMDSv2HTTPPutResponseHopLimit
 		}
-		shoot.Spec.Provider.Workers[0].ProviderConfig = &apimachineryRuntime.RawExtension{Raw: jsonWCData}
 	}
+	jsonWCData, err := json.Marshal(workerConfig)
+	if err != nil {
+		return apperrors.Internal("error encoding aws worker config: %s", err.Error())
+	}
+	worker.ProviderConfig = &apimachineryRuntime.RawExtension{Raw: jsonWCData}
 
 	return nil
 }
@@ -639,7 +704,7 @@ func (c AWSGardenerConfig) ExtendShootConfig(gardenerConfig GardenerConfig, shoo
 
 	zoneNames := getAWSZonesNames(c.input.AwsZones)
 
-	workers := []gardener_types.Worker{getWorkerConfig(gardenerConfig, zoneNames)}
+	workers := getWorkers(gardenerConfig, zoneNames)
 
 	awsInfra := NewAWSInfrastructure(c)
 	jsonData, err := json.Marshal(awsInfra)
@@ -659,7 +724,9 @@ func (c AWSGardenerConfig) ExtendShootConfig(gardenerConfig GardenerConfig, shoo
 		if err != nil {
 			return apperrors.Internal("error encoding aws worker config: %s", err.Error())
 		}
-		workers[0].ProviderConfig = &apimachineryRuntime.RawExtension{Raw: jsonWCData}
+		for i := range workers {
+			workers[i].ProviderConfig = &apimachineryRuntime.RawExtension{Raw: jsonWCData}
+		}
 	}
 
 	shoot.Spec.Provider = gardener_types.Provider{
@@ -716,7 +783,7 @@ func (c OpenStackGardenerConfig) EditShootConfig(gardenerConfig GardenerConfig,
 func (c OpenStackGardenerConfig) ExtendShootConfig(gardenerConfig GardenerConfig, shoot *gardener_types.Shoot) apperrors.AppError {
 	shoot.Spec.CloudProfileName = util.UnwrapOrZero(c.input.CloudProfileName)
 
-	workers := []gardener_types.Worker{getWorkerConfig(gardenerConfig, c.input.Zones)}
+	workers := getWorkers(gardenerConfig, c.input.Zones)
 
 	openStackInfra := NewOpenStackInfrastructure(util.UnwrapOrZero(c.input.FloatingPoolName), gardenerConfig.WorkerCidr)
 
@@ -744,9 +811,122 @@ func (c OpenStackGardenerConfig) ExtendShootConfig(gardenerConfig GardenerConfig
 	return nil
 }
 
+type AlicloudGardenerConfig struct {
+	ProviderSpecificConfig
+	input *gqlschema.AlicloudProviderConfigInput `db:"-"`
+}
+
+func NewAlicloudGardenerConfig(input *gqlschema.AlicloudProviderConfigInput) (*AlicloudGardenerConfig, apperrors.AppError) {
+	config, err := json.Marshal(input)
+	if err != nil {
+		return &AlicloudGardenerConfig{}, apperrors.Internal("failed to marshal Alicloud Gardener config")
+	}
+
+	return &AlicloudGardenerConfig{
+		ProviderSpecificConfig: ProviderSpecificConfig(config),
+		input:                  input,
+	}, nil
+}
+
+func (c AlicloudGardenerConfig) NodeCIDR(GardenerConfig) string {
+	return c.input.VpcCidr
+}
+
+func (c AlicloudGardenerConfig) AsProviderSpecificConfig() gqlschema.ProviderSpecificConfig {
+	zones := make([]*gqlschema.AlicloudZone, 0)
+
+	for _, inputZone := range c.input.AlicloudZones {
+		zone := &gqlschema.AlicloudZone{
+			Name:       inputZone.Name,
+			WorkerCidr: inputZone.WorkerCidr,
+		}
+		zones = append(zones, zone)
+	}
+
+	return gqlschema.AlicloudProviderConfig{
+		AlicloudZones: zones,
+		VpcCidr:       &c.input.VpcCidr,
+	}
+}
+
+func (c AlicloudGardenerConfig) ValidateShootConfigChange(shoot *gardener_types.Shoot) apperrors.AppError {
+	infra := alicloud.InfrastructureConfig{}
+	err := json.Unmarshal(shoot.Spec.Provider.InfrastructureConfig.Raw, &infra)
+	if err != nil {
+		return apperrors.Internal("error decoding infrastructure config: %s", err.Error())
+	}
+	if infra.Networks.VPC.CIDR != nil && *infra.Networks.VPC.CIDR != c.input.VpcCidr {
+		return apperrors.BadRequest("cannot change shoot VPC CIDR from %s to %s", *infra.Networks.VPC.CIDR, c.input.VpcCidr)
+	}
+	for _, inputZone := range c.input.AlicloudZones {
+		zoneFound := false
+		for _, zone := range infra.Networks.Zones {
+			if inputZone.Name == zone.Name {
+				zoneFound = true
+				if inputZone.WorkerCidr != zone.Workers {
+					return apperrors.BadRequest("cannot change shoot network zone workers CIDR from %s to %s", zone.Workers, inputZone.WorkerCidr)
+				}
+			}
+		}
+
+		if !zoneFound {
+			return apperrors.BadRequest("extension of shoot network zones is not supported")
+		}
+	}
+
+	return nil
+}
+
+func (c AlicloudGardenerConfig) EditShootConfig(gardenerConfig GardenerConfig, shoot *gardener_types.Shoot) apperrors.AppError {
+	return updateShootConfig(gardenerConfig, shoot)
+}
+
+func (c AlicloudGardenerConfig) ExtendShootConfig(gardenerConfig GardenerConfig, shoot *gardener_types.Shoot) apperrors.AppError {
+	shoot.Spec.CloudProfileName = "alicloud"
+
+	zoneNames := getAlicloudZonesNames(c.input.AlicloudZones)
+
+	workers := getWorkers(gardenerConfig, zoneNames)
+
+	alicloudInfra := NewAlicloudInfrastructure(c)
+	jsonData, err := json.Marshal(alicloudInfra)
+	if err != nil {
+		return apperrors.Internal("error encoding infrastructure config: %s", err.Error())
+	}
+
+	alicloudControlPlane := NewAlicloudControlPlane()
+	jsonCPData, err := json.Marshal(alicloudControlPlane)
+	if err != nil {
+		return apperrors.Internal("error encoding control plane config: %s", err.Error())
+	}
+
+	shoot.Spec.Provider = gardener_types.Provider{
+		Type:                 "alicloud",
+		ControlPlaneConfig:   &apimachineryRuntime.RawExtension{Raw: jsonCPData},
+		InfrastructureConfig: &apimachineryRuntime.RawExtension{Raw: jsonData},
+		Workers:              workers,
+		WorkersSettings: &gardener_types.WorkersSettings{
+			SSHAccess: &gardener_types.SSHAccess{Enabled: false},
+		},
+	}
+
+	return nil
+}
+
+// getWorkers returns the default worker group followed by the additional worker pools, all placed in the same zones
+func getWorkers(gardenerConfig GardenerConfig, zones []string) []gardener_types.Worker {
+	workers := []gardener_types.Worker{getWorkerConfig(gardenerConfig, zones)}
+
+	for _, pool := range gardenerConfig.AdditionalWorkerPools {
+		workers = append(workers, getWorkerPoolConfig(gardenerConfig, pool, zones))
+	}
+
+	return workers
+}
+
 func getWorkerConfig(gardenerConfig GardenerConfig, zones []string) gardener_types.Worker {
 	worker := gardener_types.Worker{
-		Name:           "cpu-worker-0",
+		Name:           DefaultWorkerName,
 		MaxSurge:       util.PtrTo(intstr.FromInt(gardenerConfig.MaxSurge)),
 		MaxUnavailable: util.PtrTo(intstr.FromInt(gardenerConfig.MaxUnavailable)),
 		Machine:        getMachineConfig(gardenerConfig),
@@ -762,9 +942,135 @@ func getWorkerConfig(gardenerConfig GardenerConfig, zones []string) gardener_typ
 		}
 	}
 
+	applyNodeConfig(gardenerConfig.NodeConfig, &worker)
+
+	return worker
+}
+
+func getWorkerPoolConfig(gardenerConfig GardenerConfig, pool WorkerPool, zones []string) gardener_types.Worker {
+	worker := gardener_types.Worker{
+		Name:  pool.Name,
+		Zones: zones,
+	}
+	applyWorkerPoolConfig(gardenerConfig, pool, &worker)
+
 	return worker
 }
 
+// applyWorkerPoolConfig sets worker pool settings on the worker, machine image defaults to the one of the default worker group
+func applyWorkerPoolConfig(gardenerConfig GardenerConfig, pool WorkerPool, worker *gardener_types.Worker) {
+	worker.MaxSurge = util.PtrTo(intstr.FromInt(pool.MaxSurge))
+	worker.MaxUnavailable = util.PtrTo(intstr.FromInt(pool.MaxUnavailable))
+	worker.Maximum = int32(pool.AutoScalerMax)
+	worker.Minimum = int32(pool.AutoScalerMin)
+
+	machineConfig := gardenerConfig
+	machineConfig.MachineType = pool.MachineType
+	if util.NotNilOrEmpty(pool.MachineImage) {
+		machineConfig.MachineImage = pool.MachineImage
+		machineConfig.MachineImageVersion = pool.MachineImageVersion
+	}
+	worker.Machine = getMachineConfig(machineConfig)
+
+	if pool.DiskType != nil && pool.VolumeSizeGB != nil {
+		worker.Volume = &gardener_types.Volume{
+			Type:       pool.DiskType,
+			VolumeSize: fmt.Sprintf("%dGi", *pool.VolumeSizeGB),
+		}
+	} else {
+		worker.Volume = nil
+	}
+
+	applyNodeConfig(pool.NodeConfig, worker)
+}
+
+// applyNodeConfig sets node labels, taints and kubelet settings on the worker. The worker is not changed when node config is not provided, container runtime is changed only when set
+func applyNodeConfig(nodeConfig *NodeConfig, worker *gardener_types.Worker) {
+	if nodeConfig == nil {
+		return
+	}
+
+	worker.Labels = nodeConfig.Labels
+
+	var taints []corev1.Taint
+	for _, taint := range nodeConfig.Taints {
+		taints = append(taints, corev1.Taint{
+			Key:    taint.Key,
+			Value:  taint.Value,
+			Effect: corev1.TaintEffect(taint.Effect),
+		})
+	}
+	worker.Taints = taints
+
+	if util.NotNilOrEmpty(nodeConfig.CRIName) {
+		if worker.CRI == nil {
+			worker.CRI = &gardener_types.CRI{}
+		}
+		worker.CRI.Name = gardener_types.CRIName(*nodeConfig.CRIName)
+	}
+
+	if nodeConfig.KubeletConfig == nil {
+		if worker.Kubernetes != nil {
+			worker.Kubernetes.Kubelet = nil
+		}
+		return
+	}
+
+	if worker.Kubernetes == nil {
+		worker.Kubernetes = &gardener_types.WorkerKubernetes{}
+	}
+	worker.Kubernetes.Kubelet = gardenerKubeletConfig(nodeConfig.KubeletConfig)
+}
+
+func gardenerKubeletConfig(kubeletConfig *KubeletConfig) *gardener_types.KubeletConfig {
+	kubelet := &gardener_types.KubeletConfig{}
+
+	if kubeletConfig.MaxPods != nil {
+		kubelet.MaxPods = util.PtrTo(int32(*kubeletConfig.MaxPods))
+	}
+	if kubeletConfig.PodPIDsLimit != nil {
+		kubelet.PodPIDsLimit = util.PtrTo(int64(*kubeletConfig.PodPIDsLimit))
+	}
+	if kubeletConfig.EvictionHard != nil {
+		kubelet.EvictionHard = &gardener_types.KubeletConfigEviction{
+			MemoryAvailable:   kubeletConfig.EvictionHard.MemoryAvailable,
+			ImageFSAvailable:  kubeletConfig.EvictionHard.ImageFSAvailable,
+			ImageFSInodesFree: kubeletConfig.EvictionHard.ImageFSInodesFree,
+			NodeFSAvailable:   kubeletConfig.EvictionHard.NodeFSAvailable,
+			NodeFSInodesFree:  kubeletConfig.EvictionHard.NodeFSInodesFree,
+		}
+	}
+
+	return kubelet
+}
+
+// updateWorkerPools replaces the workers following the default worker group with the additional worker pools.
+// Workers of existing pools keep their provider config and zones, new pools are placed in the zones of the default worker group
+func updateWorkerPools(upgradeConfig GardenerConfig, shoot *gardener_types.Shoot) {
+	defaultWorker := shoot.Spec.Provider.Workers[0]
+
+	existingWorkers := make(map[string]gardener_types.Worker)
+	for _, worker := range shoot.Spec.Provider.Workers[1:] {
+		existingWorkers[worker.Name] = worker
+	}
+
+	workers := []gardener_types.Worker{defaultWorker}
+	for _, pool := range upgradeConfig.AdditionalWorkerPools {
+		worker, found := existingWorkers[pool.Name]
+		if !found {
+			worker = gardener_types.Worker{
+				Name:           pool.Name,
+				Zones:          defaultWorker.Zones,
+				ProviderConfig: defaultWorker.ProviderConfig.DeepCopy(),
+			}
+		}
+		applyWorkerPoolConfig(upgradeConfig, pool, &worker)
+		workers = append(workers, worker)
+	}
+
+	shoot.Spec.Provider.Workers = workers
+}
+
 func updateShootConfig(upgradeConfig GardenerConfig, shoot *gardener_types.Shoot) apperrors.AppError {
 
 	if upgradeConfig.KubernetesVersion != "" {
@@ -793,7 +1099,7 @@ func updateShootConfig(upgradeConfig GardenerConfig, shoot *gardener_types.Shoot
 		shoot.Spec.Provider.Workers[0].Volume.VolumeSize = fmt.Sprintf("%dGi", *upgradeConfig.VolumeSizeGB)
 	}
 
-	// We support only single working group during provisioning
+	// The first worker group is the default one, additional worker pools follow it
 	shoot.Spec.Provider.Workers[0].MaxSurge = util.PtrTo(intstr.FromInt(upgradeConfig.MaxSurge))
 	shoot.Spec.Provider.Workers[0].MaxUnavailable = util.PtrTo(intstr.FromInt(upgradeConfig.MaxUnavailable))
 	shoot.Spec.Provider.Workers[0].Machine.Type = upgradeConfig.MachineType
@@ -805,6 +1111,9 @@ func updateShootConfig(upgradeConfig GardenerConfig, shoot *gardener_types.Shoot
 	if util.NotNilOrEmpty(upgradeConfig.MachineImageVersion) {
 		shoot.Spec.Provider.Workers[0].Machine.Image.Version = upgradeConfig.MachineImageVersion
 	}
+	applyNodeConfig(upgradeConfig.NodeConfig, &shoot.Spec.Provider.Workers[0])
+
+	updateWorkerPools(upgradeConfig, shoot)
 
 	// block SSHAccess for all upgraded clusters
 	shoot.Spec.Provider.WorkersSettings = &gardener_types.WorkersSettings{
@@ -891,3 +1200,12 @@ func getAzureZonesNames(zones []*gqlschema.AzureZoneInput) []string {
 	}
 	return zoneNames
 }
+
+func getAlicloudZonesNames(zones []*gqlschema.AlicloudZoneInput) []string {
+	zoneNames := make([]string, 0)
+
+	for _, zone := range zones {
+		zoneNames = append(zoneNames, zone.Name)
+	}
+	return zoneNames
+}
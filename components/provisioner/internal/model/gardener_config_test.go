@@ -9,6 +9,7 @@ import (
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apimachineryRuntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -25,6 +26,7 @@ func Test_NewGardenerConfigFromJSON(t *testing.T) {
 	azureZoneSubnetsConfigJSON := `{"vnetCidr":"10.10.11.11/255", "azureZones":[{"name":1,"cidr":"10.10.11.12/255"}, {"name":2,"cidr":"10.10.11.13/255"}], "enableNatGateway":true, "idleConnectionTimeoutMinutes":4}`
 	awsConfigJSON := `{"vpcCidr":"10.10.11.11/255","awsZones":[{"name":"zone","publicCidr":"10.10.11.12/255","internalCidr":"10.10.11.13/255","workerCidr":"10.10.11.11/255"}], "enableIMDSv2": true}
 `
+	alicloudConfigJSON := `{"vpcCidr":"10.10.11.11/255","alicloudZones":[{"name":"zone","workerCidr":"10.10.11.12/255"}]}`
 
 	for _, testCase := range []struct {
 		description                    string
@@ -126,6 +128,23 @@ func Test_NewGardenerConfigFromJSON(t *testing.T) {
 				EnableIMDSv2: util.PtrTo(true),
 			},
 		},
+		{
+			description: "should create Alicloud Gardener config",
+			jsonData:    alicloudConfigJSON,
+			expectedConfig: &AlicloudGardenerConfig{
+				ProviderSpecificConfig: ProviderSpecificConfig(alicloudConfigJSON),
+				input:                  fixAlicloudGardenerInput(),
+			},
+			expectedProviderSpecificConfig: gqlschema.AlicloudProviderConfig{
+				AlicloudZones: []*gqlschema.AlicloudZone{
+					{
+						Name:       "zone",
+						WorkerCidr: "10.10.11.12/255",
+					},
+				},
+				VpcCidr: util.PtrTo("10.10.11.11/255"),
+			},
+		},
 	} {
 		t.Run(testCase.description, func(t *testing.T) {
 			// when
@@ -163,6 +182,9 @@ func TestGardenerConfig_ToShootTemplate(t *testing.T) {
 	awsGardenerProvider, err := NewAWSGardenerConfig(fixAWSGardenerInput(true))
 	require.NoError(t, err)
 
+	alicloudGardenerProvider, err := NewAlicloudGardenerConfig(fixAlicloudGardenerInput())
+	require.NoError(t, err)
+
 	for _, testCase := range []struct {
 		description           string
 		provider              string
@@ -596,6 +618,91 @@ func TestGardenerConfig_ToShootTemplate(t *testing.T) {
 				},
 			},
 		},
+		{description: "should convert to Shoot template with Alicloud provider",
+			provider:       "alicloud",
+			providerConfig: alicloudGardenerProvider,
+			expectedShootTemplate: &gardener_types.Shoot{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      "cluster",
+					Namespace: "gardener-namespace",
+					Labels: map[string]string{
+						"account":    "account",
+						"subaccount": "sub-account",
+					},
+					Annotations: map[string]string{
+						"support.gardener.cloud/eu-access-for-cluster-nodes": "true",
+					},
+				},
+				Spec: gardener_types.ShootSpec{
+					CloudProfileName: "alicloud",
+					Networking: &gardener_types.Networking{
+						Type:     &networkingType,
+						Nodes:    util.PtrTo("10.10.11.11/255"),
+						Pods:     util.PtrTo("10.10.11.10/24"),
+						Services: util.PtrTo("10.10.12.10/24"),
+					},
+					SeedName:          util.PtrTo("eu"),
+					SecretBindingName: &gardenerSecret,
+					Region:            "eu",
+					Provider: gardener_types.Provider{
+						Type: "alicloud",
+						ControlPlaneConfig: &apimachineryRuntime.RawExtension{
+							Raw: []byte(`{"kind":"ControlPlaneConfig","apiVersion":"alicloud.provider.extensions.gardener.cloud/v1alpha1"}`),
+						},
+						InfrastructureConfig: &apimachineryRuntime.RawExtension{
+							Raw: []byte(`{"kind":"InfrastructureConfig","apiVersion":"alicloud.provider.extensions.gardener.cloud/v1alpha1","networks":{"vpc":{"cidr":"10.10.11.11/255"},"zones":[{"name":"zone","workers":"10.10.11.12/255"}]}}`),
+						},
+						Workers: []gardener_types.Worker{
+							fixWorker([]string{"zone"}, nil),
+						},
+						WorkersSettings: &gardener_types.WorkersSettings{
+							SSHAccess: &gardener_types.SSHAccess{Enabled: false},
+						},
+					},
+					Purpose:           &purpose,
+					ExposureClassName: util.PtrTo("internet"),
+					Kubernetes: gardener_types.Kubernetes{
+						Version: "1.15",
+						KubeAPIServer: &gardener_types.KubeAPIServerConfig{
+							OIDCConfig: gardenerOidcConfig(oidcConfig()),
+						},
+						EnableStaticTokenKubeconfig: util.PtrTo(false),
+					},
+					Maintenance: &gardener_types.Maintenance{
+						AutoUpdate: &gardener_types.MaintenanceAutoUpdate{
+							KubernetesVersion:   true,
+							MachineImageVersion: util.PtrTo(false),
+						},
+					},
+					DNS: gardenerDnsConfig(dnsConfig()),
+					Extensions: []gardener_types.Extension{
+						{
+							Type: "shoot-dns-service",
+							ProviderConfig: &apimachineryRuntime.RawExtension{
+								Raw: []byte(`{"apiVersion":"service.dns.extensions.gardener.cloud/v1alpha1","dnsProviderReplication":{"enabled":true},"kind":"DNSConfig"}`),
+							},
+						},
+						{
+							Type: "shoot-cert-service",
+							ProviderConfig: &apimachineryRuntime.RawExtension{
+								Raw: []byte(`{"apiVersion":"service.cert.extensions.gardener.cloud/v1alpha1","shootIssuers":{"enabled":true},"kind":"CertConfig"}`),
+							},
+						},
+						{
+							Type:     ShootNetworkingFilterExtensionType,
+							Disabled: util.PtrTo(true),
+						},
+					},
+					ControlPlane: &gardener_types.ControlPlane{
+						HighAvailability: &gardener_types.HighAvailability{
+							FailureTolerance: gardener_types.FailureTolerance{
+								Type: gardener_types.FailureToleranceTypeZone,
+							},
+						},
+					},
+				},
+			},
+		},
 	} {
 		t.Run(testCase.description, func(t *testing.T) {
 			// given
@@ -611,6 +718,80 @@ func TestGardenerConfig_ToShootTemplate(t *testing.T) {
 	}
 }
 
+func TestGardenerConfig_ToShootTemplate_AdditionalWorkerPools(t *testing.T) {
+	// given
+	awsGardenerProvider, err := NewAWSGardenerConfig(fixAWSGardenerInput(true))
+	require.NoError(t, err)
+
+	gardenerConfig := fixGardenerConfig("aws", awsGardenerProvider)
+	gardenerConfig.AdditionalWorkerPools = []WorkerPool{
+		{Name: "spot", MachineType: "spot-machine", AutoScalerMin: 0, AutoScalerMax: 5, MaxSurge: 1, MaxUnavailable: 0},
+	}
+
+	// when
+	template, err := gardenerConfig.ToShootTemplate("gardener-namespace", "account", "sub-account", oidcConfig(), dnsConfig())
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []gardener_types.Worker{
+		fixWorker([]string{"zone"}, &apimachineryRuntime.RawExtension{
+			Raw: []byte(`{"kind":"WorkerConfig","apiVersion":"aws.provider.extensions.gardener.cloud/v1alpha1","instanceMetadataOptions":{"httpTokens":"required","httpPutResponseHopLimit":2}}`),
+		}),
+		{
+			Name: "spot",
+			Machine: gardener_types.Machine{
+				Type:  "spot-machine",
+				Image: &gardener_types.ShootMachineImage{Name: "gardenlinux", Version: util.PtrTo("25.0.0")},
+			},
+			MaxSurge:       util.PtrTo(intstr.FromInt(1)),
+			MaxUnavailable: util.PtrTo(intstr.FromInt(0)),
+			Maximum:        5,
+			Minimum:        0,
+			Zones:          []string{"zone"},
+			ProviderConfig: &apimachineryRuntime.RawExtension{
+				Raw: []byte(`{"kind":"WorkerConfig","apiVersion":"aws.provider.extensions.gardener.cloud/v1alpha1","instanceMetadataOptions":{"httpTokens":"required","httpPutResponseHopLimit":2}}`),
+			},
+		},
+	}, template.Spec.Provider.Workers)
+}
+
+func TestGardenerConfig_ToShootTemplate_NodeConfig(t *testing.T) {
+	// given
+	gcpGardenerProvider, err := NewGCPGardenerConfig(fixGCPGardenerInput([]string{"fix-zone-1"}))
+	require.NoError(t, err)
+
+	gardenerConfig := fixGardenerConfig("gcp", gcpGardenerProvider)
+	gardenerConfig.NodeConfig = fixNodeConfig()
+	gardenerConfig.AdditionalWorkerPools = []WorkerPool{
+		{Name: "spot", MachineType: "spot-machine", AutoScalerMax: 5, NodeConfig: &NodeConfig{Labels: map[string]string{"pool": "spot"}}},
+	}
+
+	// when
+	template, err := gardenerConfig.ToShootTemplate("gardener-namespace", "account", "sub-account", oidcConfig(), dnsConfig())
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, template.Spec.Provider.Workers, 2)
+
+	worker := template.Spec.Provider.Workers[0]
+	assert.Equal(t, map[string]string{"workload": "memory"}, worker.Labels)
+	assert.Equal(t, []corev1.Taint{{Key: "dedicated", Value: "memory", Effect: corev1.TaintEffectNoSchedule}}, worker.Taints)
+	assert.Equal(t, &gardener_types.CRI{Name: gardener_types.CRINameContainerD}, worker.CRI)
+	assert.Equal(t, &gardener_types.WorkerKubernetes{
+		Kubelet: &gardener_types.KubeletConfig{
+			MaxPods:      util.PtrTo(int32(200)),
+			PodPIDsLimit: util.PtrTo(int64(4096)),
+			EvictionHard: &gardener_types.KubeletConfigEviction{MemoryAvailable: util.PtrTo("200Mi")},
+		},
+	}, worker.Kubernetes)
+
+	pool := template.Spec.Provider.Workers[1]
+	assert.Equal(t, map[string]string{"pool": "spot"}, pool.Labels)
+	assert.Nil(t, pool.Taints)
+	assert.Nil(t, pool.CRI)
+	assert.Nil(t, pool.Kubernetes)
+}
+
 func TestAdjustStaticKubeconfigFlagK8s126(t *testing.T) {
 	//given old (1.26) shoot and request to upgrade not relevant to k8s version
 	config := GardenerConfig{}
@@ -682,6 +863,9 @@ func TestEditShootConfig(t *testing.T) {
 	gcpProviderConfig, err := NewGCPGardenerConfig(fixGCPGardenerInput(zones))
 	require.NoError(t, err)
 
+	alicloudProviderConfig, err := NewAlicloudGardenerConfig(fixAlicloudGardenerInput())
+	require.NoError(t, err)
+
 	initialShootWithInfrastructureConfig := initialShoot.DeepCopy()
 	initialShootWithInfrastructureConfig.Spec.Provider.InfrastructureConfig = &apimachineryRuntime.RawExtension{
 		Raw: []byte(azureProviderConfig.RawJSON()),
@@ -707,6 +891,68 @@ func TestEditShootConfig(t *testing.T) {
 		Raw: []byte(`{"kind":"InfrastructureConfig","apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","networks":{"vnet":{"cidr":"10.10.11.11/255"},"zones":[{"name":0,"cidr":"","natGateway":{"enabled":true,"idleConnectionTimeoutMinutes":4}},{"name":1,"cidr":"","natGateway":{"enabled":true,"idleConnectionTimeoutMinutes":4}}]},"zoned":false}`),
 	}
 
+	initialShootWithWorkerPools := testkit.NewTestShoot("shoot").
+		WithAutoUpdate(false, false).
+		WithWorkers(
+			testkit.NewTestWorker("peon").WithZones("fix-zone-1").ToWorker(),
+			gardener_types.Worker{
+				Name:           "spot",
+				Machine:        gardener_types.Machine{Type: "old-machine"},
+				Zones:          []string{"fix-zone-2"},
+				ProviderConfig: &apimachineryRuntime.RawExtension{Raw: []byte(`{"kind":"WorkerConfig"}`)},
+			},
+			gardener_types.Worker{Name: "removed", Machine: gardener_types.Machine{Type: "machine"}},
+		).
+		ToShoot()
+
+	upgradeConfigWithWorkerPools := fixGardenerConfig("gcp", gcpProviderConfig)
+	upgradeConfigWithWorkerPools.AdditionalWorkerPools = []WorkerPool{
+		{Name: "spot", MachineType: "spot-machine", AutoScalerMin: 0, AutoScalerMax: 5, MaxSurge: 1, MaxUnavailable: 0},
+		{
+			Name:                "high-memory",
+			MachineType:         "memory-machine",
+			MachineImage:        util.PtrTo("ubuntu"),
+			MachineImageVersion: util.PtrTo("22.04"),
+			DiskType:            util.PtrTo("SSD"),
+			VolumeSizeGB:        util.PtrTo(50),
+			AutoScalerMin:       1,
+			AutoScalerMax:       2,
+			MaxSurge:            1,
+			MaxUnavailable:      0,
+		},
+	}
+
+	expectedShootWithWorkerPools := expectedShoot.DeepCopy()
+	expectedShootWithWorkerPools.Spec.Provider.Workers[0].Zones = []string{"fix-zone-1"}
+	expectedShootWithWorkerPools.Spec.Provider.Workers = append(expectedShootWithWorkerPools.Spec.Provider.Workers,
+		gardener_types.Worker{
+			Name: "spot",
+			Machine: gardener_types.Machine{
+				Type:  "spot-machine",
+				Image: &gardener_types.ShootMachineImage{Name: "gardenlinux", Version: util.PtrTo("25.0.0")},
+			},
+			MaxSurge:       util.PtrTo(intstr.FromInt(1)),
+			MaxUnavailable: util.PtrTo(intstr.FromInt(0)),
+			Maximum:        5,
+			Minimum:        0,
+			Zones:          []string{"fix-zone-2"},
+			ProviderConfig: &apimachineryRuntime.RawExtension{Raw: []byte(`{"kind":"WorkerConfig"}`)},
+		},
+		gardener_types.Worker{
+			Name: "high-memory",
+			Machine: gardener_types.Machine{
+				Type:  "memory-machine",
+				Image: &gardener_types.ShootMachineImage{Name: "ubuntu", Version: util.PtrTo("22.04")},
+			},
+			Volume:         &gardener_types.Volume{Type: util.PtrTo("SSD"), VolumeSize: "50Gi"},
+			MaxSurge:       util.PtrTo(intstr.FromInt(1)),
+			MaxUnavailable: util.PtrTo(intstr.FromInt(0)),
+			Maximum:        2,
+			Minimum:        1,
+			Zones:          []string{"fix-zone-1"},
+		},
+	)
+
 	for _, testCase := range []struct {
 		description   string
 		provider      string
@@ -726,6 +972,12 @@ func TestEditShootConfig(t *testing.T) {
 			initialShoot:  initialShoot.DeepCopy(),
 			expectedShoot: expectedShootConfigWithIMDSv2Enabled.DeepCopy(),
 		},
+		{description: "should edit Alicloud shoot template",
+			provider:      "alicloud",
+			upgradeConfig: fixGardenerConfig("alicloud", alicloudProviderConfig),
+			initialShoot:  initialShoot.DeepCopy(),
+			expectedShoot: expectedShoot.DeepCopy(),
+		},
 		{description: "should edit Azure shoot template",
 			provider:      "az",
 			upgradeConfig: fixGardenerConfig("az", azureProviderConfig),
@@ -766,6 +1018,40 @@ func TestEditShootConfig(t *testing.T) {
 				return shoot
 			}(expectedShoot),
 		},
+		{description: "should update node config of the default worker",
+			provider: "gcp",
+			upgradeConfig: func(config GardenerConfig) GardenerConfig {
+				config.NodeConfig = fixNodeConfig()
+				return config
+			}(fixGardenerConfig("gcp", gcpProviderConfig)),
+			initialShoot: func(s *gardener_types.Shoot) *gardener_types.Shoot {
+				shoot := s.DeepCopy()
+				shoot.Spec.Provider.Workers[0].Labels = map[string]string{"removed": "label"}
+				shoot.Spec.Provider.Workers[0].Kubernetes = &gardener_types.WorkerKubernetes{Version: util.PtrTo("1.15")}
+				return shoot
+			}(initialShoot),
+			expectedShoot: func(s *gardener_types.Shoot) *gardener_types.Shoot {
+				shoot := s.DeepCopy()
+				shoot.Spec.Provider.Workers[0].Labels = map[string]string{"workload": "memory"}
+				shoot.Spec.Provider.Workers[0].Taints = []corev1.Taint{{Key: "dedicated", Value: "memory", Effect: corev1.TaintEffectNoSchedule}}
+				shoot.Spec.Provider.Workers[0].CRI = &gardener_types.CRI{Name: gardener_types.CRINameContainerD}
+				shoot.Spec.Provider.Workers[0].Kubernetes = &gardener_types.WorkerKubernetes{
+					Version: util.PtrTo("1.15"),
+					Kubelet: &gardener_types.KubeletConfig{
+						MaxPods:      util.PtrTo(int32(200)),
+						PodPIDsLimit: util.PtrTo(int64(4096)),
+						EvictionHard: &gardener_types.KubeletConfigEviction{MemoryAvailable: util.PtrTo("200Mi")},
+					},
+				}
+				return shoot
+			}(expectedShoot),
+		},
+		{description: "should add, update and remove worker pools",
+			provider:      "gcp",
+			upgradeConfig: upgradeConfigWithWorkerPools,
+			initialShoot:  initialShootWithWorkerPools.DeepCopy(),
+			expectedShoot: expectedShootWithWorkerPools,
+		},
 	} {
 		t.Run(testCase.description, func(t *testing.T) {
 			// given
@@ -803,6 +1089,53 @@ func TestEditShootConfig(t *testing.T) {
 	}
 }
 
+func TestAlicloudGardenerConfig_ValidateShootConfigChange(t *testing.T) {
+	infrastructureConfig := func(raw string) *gardener_types.Shoot {
+		shoot := testkit.NewTestShoot("shoot").ToShoot()
+		shoot.Spec.Provider.InfrastructureConfig = &apimachineryRuntime.RawExtension{Raw: []byte(raw)}
+		return shoot
+	}
+
+	for _, testCase := range []struct {
+		description string
+		shoot       *gardener_types.Shoot
+		expectedErr string
+	}{
+		{description: "should accept unchanged network configuration",
+			shoot: infrastructureConfig(`{"networks":{"vpc":{"cidr":"10.10.11.11/255"},"zones":[{"name":"zone","workers":"10.10.11.12/255"}]}}`),
+		},
+		{description: "should reject VPC CIDR change",
+			shoot:       infrastructureConfig(`{"networks":{"vpc":{"cidr":"10.10.0.0/16"},"zones":[{"name":"zone","workers":"10.10.11.12/255"}]}}`),
+			expectedErr: "cannot change shoot VPC CIDR from 10.10.0.0/16 to 10.10.11.11/255",
+		},
+		{description: "should reject zone workers CIDR change",
+			shoot:       infrastructureConfig(`{"networks":{"vpc":{"cidr":"10.10.11.11/255"},"zones":[{"name":"zone","workers":"10.10.0.0/19"}]}}`),
+			expectedErr: "cannot change shoot network zone workers CIDR from 10.10.0.0/19 to 10.10.11.12/255",
+		},
+		{description: "should reject new zones",
+			shoot:       infrastructureConfig(`{"networks":{"vpc":{"cidr":"10.10.11.11/255"},"zones":[{"name":"other-zone","workers":"10.10.11.12/255"}]}}`),
+			expectedErr: "extension of shoot network zones is not supported",
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// given
+			alicloudProviderConfig, err := NewAlicloudGardenerConfig(fixAlicloudGardenerInput())
+			require.NoError(t, err)
+
+			// when
+			appErr := alicloudProviderConfig.ValidateShootConfigChange(testCase.shoot)
+
+			// then
+			if testCase.expectedErr == "" {
+				require.Nil(t, appErr)
+				return
+			}
+			require.NotNil(t, appErr)
+			assert.Equal(t, testCase.expectedErr, appErr.Error())
+		})
+	}
+}
+
 func fixGardenerConfig(provider string, providerCfg GardenerProviderConfig) GardenerConfig {
 	return GardenerConfig{
 		ID:                                  "",
@@ -839,6 +1172,19 @@ func fixGardenerConfig(provider string, providerCfg GardenerProviderConfig) Gard
 	}
 }
 
+func fixNodeConfig() *NodeConfig {
+	return &NodeConfig{
+		CRIName: util.PtrTo("containerd"),
+		KubeletConfig: &KubeletConfig{
+			EvictionHard: &KubeletEviction{MemoryAvailable: util.PtrTo("200Mi")},
+			MaxPods:      util.PtrTo(200),
+			PodPIDsLimit: util.PtrTo(4096),
+		},
+		Labels: map[string]string{"workload": "memory"},
+		Taints: []Taint{{Key: "dedicated", Value: "memory", Effect: "NoSchedule"}},
+	}
+}
+
 func fixAWSGardenerInput(enableIMDSv2 bool) *gqlschema.AWSProviderConfigInput {
 	return &gqlschema.AWSProviderConfigInput{
 		AwsZones: []*gqlschema.AWSZoneInput{
@@ -854,6 +1200,18 @@ func fixAWSGardenerInput(enableIMDSv2 bool) *gqlschema.AWSProviderConfigInput {
 	}
 }
 
+func fixAlicloudGardenerInput() *gqlschema.AlicloudProviderConfigInput {
+	return &gqlschema.AlicloudProviderConfigInput{
+		AlicloudZones: []*gqlschema.AlicloudZoneInput{
+			{
+				Name:       "zone",
+				WorkerCidr: "10.10.11.12/255",
+			},
+		},
+		VpcCidr: "10.10.11.11/255",
+	}
+}
+
 func fixGCPGardenerInput(zones []string) *gqlschema.GCPProviderConfigInput {
 	return &gqlschema.GCPProviderConfigInput{Zones: zones}
 }
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model/infrastructure/alicloud"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model/infrastructure/aws"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model/infrastructure/azure"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model/infrastructure/gcp"
@@ -19,6 +20,7 @@ const (
 	azureAPIVersion     = "azure.provider.extensions.gardener.cloud/v1alpha1"
 	awsAPIVersion       = "aws.provider.extensions.gardener.cloud/v1alpha1"
 	openStackApiVersion = "openstack.provider.extensions.gardener.cloud/v1alpha1"
+	alicloudAPIVersion  = "alicloud.provider.extensions.gardener.cloud/v1alpha1"
 
 	defaultConnectionTimeOutMinutes = 4
 )
@@ -168,6 +170,43 @@ func NewOpenStackControlPlane(loadBalancerProvider string) *openstack.ControlPla
 	}
 }
 
+func NewAlicloudInfrastructure(alicloudConfig AlicloudGardenerConfig) *alicloud.InfrastructureConfig {
+	return &alicloud.InfrastructureConfig{
+		TypeMeta: v1.TypeMeta{
+			Kind:       infrastructureConfigKind,
+			APIVersion: alicloudAPIVersion,
+		},
+		Networks: alicloud.Networks{
+			Zones: createAlicloudZones(alicloudConfig.input.AlicloudZones),
+			VPC: alicloud.VPC{
+				CIDR: util.PtrTo(alicloudConfig.input.VpcCidr),
+			},
+		},
+	}
+}
+
+func createAlicloudZones(inputZones []*gqlschema.AlicloudZoneInput) []alicloud.Zone {
+	zones := make([]alicloud.Zone, 0)
+
+	for _, inputZone := range inputZones {
+		zone := alicloud.Zone{
+			Name:    inputZone.Name,
+			Workers: inputZone.WorkerCidr,
+		}
+		zones = append(zones, zone)
+	}
+	return zones
+}
+
+func NewAlicloudControlPlane() *alicloud.ControlPlaneConfig {
+	return &alicloud.ControlPlaneConfig{
+		TypeMeta: v1.TypeMeta{
+			Kind:       controlPlaneConfigKind,
+			APIVersion: alicloudAPIVersion,
+		},
+	}
+}
+
 func NewAWSWorkerConfig(httpPutResponseHopLimit int64) *aws.WorkerConfig {
 
 	return &aws.WorkerConfig{
@@ -0,0 +1,31 @@
+package alicloud
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ControlPlaneConfig contains configuration settings for the control plane.
+type ControlPlaneConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CloudControllerManager contains configuration settings for the cloud-controller-manager.
+	// +optional
+	CloudControllerManager *CloudControllerManagerConfig `json:"cloudControllerManager,omitempty"`
+	// CSI is the config for CSI plugin
+	// +optional
+	CSI *CSI `json:"csi,omitempty"`
+}
+
+// CloudControllerManagerConfig contains configuration settings for the cloud-controller-manager.
+type CloudControllerManagerConfig struct {
+	// FeatureGates contains information about enabled feature gates.
+	// +optional
+	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+}
+
+// CSI is csi components configuration.
+type CSI struct {
+	// EnableADController enables disks to be attached/detached from nodes by controller-manager instead of kubelet.
+	// +optional
+	EnableADController *bool `json:"enableADController,omitempty"`
+}
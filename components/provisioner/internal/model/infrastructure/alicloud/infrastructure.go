@@ -0,0 +1,57 @@
+package alicloud
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// This types are copied from https://github.com/gardener/gardener-extension-provider-alicloud/blob/master/pkg/apis/alicloud/v1alpha1/types_infrastructure.go
+
+// InfrastructureConfig infrastructure configuration resource
+type InfrastructureConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Networks is the network configuration (VPC, vSwitches, NAT gateways)
+	Networks Networks `json:"networks"`
+}
+
+// Networks holds information about the Kubernetes and infrastructure networks.
+type Networks struct {
+	// VPC indicates whether to use an existing VPC or create a new one.
+	VPC VPC `json:"vpc"`
+	// Zones belonging to the same region
+	Zones []Zone `json:"zones"`
+}
+
+// Zone is a zone with a name and worker CIDR.
+type Zone struct {
+	// Name is the name of a zone.
+	Name string `json:"name"`
+	// Worker specifies the worker CIDR to use.
+	// Deprecated - use `workers` instead.
+	Worker string `json:"worker,omitempty"`
+	// Workers specifies the worker CIDR to use.
+	Workers string `json:"workers"`
+	// NatGateway contains information about the NAT gateway used in the zone.
+	// +optional
+	NatGateway *NatGatewayConfig `json:"natGateway,omitempty"`
+}
+
+// NatGatewayConfig contains configuration for the NAT gateway and the attached EIP.
+type NatGatewayConfig struct {
+	// EIPAllocationID is the id of the existing EIP.
+	// +optional
+	EIPAllocationID *string `json:"eipAllocationID,omitempty"`
+}
+
+// VPC contains information about whether to create a new or use an existing VPC.
+type VPC struct {
+	// ID is the ID of an existing VPC.
+	// +optional
+	ID *string `json:"id,omitempty"`
+	// CIDR is the CIDR of a VPC to create.
+	// +optional
+	CIDR *string `json:"cidr,omitempty"`
+	// GardenerManagedNATGateway indicates whether Gardener should create the NAT gateway for an existing VPC.
+	// +optional
+	GardenerManagedNATGateway *bool `json:"gardenerManagedNATGateway,omitempty"`
+}
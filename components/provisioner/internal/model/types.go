@@ -10,8 +10,29 @@ const (
 	InProgress OperationState = "IN_PROGRESS"
 	Succeeded  OperationState = "SUCCEEDED"
 	Failed     OperationState = "FAILED"
+	Cancelled  OperationState = "CANCELLED"
 )
 
+// OperationPriority decides the order in which queued operations are processed, higher priority is served first
+type OperationPriority int
+
+const (
+	LowPriority    OperationPriority = -1
+	NormalPriority OperationPriority = 0
+	HighPriority   OperationPriority = 1
+)
+
+func (p OperationPriority) String() string {
+	switch p {
+	case LowPriority:
+		return "low"
+	case HighPriority:
+		return "high"
+	default:
+		return "normal"
+	}
+}
+
 type OperationType string
 
 const (
@@ -23,6 +44,7 @@ const (
 	DeprovisionNoInstall OperationType = "DEPROVISION_NO_INSTALL"
 	ReconnectRuntime     OperationType = "RECONNECT_RUNTIME"
 	Hibernate            OperationType = "HIBERNATE"
+	WakeUp               OperationType = "WAKE_UP"
 )
 
 type OperationStage string
@@ -48,6 +70,7 @@ const (
 	WaitingForShootNewVersion OperationStage = "WaitingForShootNewVersion"
 
 	WaitForHibernation OperationStage = "WaitForHibernation"
+	WaitForWakeUp      OperationStage = "WaitForWakeUp"
 
 	FinishedStage OperationStage = "Finished"
 )
@@ -84,6 +107,21 @@ type Operation struct {
 	ClusterID      string
 	Stage          OperationStage
 	LastTransition *time.Time
+	Priority       OperationPriority
+	// Attempts is the number of failed attempts of the current stage
+	Attempts int
+	// TraceContext links processing of the operation to the trace of the request which started it
+	TraceContext string
+	LastError
+}
+
+// OperationRetry records the state of the failed operation at the moment it was retried
+type OperationRetry struct {
+	ID          string
+	OperationID string
+	Stage       OperationStage
+	Timestamp   time.Time
+	Message     string
 	LastError
 }
 
@@ -100,6 +138,7 @@ type RuntimeStatus struct {
 	RuntimeConnectionStatus RuntimeAgentConnectionStatus
 	RuntimeConfiguration    Cluster
 	HibernationStatus       HibernationStatus
+	Drift                   []ShootDrift
 }
 
 type OperationsCount struct {
@@ -110,3 +149,86 @@ type HibernationStatus struct {
 	Hibernated          bool
 	HibernationPossible bool
 }
+
+type RuntimeFilter struct {
+	Tenant             *string
+	SubAccountID       *string
+	Provider           *string
+	Region             *string
+	KubernetesVersion  *string
+	LastOperationState *OperationState
+	Deleted            *bool
+}
+
+type OperationFilter struct {
+	Types  []OperationType
+	States []OperationState
+}
+
+// PageCursor identifies the last element of the previously returned page, elements are ordered by timestamp and ID
+type PageCursor struct {
+	Timestamp time.Time
+	ID        string
+}
+
+// IdempotencyKey is reserved before the operation is started, OperationID is set once the operation is created
+type IdempotencyKey struct {
+	Tenant      string
+	Key         string
+	PayloadHash string
+	OperationID *string
+	CreatedAt   time.Time
+}
+
+// QueuedOperation is the operation scheduled in the durable queue
+type QueuedOperation struct {
+	OperationID string
+	Queue       string
+	Priority    OperationPriority
+	NextRunAt   time.Time
+	Attempts    int
+}
+
+// DryRunResult contains the Shoot manifest which would be sent to Gardener and its difference from the current Shoot
+type DryRunResult struct {
+	Manifest string
+	Diff     []ShootFieldDiff
+}
+
+type ShootFieldDiff struct {
+	Path     string
+	OldValue *string
+	NewValue *string
+}
+
+// DriftPolicy decides what the shoot reconciler does with the Shoot field which differs from the stored configuration
+type DriftPolicy string
+
+const (
+	// DriftPolicyRecord only records the difference
+	DriftPolicyRecord DriftPolicy = "record"
+	// DriftPolicyRevert restores the stored value in the Shoot
+	DriftPolicyRevert DriftPolicy = "revert"
+	// DriftPolicyAdopt stores the value found in the Shoot
+	DriftPolicyAdopt DriftPolicy = "adopt"
+)
+
+// ShootDrift is the Shoot field which was modified outside the Provisioner
+type ShootDrift struct {
+	ClusterID     string
+	Field         string
+	ExpectedValue string
+	ActualValue   string
+	Policy        DriftPolicy
+	DetectedAt    time.Time
+}
+
+// GardenerConfigChange is the history entry of the stored configuration synchronized with the Shoot
+type GardenerConfigChange struct {
+	ID        string
+	ClusterID string
+	Field     string
+	OldValue  string
+	NewValue  string
+	ChangedAt time.Time
+}
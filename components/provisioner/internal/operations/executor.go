@@ -1,14 +1,19 @@
 package operations
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
 
 	retry "github.com/avast/retry-go"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/events"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/metrics"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/tracing"
 	"github.com/sirupsen/logrus"
 )
 
@@ -18,17 +23,29 @@ const (
 
 var ErrKubeconfigNil = errors.New("cluster kubeconfig is nil")
 
+// errOperationNotInProgress is returned when the operation state was changed while its step was running, e.g. the operation was cancelled
+var errOperationNotInProgress = errors.New("operation is no longer in progress")
+
+// errOperationLost is returned when the operation was taken over by other replica while its step was running
+var errOperationLost = errors.New("operation was taken over by other replica")
+
 func NewExecutor(
 	session dbsession.ReadWriteSession,
 	operation model.OperationType,
 	stages map[model.OperationStage]Step,
-	failureHandler FailureHandler) *Executor {
+	failureHandler FailureHandler,
+	publisher events.Publisher,
+	recorder metrics.OperationsRecorder,
+	fence Fence) *Executor {
 
 	return &Executor{
 		dbSession:      session,
 		stages:         stages,
 		operation:      operation,
 		failureHandler: failureHandler,
+		publisher:      publisher,
+		recorder:       recorder,
+		fence:          fence,
 		log:            logrus.WithFields(logrus.Fields{"Component": "Executor", "OperationType": operation}),
 	}
 }
@@ -38,6 +55,9 @@ type Executor struct {
 	stages         map[model.OperationStage]Step
 	operation      model.OperationType
 	failureHandler FailureHandler
+	publisher      events.Publisher
+	recorder       metrics.OperationsRecorder
+	fence          Fence
 
 	log logrus.FieldLogger
 }
@@ -55,6 +75,14 @@ func (e *Executor) Execute(operationID string) ProcessingResult {
 
 	log = log.WithField("RuntimeId", operation.ClusterID)
 
+	ctx, span := tracing.StartLinked(context.Background(), "Executor.Execute", operation.TraceContext,
+		tracing.OperationIDKey.String(operation.ID), tracing.RuntimeIDKey.String(operation.ClusterID), tracing.OperationTypeKey.String(string(e.operation)))
+	defer span.End()
+
+	if operation.State == model.Cancelled && operation.Type == e.operation {
+		return e.handleOperationCancelled(operation, log)
+	}
+
 	if operation.State != model.InProgress {
 		log.Infof("Operation not InProgress. State: %s", operation.State)
 		return ProcessingResult{Requeue: false}
@@ -69,19 +97,44 @@ func (e *Executor) Execute(operationID string) ProcessingResult {
 	log = log.WithField("ShootName", cluster.ClusterConfig.Name)
 
 	if operation.Type == e.operation {
-		requeue, delay, err := e.process(operation, cluster, log)
-		e.updateOperationLastError(log, operation.ID, err)
+		requeue, delay, err := e.process(ctx, &operation, cluster, log)
+		if errors.Is(err, errOperationNotInProgress) || errors.Is(err, errOperationLost) {
+			return e.handleOperationInterrupted(operation.ID, log)
+		}
+		tracing.RecordError(span, err)
+		lastError := toLastError(err)
+		e.updateOperationLastError(log, operation.ID, lastError)
 		if err != nil {
 			nonRecoverable := NonRecoverableError{}
 			if errors.As(err, &nonRecoverable) {
 				log.Errorf("unrecoverable error occurred while processing operation: %s", err.Error())
+				if err := e.holdOperation(operation.ID); err != nil {
+					if errors.Is(err, errOperationLost) {
+						return e.handleOperationInterrupted(operation.ID, log)
+					}
+					log.Errorf("error checking ownership of failed operation: %s", err.Error())
+					return ProcessingResult{Requeue: true, Delay: defaultDelay}
+				}
 				e.handleOperationFailure(operation, cluster, log)
-				e.updateOperationStatus(log, operation.ID, nonRecoverable.Error(), model.Failed, time.Now())
+				failureTime := time.Now()
+				if err := e.updateOperationStatus(log, operation.ID, nonRecoverable.Error(), model.Failed, failureTime); err != nil {
+					if !errors.Is(err, errOperationNotInProgress) {
+						log.Warnf("Operation not marked as failed: %s", err.Error())
+						return ProcessingResult{Requeue: !errors.Is(err, errOperationLost), Delay: defaultDelay}
+					}
+					// The failure handler already did the clean-up needed by the cancelled operation
+					log.Infof("Operation cancelled while failing, not marking it as failed")
+					e.recorder.ObserveOperation(operation, cluster, model.Cancelled, lastError, failureTime)
+					return ProcessingResult{Requeue: false}
+				}
+				e.recorder.ObserveStage(operation, cluster, model.Failed, failureTime)
+				e.recorder.ObserveOperation(operation, cluster, model.Failed, lastError, failureTime)
+				e.publishOperationChanged(operation)
 
 				return ProcessingResult{Requeue: false}
 			}
 
-			return ProcessingResult{Requeue: true, Delay: defaultDelay}
+			return ProcessingResult{Requeue: true, Delay: delay}
 		}
 
 		return ProcessingResult{Requeue: requeue, Delay: delay}
@@ -93,7 +146,8 @@ func (e *Executor) Execute(operationID string) ProcessingResult {
 	}
 }
 
-func (e *Executor) process(operation model.Operation, cluster model.Cluster, logger logrus.FieldLogger) (bool, time.Duration, error) {
+// process runs steps of the operation until it has to wait, the operation is updated with the stages it went through
+func (e *Executor) process(ctx context.Context, operation *model.Operation, cluster model.Cluster, logger logrus.FieldLogger) (bool, time.Duration, error) {
 
 	step, found := e.stages[operation.Stage]
 	if !found {
@@ -105,30 +159,45 @@ func (e *Executor) process(operation model.Operation, cluster model.Cluster, log
 		log := logger.WithField("Stage", step.Name())
 		log.Infof("Starting processing")
 
-		if e.timeoutReached(operation, step.TimeLimit()) {
+		if e.timeoutReached(*operation, step.TimeLimit()) {
 			log.Errorf("Timeout reached for operation")
 			return false, 0, NewNonRecoverableError(apperrors.Internal("error: timeout while processing operation").SetReason(apperrors.ErrProvisionerTimeout))
 		}
 
-		result, err := step.Run(cluster, operation, log)
+		stepCtx, span := tracing.Start(ctx, fmt.Sprintf("Step.%s", step.Name()))
+		result, err := step.Run(stepCtx, cluster, *operation, log)
+		tracing.End(span, err)
 		if err != nil {
 			if errors.Is(err, ErrKubeconfigNil) {
 				log.Warnf("Warning, the %s", err)
 				// break
 			}
 			log.Warnf("error while processing operation, stage failed: %s", err.Error())
-			return false, 0, err
+			return e.handleStepError(*operation, step, err, log)
+		}
+
+		if operation.Attempts > 0 {
+			e.updateOperationAttempts(log, operation.ID, 0)
+			operation.Attempts = 0
 		}
 
 		if result.Stage == model.FinishedStage {
 			log.Infof("Finished processing operation")
-			e.updateOperationStage(log, operation.ID, "Provisioning steps finished", model.FinishedStage, time.Now())
+			finishTime := time.Now()
+			if err := e.updateOperationStage(log, operation.ID, "Provisioning steps finished", model.FinishedStage, finishTime); err != nil {
+				return false, 0, err
+			}
+			e.recorder.ObserveStage(*operation, cluster, model.Succeeded, finishTime)
 			break
 		}
 
 		if result.Stage != step.Name() {
 			transitionTime := time.Now()
-			e.updateOperationStage(log, operation.ID, fmt.Sprintf("Operation in progress. Stage %s", result.Stage), result.Stage, transitionTime)
+			if err := e.updateOperationStage(log, operation.ID, fmt.Sprintf("Operation in progress. Stage %s", result.Stage), result.Stage, transitionTime); err != nil {
+				return false, 0, err
+			}
+			e.recorder.ObserveStage(*operation, cluster, model.Succeeded, transitionTime)
+			e.publishOperationChanged(*operation)
 			step = e.stages[result.Stage]
 			operation.Stage = result.Stage
 			operation.LastTransition = &transitionTime
@@ -141,11 +210,35 @@ func (e *Executor) process(operation model.Operation, cluster model.Cluster, log
 	}
 
 	logger.Infof("Setting operation to succeeded")
-	e.updateOperationStatus(logger, operation.ID, "Operation succeeded", model.Succeeded, time.Now())
+	successTime := time.Now()
+	if err := e.updateOperationStatus(logger, operation.ID, "Operation succeeded", model.Succeeded, successTime); err != nil {
+		return false, 0, err
+	}
+	e.recorder.ObserveOperation(*operation, cluster, model.Succeeded, model.LastError{}, successTime)
+	e.publishOperationChanged(*operation)
 
 	return false, 0, nil
 }
 
+// handleStepError returns the delay of the next attempt, the error becomes non-recoverable when the step failed too many times
+func (e *Executor) handleStepError(operation model.Operation, step Step, err error, log logrus.FieldLogger) (bool, time.Duration, error) {
+	if nonRecoverable := (NonRecoverableError{}); errors.As(err, &nonRecoverable) {
+		return false, 0, err
+	}
+
+	policy := step.RetryPolicy()
+	attempts := operation.Attempts + 1
+
+	if policy.Exhausted(attempts) {
+		log.Errorf("Stage failed %d times, giving up", attempts)
+		return false, 0, NewNonRecoverableError(err)
+	}
+
+	e.updateOperationAttempts(log, operation.ID, attempts)
+
+	return true, policy.Delay(attempts), err
+}
+
 func (e *Executor) timeoutReached(operation model.Operation, timeout time.Duration) bool {
 
 	lastTimestamp := operation.StartTimestamp
@@ -158,6 +251,48 @@ func (e *Executor) timeoutReached(operation model.Operation, timeout time.Durati
 	return timePassed > timeout
 }
 
+func (e *Executor) handleOperationCancelled(operation model.Operation, log logrus.FieldLogger) ProcessingResult {
+	log.Infof("Operation cancelled, running clean-up")
+
+	cluster, err := e.dbSession.GetCluster(operation.ClusterID)
+	if err != nil {
+		log.Errorf("error getting cluster while cleaning up cancelled operation: %s", err.Error())
+		return ProcessingResult{Requeue: true, Delay: defaultDelay}
+	}
+
+	e.handleOperationFailure(operation, cluster, log.WithField("ShootName", cluster.ClusterConfig.Name))
+
+	cancelTime := time.Now()
+	if operation.EndTimestamp != nil {
+		cancelTime = *operation.EndTimestamp
+	}
+	e.recorder.ObserveOperation(operation, cluster, model.Cancelled, model.LastError{}, cancelTime)
+
+	return ProcessingResult{Requeue: false}
+}
+
+// handleOperationInterrupted stops processing of the operation which is no longer in progress or was taken over by other replica,
+// the cancelled operation is cleaned up
+func (e *Executor) handleOperationInterrupted(operationID string, log logrus.FieldLogger) ProcessingResult {
+	operation, err := e.dbSession.GetOperation(operationID)
+	if err != nil {
+		log.Errorf("error getting operation which is no longer in progress: %s", err.Error())
+		return ProcessingResult{Requeue: true, Delay: defaultDelay}
+	}
+
+	if operation.State == model.Cancelled {
+		return e.handleOperationCancelled(operation, log)
+	}
+
+	if operation.State == model.InProgress {
+		log.Warnf("Operation taken over by other replica, dropping its progress")
+		return ProcessingResult{Requeue: false}
+	}
+
+	log.Infof("Operation no longer InProgress. State: %s", operation.State)
+	return ProcessingResult{Requeue: false}
+}
+
 func (e *Executor) handleOperationFailure(operation model.Operation, cluster model.Cluster, log logrus.FieldLogger) {
 	err := retry.Do(func() error {
 		return e.failureHandler.HandleFailure(operation, cluster)
@@ -167,27 +302,66 @@ func (e *Executor) handleOperationFailure(operation model.Operation, cluster mod
 	}
 }
 
-func (e *Executor) updateOperationStatus(log logrus.FieldLogger, id, message string, state model.OperationState, t time.Time) {
+func (e *Executor) publishOperationChanged(operation model.Operation) {
+	e.publisher.Publish(events.OperationEvent{OperationID: operation.ID, RuntimeID: operation.ClusterID})
+}
+
+// holdOperation returns errOperationLost when the operation is no longer owned by the replica
+func (e *Executor) holdOperation(id string) error {
+	held, err := e.fence.Acquire(id)
+	if err != nil {
+		return fmt.Errorf("failed to check ownership of operation: %w", err)
+	}
+	if !held {
+		return errOperationLost
+	}
+	return nil
+}
+
+// updateOperationStatus returns errOperationNotInProgress when the operation was finished or cancelled concurrently,
+// errOperationLost is returned without storing the state when the operation was taken over by other replica
+func (e *Executor) updateOperationStatus(log logrus.FieldLogger, id, message string, state model.OperationState, t time.Time) error {
+	if err := e.holdOperation(id); err != nil {
+		return err
+	}
 	err := retry.Do(func() error {
-		return e.dbSession.UpdateOperationState(id, message, state, t)
-	}, retry.Attempts(5))
+		return inProgressUpdateError(e.dbSession.UpdateOperationState(id, message, state, t))
+	}, retry.Attempts(5), retry.LastErrorOnly(true))
+	if errors.Is(err, errOperationNotInProgress) {
+		return err
+	}
 	if err != nil {
 		log.Infof("Cannot set operation status to %s: %s", state, err.Error())
 	}
+	return nil
+}
+
+// inProgressUpdateError stops retries of the update which did not match the operation in progress
+func inProgressUpdateError(dberr dberrors.Error) error {
+	if dberr == nil {
+		return nil
+	}
+	if dberr.Code() == dberrors.CodeNotFound {
+		return retry.Unrecoverable(errOperationNotInProgress)
+	}
+	return dberr
 }
 
-func (e *Executor) updateOperationLastError(log logrus.FieldLogger, id string, runErr error) {
-	var lastErr model.LastError
+func toLastError(runErr error) model.LastError {
+	if runErr == nil {
+		return model.LastError{}
+	}
+
+	appErr := ConvertToAppError(runErr)
 
-	if runErr != nil {
-		appErr := ConvertToAppError(runErr)
-		lastErr = model.LastError{
-			ErrMessage: runErr.Error(),
-			Reason:     string(appErr.Reason()),
-			Component:  string(appErr.Component()),
-		}
+	return model.LastError{
+		ErrMessage: runErr.Error(),
+		Reason:     string(appErr.Reason()),
+		Component:  string(appErr.Component()),
 	}
+}
 
+func (e *Executor) updateOperationLastError(log logrus.FieldLogger, id string, lastErr model.LastError) {
 	err := retry.Do(func() error {
 		return e.dbSession.UpdateOperationLastError(id, lastErr.ErrMessage, lastErr.Reason, lastErr.Component)
 	}, retry.Attempts(5))
@@ -197,11 +371,29 @@ func (e *Executor) updateOperationLastError(log logrus.FieldLogger, id string, r
 	}
 }
 
-func (e *Executor) updateOperationStage(log logrus.FieldLogger, id, message string, stage model.OperationStage, t time.Time) {
+func (e *Executor) updateOperationAttempts(log logrus.FieldLogger, id string, attempts int) {
 	err := retry.Do(func() error {
-		return e.dbSession.TransitionOperation(id, message, stage, t)
+		return e.dbSession.UpdateOperationAttempts(id, attempts)
 	}, retry.Attempts(5))
+	if err != nil {
+		log.Infof("Cannot set operation attempts to %d: %s", attempts, err.Error())
+	}
+}
+
+// updateOperationStage returns errOperationNotInProgress when the operation was cancelled concurrently,
+// errOperationLost is returned without storing the stage when the operation was taken over by other replica
+func (e *Executor) updateOperationStage(log logrus.FieldLogger, id, message string, stage model.OperationStage, t time.Time) error {
+	if err := e.holdOperation(id); err != nil {
+		return err
+	}
+	err := retry.Do(func() error {
+		return inProgressUpdateError(e.dbSession.TransitionOperation(id, message, stage, t))
+	}, retry.Attempts(5), retry.LastErrorOnly(true))
+	if errors.Is(err, errOperationNotInProgress) {
+		return err
+	}
 	if err != nil {
 		log.Infof("Cannot modify operation stage to %s: %s", stage, err.Error())
 	}
+	return nil
 }
@@ -1,21 +1,33 @@
 package operations
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/events"
+	eventsMocks "github.com/kyma-project/control-plane/components/provisioner/internal/events/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/metrics"
+	metricsMocks "github.com/kyma-project/control-plane/components/provisioner/internal/metrics/mocks"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/failure"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/lease"
+	leaseMocks "github.com/kyma-project/control-plane/components/provisioner/internal/operations/lease/mocks"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/tracing"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
 const (
@@ -57,7 +69,7 @@ func TestStagesExecutor_Execute(t *testing.T) {
 			model.WaitingForInstallation: mockStage,
 		}
 
-		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler())
+		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler(), events.NewNoopPublisher(), metrics.NewOperationsCollector(), lease.NewNoopManager())
 
 		// when
 		result := executor.Execute(operationId)
@@ -74,6 +86,7 @@ func TestStagesExecutor_Execute(t *testing.T) {
 		dbSession.On("GetOperation", operationId).Return(operation, nil)
 		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
 		dbSession.On("UpdateOperationLastError", operationId, runErr.Error(), string(apperrors.ErrProvisionerInternal), string(apperrors.ErrProvisioner)).Return(nil)
+		dbSession.On("UpdateOperationAttempts", operationId, 1).Return(nil)
 
 		mockStage := NewErrorStep(model.WaitingForClusterCreation, runErr, time.Second*10)
 
@@ -81,14 +94,108 @@ func TestStagesExecutor_Execute(t *testing.T) {
 			model.WaitingForInstallation: mockStage,
 		}
 
-		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler())
+		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler(), events.NewNoopPublisher(), metrics.NewOperationsCollector(), lease.NewNoopManager())
 
 		// when
 		result := executor.Execute(operationId)
 
 		// then
 		assert.Equal(t, true, result.Requeue)
+		assert.Equal(t, DefaultRetryPolicy.InitialDelay, result.Delay)
 		assert.True(t, mockStage.called)
+		dbSession.AssertExpectations(t)
+	})
+
+	t.Run("should requeue operation with delay growing with the number of failed attempts", func(t *testing.T) {
+		// given
+		failedOperation := operation
+		failedOperation.Attempts = 2
+
+		runErr := fmt.Errorf("error")
+		dbSession := &mocks.ReadWriteSession{}
+		dbSession.On("GetOperation", operationId).Return(failedOperation, nil)
+		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
+		dbSession.On("UpdateOperationLastError", operationId, runErr.Error(), string(apperrors.ErrProvisionerInternal), string(apperrors.ErrProvisioner)).Return(nil)
+		dbSession.On("UpdateOperationAttempts", operationId, 3).Return(nil)
+
+		mockStage := NewErrorStep(model.WaitingForInstallation, runErr, 10*time.Second)
+		mockStage.retryPolicy = RetryPolicy{InitialDelay: time.Second, Multiplier: 3, MaxDelay: time.Minute, MaxAttempts: 5}
+
+		installationStages := map[model.OperationStage]Step{
+			model.WaitingForInstallation: mockStage,
+		}
+
+		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler(), events.NewNoopPublisher(), metrics.NewOperationsCollector(), lease.NewNoopManager())
+
+		// when
+		result := executor.Execute(operationId)
+
+		// then
+		assert.Equal(t, true, result.Requeue)
+		assert.Equal(t, 9*time.Second, result.Delay)
+		dbSession.AssertExpectations(t)
+	})
+
+	t.Run("should not requeue operation and run failure handler if step failed max attempts times", func(t *testing.T) {
+		// given
+		failedOperation := operation
+		failedOperation.Attempts = 2
+
+		runErr := fmt.Errorf("error")
+		dbSession := &mocks.ReadWriteSession{}
+		dbSession.On("GetOperation", operationId).Return(failedOperation, nil)
+		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
+		dbSession.On("UpdateOperationState", operationId, runErr.Error(), model.Failed, mock.AnythingOfType("time.Time")).
+			Return(nil)
+		dbSession.On("UpdateOperationLastError", operationId, runErr.Error(), string(apperrors.ErrProvisionerInternal), string(apperrors.ErrProvisioner)).Return(nil)
+
+		mockStage := NewErrorStep(model.WaitingForInstallation, runErr, 10*time.Second)
+		mockStage.retryPolicy = RetryPolicy{InitialDelay: time.Second, Multiplier: 2, MaxDelay: time.Minute, MaxAttempts: 3}
+
+		installationStages := map[model.OperationStage]Step{
+			model.WaitingForInstallation: mockStage,
+		}
+
+		failureHandler := MockFailureHandler{}
+
+		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler, events.NewNoopPublisher(), metrics.NewOperationsCollector(), lease.NewNoopManager())
+
+		// when
+		result := executor.Execute(operationId)
+
+		// then
+		assert.Equal(t, false, result.Requeue)
+		assert.True(t, failureHandler.called)
+		dbSession.AssertExpectations(t)
+		dbSession.AssertNotCalled(t, "UpdateOperationAttempts", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should reset failed attempts when step succeeded", func(t *testing.T) {
+		// given
+		failedOperation := operation
+		failedOperation.Attempts = 2
+
+		dbSession := &mocks.ReadWriteSession{}
+		dbSession.On("GetOperation", operationId).Return(failedOperation, nil)
+		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
+		dbSession.On("UpdateOperationAttempts", operationId, 0).Return(nil).Once()
+		dbSession.On("UpdateOperationLastError", operationId, "", "", "").Return(nil)
+
+		mockStage := NewMockStep(model.WaitingForInstallation, model.WaitingForInstallation, 10*time.Second, 10*time.Second)
+
+		installationStages := map[model.OperationStage]Step{
+			model.WaitingForInstallation: mockStage,
+		}
+
+		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler(), events.NewNoopPublisher(), metrics.NewOperationsCollector(), lease.NewNoopManager())
+
+		// when
+		result := executor.Execute(operationId)
+
+		// then
+		assert.Equal(t, true, result.Requeue)
+		assert.Equal(t, 10*time.Second, result.Delay)
+		dbSession.AssertExpectations(t)
 	})
 
 	t.Run("should not requeue operation and run failure handler if NonRecoverable error occurred", func(t *testing.T) {
@@ -109,7 +216,7 @@ func TestStagesExecutor_Execute(t *testing.T) {
 
 		failureHandler := MockFailureHandler{}
 
-		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler)
+		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler, events.NewNoopPublisher(), metrics.NewOperationsCollector(), lease.NewNoopManager())
 
 		// when
 		result := executor.Execute(operationId)
@@ -139,7 +246,35 @@ func TestStagesExecutor_Execute(t *testing.T) {
 
 		failureHandler := MockFailureHandler{}
 
-		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler)
+		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler, events.NewNoopPublisher(), metrics.NewOperationsCollector(), lease.NewNoopManager())
+
+		// when
+		result := executor.Execute(operationId)
+
+		// then
+		assert.Equal(t, false, result.Requeue)
+		assert.False(t, mockStage.called)
+		assert.True(t, failureHandler.called)
+	})
+
+	t.Run("should not requeue operation and run failure handler if operation was cancelled", func(t *testing.T) {
+		// given
+		cancelledOperation := operation
+		cancelledOperation.State = model.Cancelled
+
+		dbSession := &mocks.ReadWriteSession{}
+		dbSession.On("GetOperation", operationId).Return(cancelledOperation, nil)
+		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
+
+		mockStage := NewMockStep(model.WaitingForInstallation, model.FinishedStage, 0, 10*time.Second)
+
+		installationStages := map[model.OperationStage]Step{
+			model.WaitingForInstallation: mockStage,
+		}
+
+		failureHandler := MockFailureHandler{}
+
+		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler, events.NewNoopPublisher(), metrics.NewOperationsCollector(), lease.NewNoopManager())
 
 		// when
 		result := executor.Execute(operationId)
@@ -148,16 +283,363 @@ func TestStagesExecutor_Execute(t *testing.T) {
 		assert.Equal(t, false, result.Requeue)
 		assert.False(t, mockStage.called)
 		assert.True(t, failureHandler.called)
+		dbSession.AssertExpectations(t)
+	})
+
+	t.Run("should stop processing and run failure handler if operation was cancelled while step was running", func(t *testing.T) {
+		// given
+		cancelledOperation := operation
+		cancelledOperation.State = model.Cancelled
+
+		dbSession := &mocks.ReadWriteSession{}
+		dbSession.On("GetOperation", operationId).Return(operation, nil).Once()
+		dbSession.On("GetOperation", operationId).Return(cancelledOperation, nil).Once()
+		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
+		dbSession.On("TransitionOperation", operationId, "Operation in progress. Stage WaitingForClusterCreation", model.WaitingForClusterCreation, mock.AnythingOfType("time.Time")).
+			Return(dberrors.NotFound("operation not found or not in progress")).Once()
+
+		nextStage := NewMockStep(model.WaitingForClusterCreation, model.FinishedStage, 0, 10*time.Second)
+
+		installationStages := map[model.OperationStage]Step{
+			model.WaitingForInstallation:    NewMockStep(model.WaitingForInstallation, model.WaitingForClusterCreation, 0, 10*time.Second),
+			model.WaitingForClusterCreation: nextStage,
+		}
+
+		failureHandler := MockFailureHandler{}
+
+		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler, events.NewNoopPublisher(), metrics.NewOperationsCollector(), lease.NewNoopManager())
+
+		// when
+		result := executor.Execute(operationId)
+
+		// then
+		assert.Equal(t, false, result.Requeue)
+		assert.False(t, nextStage.called)
+		assert.True(t, failureHandler.called)
+		dbSession.AssertExpectations(t)
+		dbSession.AssertNotCalled(t, "UpdateOperationState", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should not overwrite state of operation cancelled while last step was running", func(t *testing.T) {
+		// given
+		cancelledOperation := operation
+		cancelledOperation.State = model.Cancelled
+
+		dbSession := &mocks.ReadWriteSession{}
+		dbSession.On("GetOperation", operationId).Return(operation, nil).Once()
+		dbSession.On("GetOperation", operationId).Return(cancelledOperation, nil).Once()
+		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
+		dbSession.On("TransitionOperation", operationId, "Provisioning steps finished", model.FinishedStage, mock.AnythingOfType("time.Time")).
+			Return(nil)
+		dbSession.On("UpdateOperationState", operationId, "Operation succeeded", model.Succeeded, mock.AnythingOfType("time.Time")).
+			Return(dberrors.NotFound("operation not found or not in progress")).Once()
+
+		publisher := &eventsMocks.Publisher{}
+
+		installationStages := map[model.OperationStage]Step{
+			model.WaitingForInstallation: NewMockStep(model.WaitingForInstallation, model.FinishedStage, 0, 10*time.Second),
+		}
+
+		failureHandler := MockFailureHandler{}
+
+		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler, publisher, metrics.NewOperationsCollector(), lease.NewNoopManager())
+
+		// when
+		result := executor.Execute(operationId)
+
+		// then
+		assert.Equal(t, false, result.Requeue)
+		assert.True(t, failureHandler.called)
+		dbSession.AssertExpectations(t)
+		publisher.AssertNotCalled(t, "Publish", mock.Anything)
+	})
+
+	t.Run("should not mark operation as failed if it was cancelled while failing", func(t *testing.T) {
+		// given
+		runErr := NewNonRecoverableError(errors.New("gardener error"))
+		dbSession := &mocks.ReadWriteSession{}
+		dbSession.On("GetOperation", operationId).Return(operation, nil)
+		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
+		dbSession.On("UpdateOperationLastError", operationId, runErr.Error(), string(apperrors.ErrProvisionerInternal), string(apperrors.ErrProvisioner)).Return(nil)
+		dbSession.On("UpdateOperationState", operationId, runErr.Error(), model.Failed, mock.AnythingOfType("time.Time")).
+			Return(dberrors.NotFound("operation not found or not in progress")).Once()
+
+		recorder := metricsMocks.NewOperationsRecorder(t)
+		recorder.On("ObserveOperation", operation, cluster, model.Cancelled, mock.AnythingOfType("model.LastError"), mock.AnythingOfType("time.Time")).Return().Once()
+
+		installationStages := map[model.OperationStage]Step{
+			model.WaitingForInstallation: NewErrorStep(model.WaitingForInstallation, runErr, 10*time.Second),
+		}
+
+		failureHandler := MockFailureHandler{}
+
+		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler, events.NewNoopPublisher(), recorder, lease.NewNoopManager())
+
+		// when
+		result := executor.Execute(operationId)
+
+		// then
+		assert.Equal(t, false, result.Requeue)
+		assert.True(t, failureHandler.called)
+		dbSession.AssertExpectations(t)
+	})
+
+	t.Run("should not store stage of operation taken over by other replica while step was running", func(t *testing.T) {
+		// given
+		dbSession := &mocks.ReadWriteSession{}
+		dbSession.On("GetOperation", operationId).Return(operation, nil)
+		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
+
+		fence := leaseMocks.NewManager(t)
+		fence.On("Acquire", operationId).Return(false, nil).Once()
+
+		nextStage := NewMockStep(model.WaitingForClusterCreation, model.FinishedStage, 0, 10*time.Second)
+
+		installationStages := map[model.OperationStage]Step{
+			model.WaitingForInstallation:    NewMockStep(model.WaitingForInstallation, model.WaitingForClusterCreation, 0, 10*time.Second),
+			model.WaitingForClusterCreation: nextStage,
+		}
+
+		failureHandler := MockFailureHandler{}
+
+		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler, events.NewNoopPublisher(), metrics.NewOperationsCollector(), fence)
+
+		// when
+		result := executor.Execute(operationId)
+
+		// then
+		assert.Equal(t, false, result.Requeue)
+		assert.False(t, nextStage.called)
+		assert.False(t, failureHandler.called)
+		dbSession.AssertExpectations(t)
+		dbSession.AssertNotCalled(t, "TransitionOperation", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		dbSession.AssertNotCalled(t, "UpdateOperationLastError", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should not fail operation taken over by other replica", func(t *testing.T) {
+		// given
+		runErr := NewNonRecoverableError(errors.New("gardener error"))
+		dbSession := &mocks.ReadWriteSession{}
+		dbSession.On("GetOperation", operationId).Return(operation, nil)
+		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
+		dbSession.On("UpdateOperationLastError", operationId, runErr.Error(), string(apperrors.ErrProvisionerInternal), string(apperrors.ErrProvisioner)).Return(nil)
+
+		fence := leaseMocks.NewManager(t)
+		fence.On("Acquire", operationId).Return(false, nil).Once()
+
+		installationStages := map[model.OperationStage]Step{
+			model.WaitingForInstallation: NewErrorStep(model.WaitingForInstallation, runErr, 10*time.Second),
+		}
+
+		failureHandler := MockFailureHandler{}
+
+		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler, events.NewNoopPublisher(), metrics.NewOperationsCollector(), fence)
+
+		// when
+		result := executor.Execute(operationId)
+
+		// then
+		assert.Equal(t, false, result.Requeue)
+		assert.False(t, failureHandler.called)
+		dbSession.AssertExpectations(t)
+		dbSession.AssertNotCalled(t, "UpdateOperationState", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should requeue operation when its ownership cannot be checked", func(t *testing.T) {
+		// given
+		dbSession := &mocks.ReadWriteSession{}
+		dbSession.On("GetOperation", operationId).Return(operation, nil)
+		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
+		dbSession.On("UpdateOperationLastError", operationId, mock.AnythingOfType("string"), string(apperrors.ErrProvisionerInternal), string(apperrors.ErrProvisioner)).Return(nil)
+
+		fence := leaseMocks.NewManager(t)
+		fence.On("Acquire", operationId).Return(false, errors.New("db error")).Once()
+
+		installationStages := map[model.OperationStage]Step{
+			model.WaitingForInstallation: NewMockStep(model.WaitingForInstallation, model.FinishedStage, 0, 10*time.Second),
+		}
+
+		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler(), events.NewNoopPublisher(), metrics.NewOperationsCollector(), fence)
+
+		// when
+		result := executor.Execute(operationId)
+
+		// then
+		assert.Equal(t, true, result.Requeue)
+		dbSession.AssertExpectations(t)
+		dbSession.AssertNotCalled(t, "TransitionOperation", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should not run failure handler if cancelled operation is of different type", func(t *testing.T) {
+		// given
+		cancelledOperation := operation
+		cancelledOperation.State = model.Cancelled
+
+		dbSession := &mocks.ReadWriteSession{}
+		dbSession.On("GetOperation", operationId).Return(cancelledOperation, nil)
+
+		failureHandler := MockFailureHandler{}
+
+		executor := NewExecutor(dbSession, model.UpgradeShoot, map[model.OperationStage]Step{}, &failureHandler, events.NewNoopPublisher(), metrics.NewOperationsCollector(), lease.NewNoopManager())
+
+		// when
+		result := executor.Execute(operationId)
+
+		// then
+		assert.Equal(t, false, result.Requeue)
+		assert.False(t, failureHandler.called)
+	})
+
+	t.Run("should publish event when operation succeeded", func(t *testing.T) {
+		// given
+		dbSession := &mocks.ReadWriteSession{}
+		dbSession.On("GetOperation", operationId).Return(operation, nil)
+		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
+		dbSession.On("TransitionOperation", operationId, "Provisioning steps finished", model.FinishedStage, mock.AnythingOfType("time.Time")).
+			Return(nil)
+		dbSession.On("UpdateOperationState", operationId, "Operation succeeded", model.Succeeded, mock.AnythingOfType("time.Time")).
+			Return(nil)
+		dbSession.On("UpdateOperationLastError", operationId, "", "", "").Return(nil)
+
+		publisher := &eventsMocks.Publisher{}
+		publisher.On("Publish", events.OperationEvent{OperationID: operationId, RuntimeID: clusterId}).Return().Once()
+
+		installationStages := map[model.OperationStage]Step{
+			model.WaitingForInstallation: NewMockStep(model.WaitingForInstallation, model.FinishedStage, 10*time.Second, 10*time.Second),
+		}
+
+		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler(), publisher, metrics.NewOperationsCollector(), lease.NewNoopManager())
+
+		// when
+		result := executor.Execute(operationId)
+
+		// then
+		assert.Equal(t, false, result.Requeue)
+		publisher.AssertExpectations(t)
+	})
+
+	t.Run("should observe durations of stages and of succeeded operation", func(t *testing.T) {
+		// given
+		dbSession := &mocks.ReadWriteSession{}
+		dbSession.On("GetOperation", operationId).Return(operation, nil)
+		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
+		dbSession.On("TransitionOperation", operationId, "Operation in progress. Stage WaitingForClusterCreation", model.WaitingForClusterCreation, mock.AnythingOfType("time.Time")).
+			Return(nil)
+		dbSession.On("TransitionOperation", operationId, "Provisioning steps finished", model.FinishedStage, mock.AnythingOfType("time.Time")).
+			Return(nil)
+		dbSession.On("UpdateOperationState", operationId, "Operation succeeded", model.Succeeded, mock.AnythingOfType("time.Time")).
+			Return(nil)
+		dbSession.On("UpdateOperationLastError", operationId, "", "", "").Return(nil)
+
+		inStage := func(stage model.OperationStage) interface{} {
+			return mock.MatchedBy(func(op model.Operation) bool { return op.Stage == stage })
+		}
+
+		recorder := metricsMocks.NewOperationsRecorder(t)
+		recorder.On("ObserveStage", inStage(model.WaitingForInstallation), cluster, model.Succeeded, mock.AnythingOfType("time.Time")).Return().Once()
+		recorder.On("ObserveStage", inStage(model.WaitingForClusterCreation), cluster, model.Succeeded, mock.AnythingOfType("time.Time")).Return().Once()
+		recorder.On("ObserveOperation", inStage(model.WaitingForClusterCreation), cluster, model.Succeeded, model.LastError{}, mock.AnythingOfType("time.Time")).Return().Once()
+
+		installationStages := map[model.OperationStage]Step{
+			model.WaitingForInstallation:    NewMockStep(model.WaitingForInstallation, model.WaitingForClusterCreation, 0, 10*time.Second),
+			model.WaitingForClusterCreation: NewMockStep(model.WaitingForClusterCreation, model.FinishedStage, 0, 10*time.Second),
+		}
+
+		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler(), events.NewNoopPublisher(), recorder, lease.NewNoopManager())
+
+		// when
+		result := executor.Execute(operationId)
+
+		// then
+		assert.Equal(t, false, result.Requeue)
+	})
+
+	t.Run("should observe failed operation with reason of the error", func(t *testing.T) {
+		// given
+		runErr := NewNonRecoverableError(apperrors.External("gardener error").SetReason("shoot_failed").SetComponent(apperrors.ErrGardener))
+		dbSession := &mocks.ReadWriteSession{}
+		dbSession.On("GetOperation", operationId).Return(operation, nil)
+		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
+		dbSession.On("UpdateOperationLastError", operationId, runErr.Error(), "shoot_failed", string(apperrors.ErrGardener)).Return(nil)
+		dbSession.On("UpdateOperationState", operationId, runErr.Error(), model.Failed, mock.AnythingOfType("time.Time")).Return(nil)
+
+		lastError := model.LastError{
+			ErrMessage: runErr.Error(),
+			Reason:     "shoot_failed",
+			Component:  string(apperrors.ErrGardener),
+		}
+
+		recorder := metricsMocks.NewOperationsRecorder(t)
+		recorder.On("ObserveStage", operation, cluster, model.Failed, mock.AnythingOfType("time.Time")).Return().Once()
+		recorder.On("ObserveOperation", operation, cluster, model.Failed, lastError, mock.AnythingOfType("time.Time")).Return().Once()
+
+		installationStages := map[model.OperationStage]Step{
+			model.WaitingForInstallation: NewErrorStep(model.WaitingForInstallation, runErr, 10*time.Second),
+		}
+
+		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler(), events.NewNoopPublisher(), recorder, lease.NewNoopManager())
+
+		// when
+		result := executor.Execute(operationId)
+
+		// then
+		assert.Equal(t, false, result.Requeue)
+	})
+
+	t.Run("should trace steps in a trace linked to the request which started the operation", func(t *testing.T) {
+		// given
+		exporter := tracetest.NewInMemoryExporter()
+		tracing.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+
+		requestCtx, requestSpan := tracing.Start(context.Background(), "request")
+		requestSpan.End()
+
+		tracedOperation := operation
+		tracedOperation.TraceContext = tracing.Inject(requestCtx)
+
+		runErr := fmt.Errorf("error")
+		dbSession := &mocks.ReadWriteSession{}
+		dbSession.On("GetOperation", operationId).Return(tracedOperation, nil)
+		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
+		dbSession.On("UpdateOperationLastError", operationId, runErr.Error(), string(apperrors.ErrProvisionerInternal), string(apperrors.ErrProvisioner)).Return(nil)
+		dbSession.On("UpdateOperationAttempts", operationId, 1).Return(nil)
+
+		installationStages := map[model.OperationStage]Step{
+			model.WaitingForInstallation: NewErrorStep(model.WaitingForInstallation, runErr, 10*time.Second),
+		}
+
+		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler(), events.NewNoopPublisher(), metrics.NewOperationsCollector(), lease.NewNoopManager())
+
+		// when
+		executor.Execute(operationId)
+
+		// then
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 3)
+
+		stepSpan, executeSpan := spans[1], spans[2]
+		assert.Equal(t, "Step.WaitingForInstallation", stepSpan.Name)
+		assert.Equal(t, executeSpan.SpanContext.SpanID(), stepSpan.Parent.SpanID())
+		assert.Equal(t, codes.Error, stepSpan.Status.Code)
+
+		assert.Equal(t, "Executor.Execute", executeSpan.Name)
+		assert.NotEqual(t, requestSpan.SpanContext().TraceID(), executeSpan.SpanContext.TraceID())
+		require.Len(t, executeSpan.Links, 1)
+		assert.Equal(t, requestSpan.SpanContext().SpanID(), executeSpan.Links[0].SpanContext.SpanID())
+		assert.Contains(t, executeSpan.Attributes, tracing.OperationIDKey.String(operationId))
+		assert.Contains(t, executeSpan.Attributes, tracing.RuntimeIDKey.String(clusterId))
+		assert.Contains(t, executeSpan.Attributes, tracing.OperationTypeKey.String(string(model.Provision)))
 	})
 
 }
 
 type mockStep struct {
-	name      model.OperationStage
-	next      model.OperationStage
-	delay     time.Duration
-	timeLimit time.Duration
-	err       error
+	name        model.OperationStage
+	next        model.OperationStage
+	delay       time.Duration
+	timeLimit   time.Duration
+	err         error
+	retryPolicy RetryPolicy
 
 	called bool
 }
@@ -173,9 +655,10 @@ func NewMockStep(name, next model.OperationStage, delay time.Duration, timeLimit
 
 func NewErrorStep(name model.OperationStage, err error, timeLimit time.Duration) *mockStep {
 	return &mockStep{
-		name:      name,
-		err:       err,
-		timeLimit: timeLimit,
+		name:        name,
+		err:         err,
+		timeLimit:   timeLimit,
+		retryPolicy: DefaultRetryPolicy,
 	}
 }
 
@@ -183,7 +666,7 @@ func (m mockStep) Name() model.OperationStage {
 	return m.name
 }
 
-func (m *mockStep) Run(cluster model.Cluster, operation model.Operation, logger logrus.FieldLogger) (StageResult, error) {
+func (m *mockStep) Run(_ context.Context, cluster model.Cluster, operation model.Operation, logger logrus.FieldLogger) (StageResult, error) {
 
 	m.called = true
 
@@ -201,6 +684,10 @@ func (m mockStep) TimeLimit() time.Duration {
 	return m.timeLimit
 }
 
+func (m mockStep) RetryPolicy() RetryPolicy {
+	return m.retryPolicy
+}
+
 type MockFailureHandler struct {
 	called bool
 }
@@ -247,3 +734,12 @@ func TestConvertToAppError(t *testing.T) {
 		assert.Equal(t, expectK8sErr, apperrK8sErr)
 	})
 }
+
+func TestRetryPolicy_Delay(t *testing.T) {
+	policy := RetryPolicy{InitialDelay: 2 * time.Second, Multiplier: 2, MaxDelay: time.Minute}
+
+	assert.Equal(t, 2*time.Second, policy.Delay(1))
+	assert.Equal(t, 16*time.Second, policy.Delay(4))
+	assert.Equal(t, time.Minute, policy.Delay(6))
+	assert.Equal(t, time.Minute, policy.Delay(10000))
+}
@@ -0,0 +1,57 @@
+package failure
+
+import (
+	"context"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/sirupsen/logrus"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const confirmDeletionPatch = `{"metadata":{"annotations":{"confirmation.gardener.cloud/deletion":"true"}}}`
+
+type ShootClient interface {
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (*gardener_types.Shoot, error)
+	Delete(ctx context.Context, name string, options metav1.DeleteOptions) error
+}
+
+// ProvisioningFailureHandler deletes the Shoot which was only partially created by the failed provisioning
+type ProvisioningFailureHandler struct {
+	shootClient ShootClient
+	log         logrus.FieldLogger
+}
+
+func NewProvisioningFailureHandler(shootClient ShootClient) *ProvisioningFailureHandler {
+	return &ProvisioningFailureHandler{
+		shootClient: shootClient,
+		log:         logrus.WithField("Component", "ProvisioningFailureHandler"),
+	}
+}
+
+func (h ProvisioningFailureHandler) HandleFailure(operation model.Operation, cluster model.Cluster) error {
+	shootName := cluster.ClusterConfig.Name
+	log := h.log.WithFields(logrus.Fields{"OperationId": operation.ID, "RuntimeId": cluster.ID, "ShootName": shootName})
+
+	_, err := h.shootClient.Patch(context.Background(), shootName, types.MergePatchType, []byte(confirmDeletionPatch), metav1.PatchOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			log.Infof("Shoot not found, skipping deletion")
+			return nil
+		}
+		return util.K8SErrorToAppError(err).SetComponent(apperrors.ErrGardenerClient).Append("error confirming deletion of Shoot %s", shootName)
+	}
+
+	err = h.shootClient.Delete(context.Background(), shootName, metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return util.K8SErrorToAppError(err).SetComponent(apperrors.ErrGardenerClient).Append("error deleting Shoot %s", shootName)
+	}
+
+	log.Infof("Shoot deleted after provisioning failure")
+
+	return nil
+}
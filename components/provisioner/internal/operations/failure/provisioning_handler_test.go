@@ -0,0 +1,55 @@
+package failure
+
+import (
+	"context"
+	"testing"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/core/clientset/versioned/fake"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	gardenerNamespace = "garden-project"
+	shootName         = "shoot"
+)
+
+func TestProvisioningFailureHandler_HandleFailure(t *testing.T) {
+	operation := model.Operation{ID: "operation-id", Type: model.Provision, ClusterID: "runtime-id"}
+	cluster := model.Cluster{ID: "runtime-id", ClusterConfig: model.GardenerConfig{Name: shootName}}
+
+	t.Run("should confirm deletion and delete Shoot", func(t *testing.T) {
+		// given
+		shootClient := fake.NewSimpleClientset(&gardener_types.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: shootName, Namespace: gardenerNamespace},
+		}).CoreV1beta1().Shoots(gardenerNamespace)
+
+		handler := NewProvisioningFailureHandler(shootClient)
+
+		// when
+		err := handler.HandleFailure(operation, cluster)
+
+		// then
+		require.NoError(t, err)
+
+		_, err = shootClient.Get(context.Background(), shootName, metav1.GetOptions{})
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+
+	t.Run("should not fail when Shoot does not exist", func(t *testing.T) {
+		// given
+		shootClient := fake.NewSimpleClientset().CoreV1beta1().Shoots(gardenerNamespace)
+
+		handler := NewProvisioningFailureHandler(shootClient)
+
+		// when
+		err := handler.HandleFailure(operation, cluster)
+
+		// then
+		require.NoError(t, err)
+	})
+}
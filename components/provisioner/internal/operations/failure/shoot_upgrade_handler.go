@@ -0,0 +1,55 @@
+package failure
+
+import (
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+	"github.com/sirupsen/logrus"
+)
+
+// ShootUpgradeFailureHandler restores the Gardener configuration stored before the failed upgrade, retrying the operation re-applies the upgraded one
+type ShootUpgradeFailureHandler struct {
+	factory dbsession.Factory
+	log     logrus.FieldLogger
+}
+
+func NewShootUpgradeFailureHandler(factory dbsession.Factory) *ShootUpgradeFailureHandler {
+	return &ShootUpgradeFailureHandler{
+		factory: factory,
+		log:     logrus.WithField("Component", "ShootUpgradeFailureHandler"),
+	}
+}
+
+func (h ShootUpgradeFailureHandler) HandleFailure(operation model.Operation, cluster model.Cluster) error {
+	log := h.log.WithFields(logrus.Fields{"OperationId": operation.ID, "RuntimeId": cluster.ID})
+
+	previousConfig, dberr := h.factory.NewReadSession().GetGardenerConfigBackup(operation.ID)
+	if dberr != nil {
+		if dberr.Code() == dberrors.CodeNotFound {
+			log.Warnf("Gardener config from before the upgrade not found, skipping roll back")
+			return nil
+		}
+		return dberr.Append("failed to get Gardener config from before the upgrade")
+	}
+
+	// Configuration is replaced together with worker pools, so it is updated within transaction
+	transaction, dberr := h.factory.NewSessionWithinTransaction()
+	if dberr != nil {
+		return dberr.Append("failed to start transaction")
+	}
+	defer transaction.RollbackUnlessCommitted()
+
+	dberr = transaction.UpdateGardenerClusterConfig(previousConfig)
+	if dberr != nil {
+		return dberr.Append("failed to roll back Gardener config")
+	}
+
+	dberr = transaction.Commit()
+	if dberr != nil {
+		return dberr.Append("failed to commit roll back of Gardener config")
+	}
+
+	log.Infof("Gardener config rolled back after upgrade failure")
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package failure
+
+import (
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShootUpgradeFailureHandler_HandleFailure(t *testing.T) {
+	operation := model.Operation{ID: "operation-id", Type: model.UpgradeShoot, ClusterID: "runtime-id"}
+	cluster := model.Cluster{
+		ID:            "runtime-id",
+		ClusterConfig: model.GardenerConfig{ClusterID: "runtime-id", Name: shootName, KubernetesVersion: "1.28.2"},
+	}
+	previousConfig := model.GardenerConfig{ClusterID: "runtime-id", Name: shootName, KubernetesVersion: "1.27.5"}
+
+	t.Run("should restore Gardener config from before the upgrade", func(t *testing.T) {
+		// given
+		factory := &mocks.Factory{}
+		readSession := &mocks.ReadSession{}
+		transaction := &mocks.WriteSessionWithinTransaction{}
+		factory.On("NewReadSession").Return(readSession)
+		factory.On("NewSessionWithinTransaction").Return(transaction, nil)
+		readSession.On("GetGardenerConfigBackup", operation.ID).Return(previousConfig, nil)
+		transaction.On("UpdateGardenerClusterConfig", previousConfig).Return(nil)
+		transaction.On("Commit").Return(nil)
+		transaction.On("RollbackUnlessCommitted").Return()
+
+		handler := NewShootUpgradeFailureHandler(factory)
+
+		// when
+		err := handler.HandleFailure(operation, cluster)
+
+		// then
+		require.NoError(t, err)
+		readSession.AssertExpectations(t)
+		transaction.AssertExpectations(t)
+	})
+
+	t.Run("should skip roll back when Gardener config from before the upgrade does not exist", func(t *testing.T) {
+		// given
+		factory := &mocks.Factory{}
+		readSession := &mocks.ReadSession{}
+		factory.On("NewReadSession").Return(readSession)
+		readSession.On("GetGardenerConfigBackup", operation.ID).Return(model.GardenerConfig{}, dberrors.NotFound("not found"))
+
+		handler := NewShootUpgradeFailureHandler(factory)
+
+		// when
+		err := handler.HandleFailure(operation, cluster)
+
+		// then
+		require.NoError(t, err)
+		factory.AssertNotCalled(t, "NewSessionWithinTransaction")
+	})
+
+	t.Run("should return error when failed to restore Gardener config", func(t *testing.T) {
+		// given
+		factory := &mocks.Factory{}
+		readSession := &mocks.ReadSession{}
+		transaction := &mocks.WriteSessionWithinTransaction{}
+		factory.On("NewReadSession").Return(readSession)
+		factory.On("NewSessionWithinTransaction").Return(transaction, nil)
+		readSession.On("GetGardenerConfigBackup", operation.ID).Return(previousConfig, nil)
+		transaction.On("UpdateGardenerClusterConfig", previousConfig).Return(dberrors.Internal("error"))
+		transaction.On("RollbackUnlessCommitted").Return()
+
+		handler := NewShootUpgradeFailureHandler(factory)
+
+		// when
+		err := handler.HandleFailure(operation, cluster)
+
+		// then
+		assert.Error(t, err)
+		transaction.AssertNotCalled(t, "Commit")
+	})
+}
@@ -0,0 +1,108 @@
+package lease
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/uuid"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+type Config struct {
+	Enabled bool `envconfig:"default=true"`
+	// TTL is the time after which operations of replica which stopped renewing its leases are taken over by other replicas
+	TTL            time.Duration `envconfig:"default=1m"`
+	RenewInterval  time.Duration `envconfig:"default=20s"`
+	ResyncInterval time.Duration `envconfig:"default=1m"`
+}
+
+// Manager makes sure that the operation is processed by single replica at a time
+//
+//go:generate mockery --name=Manager
+type Manager interface {
+	// Acquire returns false if the operation is processed by other replica
+	Acquire(operationID string) (bool, error)
+	Release(operationID string)
+	// ReleaseAll releases leases held by the replica so that its operations are taken over without waiting for the leases to expire
+	ReleaseAll()
+	// Run renews leases held by the replica until stopped
+	Run(stop <-chan struct{})
+}
+
+type manager struct {
+	session       dbsession.WriteSession
+	owner         string
+	ttl           time.Duration
+	renewInterval time.Duration
+}
+
+func NewManager(session dbsession.WriteSession, owner string, config Config) Manager {
+	return &manager{
+		session:       session,
+		owner:         owner,
+		ttl:           config.TTL,
+		renewInterval: config.RenewInterval,
+	}
+}
+
+// NewOwnerID identifies the replica, the random suffix makes sure that the restarted replica does not take over leases of its predecessor before they expire
+func NewOwnerID(generator uuid.UUIDGenerator) string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "provisioner"
+	}
+
+	return fmt.Sprintf("%s-%s", hostname, generator.New())
+}
+
+func (m *manager) Acquire(operationID string) (bool, error) {
+	acquired, err := m.session.AcquireOperationLease(operationID, m.owner, m.ttl)
+	if err != nil {
+		return false, err
+	}
+
+	return acquired, nil
+}
+
+func (m *manager) Release(operationID string) {
+	err := m.session.ReleaseOperationLease(operationID, m.owner)
+	if err != nil {
+		log.Errorf("Failed to release lease of operation %s: %s", operationID, err.Error())
+	}
+}
+
+func (m *manager) ReleaseAll() {
+	err := m.session.ReleaseOperationLeases(m.owner)
+	if err != nil {
+		log.Errorf("Failed to release operation leases: %s", err.Error())
+	}
+}
+
+func (m *manager) Run(stop <-chan struct{}) {
+	wait.Until(func() {
+		err := m.session.RenewOperationLeases(m.owner, m.ttl)
+		if err != nil {
+			log.Errorf("Failed to renew operation leases: %s", err.Error())
+		}
+	}, m.renewInterval, stop)
+}
+
+type noopManager struct{}
+
+// NewNoopManager allows processing of all operations, it can be used only when there is a single replica
+func NewNoopManager() Manager {
+	return noopManager{}
+}
+
+func (noopManager) Acquire(string) (bool, error) {
+	return true, nil
+}
+
+func (noopManager) Release(string) {}
+
+func (noopManager) ReleaseAll() {}
+
+func (noopManager) Run(<-chan struct{}) {}
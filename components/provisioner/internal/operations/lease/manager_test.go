@@ -0,0 +1,109 @@
+package lease
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/mocks"
+	uuidMocks "github.com/kyma-project/control-plane/components/provisioner/internal/uuid/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	operationID = "operation-id"
+	owner       = "provisioner-0-owner"
+)
+
+var config = Config{
+	Enabled:       true,
+	TTL:           time.Minute,
+	RenewInterval: 10 * time.Millisecond,
+}
+
+func TestManager_Acquire(t *testing.T) {
+	t.Run("should acquire lease", func(t *testing.T) {
+		// given
+		session := &mocks.WriteSession{}
+		session.On("AcquireOperationLease", operationID, owner, time.Minute).Return(true, nil)
+
+		manager := NewManager(session, owner, config)
+
+		// when
+		acquired, err := manager.Acquire(operationID)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, acquired)
+	})
+
+	t.Run("should not acquire lease held by other owner", func(t *testing.T) {
+		// given
+		session := &mocks.WriteSession{}
+		session.On("AcquireOperationLease", operationID, owner, time.Minute).Return(false, nil)
+
+		manager := NewManager(session, owner, config)
+
+		// when
+		acquired, err := manager.Acquire(operationID)
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, acquired)
+	})
+
+	t.Run("should return error when failed to acquire lease", func(t *testing.T) {
+		// given
+		session := &mocks.WriteSession{}
+		session.On("AcquireOperationLease", operationID, owner, time.Minute).Return(false, dberrors.Internal("error"))
+
+		manager := NewManager(session, owner, config)
+
+		// when
+		_, err := manager.Acquire(operationID)
+
+		// then
+		require.Error(t, err)
+	})
+}
+
+func TestManager_Run(t *testing.T) {
+	// given
+	renewed := make(chan struct{}, 1)
+
+	session := &mocks.WriteSession{}
+	session.On("RenewOperationLeases", owner, time.Minute).Return(nil).Run(func(mock.Arguments) {
+		select {
+		case renewed <- struct{}{}:
+		default:
+		}
+	})
+
+	manager := NewManager(session, owner, config)
+	stop := make(chan struct{})
+	defer close(stop)
+
+	// when
+	go manager.Run(stop)
+
+	// then
+	select {
+	case <-renewed:
+	case <-time.After(time.Second):
+		t.Fatal("leases were not renewed")
+	}
+}
+
+func TestNewOwnerID(t *testing.T) {
+	// given
+	generator := &uuidMocks.UUIDGenerator{}
+	generator.On("New").Return("id")
+
+	// when
+	ownerID := NewOwnerID(generator)
+
+	// then
+	assert.Regexp(t, ".+-id$", ownerID)
+}
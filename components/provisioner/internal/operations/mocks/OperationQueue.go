@@ -2,19 +2,88 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	dberrors "github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+	dbsession "github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+
+	model "github.com/kyma-project/control-plane/components/provisioner/internal/model"
+)
 
 // OperationQueue is an autogenerated mock type for the OperationQueue type
 type OperationQueue struct {
 	mock.Mock
 }
 
-// Add provides a mock function with given fields: processId
-func (_m *OperationQueue) Add(processId string) {
-	_m.Called(processId)
+// Add provides a mock function with given fields: processId, priority
+func (_m *OperationQueue) Add(processId string, priority model.OperationPriority) {
+	_m.Called(processId, priority)
+}
+
+// Alive provides a mock function with given fields:
+func (_m *OperationQueue) Alive() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
 }
 
 // Run provides a mock function with given fields: stop
 func (_m *OperationQueue) Run(stop <-chan struct{}) {
 	_m.Called(stop)
 }
+
+// Schedule provides a mock function with given fields: session, operationID, priority
+func (_m *OperationQueue) Schedule(session dbsession.WriteSession, operationID string, priority model.OperationPriority) dberrors.Error {
+	ret := _m.Called(session, operationID, priority)
+
+	var r0 dberrors.Error
+	if rf, ok := ret.Get(0).(func(dbsession.WriteSession, string, model.OperationPriority) dberrors.Error); ok {
+		r0 = rf(session, operationID, priority)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dberrors.Error)
+		}
+	}
+
+	return r0
+}
+
+// Shutdown provides a mock function with given fields: ctx
+func (_m *OperationQueue) Shutdown(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Unschedule provides a mock function with given fields: session, operationID
+func (_m *OperationQueue) Unschedule(session dbsession.WriteSession, operationID string) dberrors.Error {
+	ret := _m.Called(session, operationID)
+
+	var r0 dberrors.Error
+	if rf, ok := ret.Get(0).(func(dbsession.WriteSession, string) dberrors.Error); ok {
+		r0 = rf(session, operationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dberrors.Error)
+		}
+	}
+
+	return r0
+}
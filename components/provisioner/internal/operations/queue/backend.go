@@ -0,0 +1,82 @@
+package queue
+
+import (
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/lease"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+)
+
+// Backend creates queues of operations processed by the executor
+type Backend interface {
+	NewQueue(name string, config Config, executor Executor) OperationQueue
+	// Fence tells the executor whether the replica still owns the operation
+	Fence() operations.Fence
+}
+
+type inMemoryBackend struct {
+	leases lease.Manager
+}
+
+// NewInMemoryBackend creates queues which are rebuilt from operations in progress after restart
+func NewInMemoryBackend(leases lease.Manager) Backend {
+	return inMemoryBackend{leases: leases}
+}
+
+func (b inMemoryBackend) NewQueue(name string, config Config, executor Executor) OperationQueue {
+	return NewQueue(name, config, executor, b.leases)
+}
+
+func (b inMemoryBackend) Fence() operations.Fence {
+	return b.leases
+}
+
+type postgresBackend struct {
+	factory      dbsession.Factory
+	owner        string
+	pollInterval time.Duration
+	claimTimeout time.Duration
+}
+
+// NewPostgresBackend creates queues which keep the schedule of operations in the database, the owner identifies claims of the replica
+func NewPostgresBackend(factory dbsession.Factory, owner string, pollInterval, claimTimeout time.Duration) Backend {
+	return postgresBackend{
+		factory:      factory,
+		owner:        owner,
+		pollInterval: pollInterval,
+		claimTimeout: claimTimeout,
+	}
+}
+
+func (b postgresBackend) NewQueue(name string, config Config, executor Executor) OperationQueue {
+	return NewPostgresQueue(name, b.owner, config, b.factory, executor, b.pollInterval, b.claimTimeout)
+}
+
+func (b postgresBackend) Fence() operations.Fence {
+	return claimFence{
+		factory:      b.factory,
+		owner:        b.owner,
+		claimTimeout: b.claimTimeout,
+	}
+}
+
+// claimFence extends the claim of the queued operation, the claim is lost when it expired and other replica claimed the operation
+type claimFence struct {
+	factory      dbsession.Factory
+	owner        string
+	claimTimeout time.Duration
+}
+
+func (f claimFence) Acquire(operationID string) (bool, error) {
+	err := f.factory.NewWriteSession().ExtendQueuedOperationClaim(operationID, f.owner, f.claimTimeout)
+	if err != nil {
+		if err.Code() == dberrors.CodeNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
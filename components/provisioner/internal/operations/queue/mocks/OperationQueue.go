@@ -2,16 +2,36 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/kyma-project/control-plane/components/provisioner/internal/model"
+)
 
 // OperationQueue is an autogenerated mock type for the OperationQueue type
 type OperationQueue struct {
 	mock.Mock
 }
 
-// Add provides a mock function with given fields: processId
-func (_m *OperationQueue) Add(processId string) {
-	_m.Called(processId)
+// Add provides a mock function with given fields: processId, priority
+func (_m *OperationQueue) Add(processId string, priority model.OperationPriority) {
+	_m.Called(processId, priority)
+}
+
+// Alive provides a mock function with given fields:
+func (_m *OperationQueue) Alive() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
 }
 
 // Run provides a mock function with given fields: stop
@@ -19,6 +39,20 @@ func (_m *OperationQueue) Run(stop <-chan struct{}) {
 	_m.Called(stop)
 }
 
+// Shutdown provides a mock function with given fields: ctx
+func (_m *OperationQueue) Shutdown(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewOperationQueue creates a new instance of OperationQueue. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewOperationQueue(t interface {
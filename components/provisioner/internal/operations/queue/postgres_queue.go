@@ -0,0 +1,267 @@
+package queue
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+)
+
+// PostgresQueue keeps the next run time, the priority and the number of attempts of operations in the database,
+// the operation is claimed with SELECT ... FOR UPDATE SKIP LOCKED in short transaction and stays hidden from other workers until the claim expires,
+// the claim is extended while the operation is processed so that no transaction is kept open during calls to Gardener
+type PostgresQueue struct {
+	name            string
+	owner           string
+	factory         dbsession.Factory
+	executor        Executor
+	config          Config
+	pollInterval    time.Duration
+	claimTimeout    time.Duration
+	gardenerLimiter *rate.Limiter
+
+	// added wakes up a worker waiting for the next poll
+	added chan struct{}
+
+	waitGroup  sync.WaitGroup
+	heartbeats []heartbeat
+	stopOnce   sync.Once
+	stopped    chan struct{}
+	stoppedCtx context.Context
+	cancel     context.CancelFunc
+}
+
+// NewPostgresQueue creates the queue, the owner identifies claims of the replica
+func NewPostgresQueue(name, owner string, config Config, factory dbsession.Factory, executor Executor, pollInterval, claimTimeout time.Duration) *PostgresQueue {
+	stoppedCtx, cancel := context.WithCancel(context.Background())
+
+	return &PostgresQueue{
+		name:            name,
+		owner:           owner,
+		factory:         factory,
+		executor:        executor,
+		config:          config,
+		pollInterval:    pollInterval,
+		claimTimeout:    claimTimeout,
+		gardenerLimiter: rate.NewLimiter(rate.Limit(config.QPS), config.Burst),
+		heartbeats:      make([]heartbeat, config.Workers),
+		added:           make(chan struct{}, 1),
+		stopped:         make(chan struct{}),
+		stoppedCtx:      stoppedCtx,
+		cancel:          cancel,
+	}
+}
+
+// Schedule stores the operation in the queue together with the operation, so that it is never left in progress without being queued
+func (q *PostgresQueue) Schedule(session dbsession.WriteSession, operationID string, priority model.OperationPriority) dberrors.Error {
+	return session.EnqueueOperation(q.name, operationID, priority)
+}
+
+// Unschedule removes the operation from the queue, the claim of the worker processing it is lost
+func (q *PostgresQueue) Unschedule(session dbsession.WriteSession, operationID string) dberrors.Error {
+	return session.DeleteQueuedOperation(operationID)
+}
+
+// Add schedules the operation to be processed immediately unless it is already in the queue and wakes up waiting worker,
+// operations started by the service are already stored by Schedule
+func (q *PostgresQueue) Add(operationID string, priority model.OperationPriority) {
+	dberr := q.factory.NewWriteSession().EnqueueOperation(q.name, operationID, priority)
+	if dberr != nil {
+		logrus.Errorf("Failed to add operation %s to queue %s: %s", operationID, q.name, dberr.Error())
+		return
+	}
+
+	select {
+	case q.added <- struct{}{}:
+	default:
+	}
+}
+
+func (q *PostgresQueue) Run(stop <-chan struct{}) {
+	for i := 0; i < q.config.Workers; i++ {
+		q.waitGroup.Add(1)
+		beat := &q.heartbeats[i]
+		beat.beat(true)
+		go func() {
+			defer q.waitGroup.Done()
+			defer beat.stop()
+			q.worker(beat)
+		}()
+	}
+
+	go func() {
+		select {
+		case <-stop:
+			q.stop()
+		case <-q.stopped:
+		}
+	}()
+}
+
+func (q *PostgresQueue) Shutdown(ctx context.Context) error {
+	q.stop()
+
+	finished := make(chan struct{})
+	go func() {
+		q.waitGroup.Wait()
+		close(finished)
+	}()
+
+	select {
+	case <-finished:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (q *PostgresQueue) Alive() error {
+	return workersAlive(q.stopped, q.heartbeats, q.config.WorkerTimeout)
+}
+
+func (q *PostgresQueue) stop() {
+	q.stopOnce.Do(func() {
+		close(q.stopped)
+		q.cancel()
+	})
+}
+
+func (q *PostgresQueue) worker(beat *heartbeat) {
+	for {
+		select {
+		case <-q.stopped:
+			return
+		default:
+		}
+
+		beat.beat(false)
+		processed, err := q.processNext()
+		if err != nil {
+			logrus.Errorf("Failed to process operation from queue %s: %s", q.name, err.Error())
+		}
+		if processed && err == nil {
+			continue
+		}
+
+		beat.beat(true)
+		select {
+		case <-q.stopped:
+			return
+		case <-q.added:
+		case <-time.After(q.pollInterval):
+		}
+	}
+}
+
+// processNext returns false if there was no operation due to be processed
+func (q *PostgresQueue) processNext() (bool, error) {
+	session := q.factory.NewWriteSession()
+
+	// Claim is committed immediately, the operation is hidden from other workers until the claim expires
+	queuedOperation, dberr := session.ClaimQueuedOperation(q.name, q.owner, q.config.PriorityAging, q.claimTimeout)
+	if dberr != nil {
+		if dberr.Code() == dberrors.CodeNotFound {
+			return false, nil
+		}
+		return false, dberr
+	}
+
+	// Waiting for the limit of Gardener calls is interrupted when the queue is stopped, the operation is released for other replicas
+	if err := q.gardenerLimiter.Wait(q.stoppedCtx); err != nil {
+		dberr = session.RescheduleQueuedOperation(queuedOperation.OperationID, q.owner, 0, queuedOperation.Attempts)
+		if dberr != nil {
+			return false, dberr.Append("Failed to release operation %s", queuedOperation.OperationID)
+		}
+		return false, nil
+	}
+
+	stopExtending := q.extendClaim(session, queuedOperation.OperationID)
+	result := q.execute(queuedOperation.OperationID)
+	stopExtending()
+
+	dberr = q.schedule(session, queuedOperation, result)
+	if dberr != nil {
+		if dberr.Code() == dberrors.CodeNotFound {
+			logrus.Warnf("Operation %s was removed from queue %s or claimed by other worker while it was processed", queuedOperation.OperationID, q.name)
+			return true, nil
+		}
+		return true, dberr.Append("Failed to schedule operation %s", queuedOperation.OperationID)
+	}
+
+	return true, nil
+}
+
+// extendClaim keeps the operation hidden from other workers until the returned function is called
+func (q *PostgresQueue) extendClaim(session dbsession.WriteSession, operationID string) func() {
+	stop := make(chan struct{})
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+
+		ticker := time.NewTicker(q.claimTimeout / 3)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				if dberr := session.ExtendQueuedOperationClaim(operationID, q.owner, q.claimTimeout); dberr != nil {
+					logrus.Warnf("Failed to extend claim of operation %s in queue %s: %s", operationID, q.name, dberr.Error())
+				}
+			}
+		}
+	}()
+
+	return func() {
+		close(stop)
+		<-stopped
+	}
+}
+
+// execute treats panic as failure which is retried with backoff
+func (q *PostgresQueue) execute(operationID string) (result operations.ProcessingResult) {
+	defer func() {
+		if err := recover(); err != nil {
+			logrus.Errorf("panic error while processing key %s: %s", operationID, err)
+			result = operations.ProcessingResult{Requeue: true}
+		}
+	}()
+
+	return q.executor.Execute(operationID)
+}
+
+func (q *PostgresQueue) schedule(session dbsession.WriteSession, queuedOperation model.QueuedOperation, result operations.ProcessingResult) dberrors.Error {
+	if !result.Requeue {
+		return session.DeleteClaimedQueuedOperation(queuedOperation.OperationID, q.owner)
+	}
+	if result.Delay > 0 {
+		return session.RescheduleQueuedOperation(queuedOperation.OperationID, q.owner, result.Delay, 0)
+	}
+
+	attempts := queuedOperation.Attempts + 1
+	return session.RescheduleQueuedOperation(queuedOperation.OperationID, q.owner, q.backoff(attempts), attempts)
+}
+
+// backoff grows exponentially with the number of attempts, the same way as the rate limiter of in-memory queue
+func (q *PostgresQueue) backoff(attempts int) time.Duration {
+	backoff := q.config.BaseBackoff
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= q.config.MaxBackoff {
+			return q.config.MaxBackoff
+		}
+	}
+
+	if backoff > q.config.MaxBackoff {
+		return q.config.MaxBackoff
+	}
+	return backoff
+}
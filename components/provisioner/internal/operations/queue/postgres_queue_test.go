@@ -0,0 +1,240 @@
+package queue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	queueName = "provisioning"
+	owner     = "replica-1"
+)
+
+func TestPostgresQueue_processNext(t *testing.T) {
+	queuedOperation := model.QueuedOperation{
+		OperationID: operationID,
+		Queue:       queueName,
+		Attempts:    2,
+	}
+
+	for _, testCase := range []struct {
+		description string
+		result      operations.ProcessingResult
+		mockFunc    func(session *mocks.WriteSession)
+	}{
+		{
+			description: "should remove finished operation from queue",
+			result:      operations.ProcessingResult{Requeue: false},
+			mockFunc: func(session *mocks.WriteSession) {
+				session.On("DeleteClaimedQueuedOperation", operationID, owner).Return(nil)
+			},
+		},
+		{
+			description: "should reschedule operation with planned delay and reset attempts",
+			result:      operations.ProcessingResult{Requeue: true, Delay: time.Minute},
+			mockFunc: func(session *mocks.WriteSession) {
+				session.On("RescheduleQueuedOperation", operationID, owner, time.Minute, 0).Return(nil)
+			},
+		},
+		{
+			description: "should reschedule operation with backoff and increase attempts",
+			result:      operations.ProcessingResult{Requeue: true},
+			mockFunc: func(session *mocks.WriteSession) {
+				session.On("RescheduleQueuedOperation", operationID, owner, 4*time.Millisecond, 3).Return(nil)
+			},
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// given
+			session := &mocks.WriteSession{}
+			session.On("ClaimQueuedOperation", queueName, owner, time.Minute, time.Minute).Return(queuedOperation, nil)
+			testCase.mockFunc(session)
+
+			factory := &mocks.Factory{}
+			factory.On("NewWriteSession").Return(session)
+
+			called := false
+			queue := NewPostgresQueue(queueName, owner, config, factory, executor(&called, testCase.result), time.Second, time.Minute)
+
+			// when
+			processed, err := queue.processNext()
+
+			// then
+			require.NoError(t, err)
+			assert.True(t, processed)
+			assert.True(t, called)
+			session.AssertExpectations(t)
+		})
+	}
+
+	t.Run("should return false when there is no operation to process", func(t *testing.T) {
+		// given
+		session := &mocks.WriteSession{}
+		session.On("ClaimQueuedOperation", queueName, owner, time.Minute, time.Minute).Return(model.QueuedOperation{}, dberrors.NotFound("error"))
+
+		factory := &mocks.Factory{}
+		factory.On("NewWriteSession").Return(session)
+
+		called := false
+		queue := NewPostgresQueue(queueName, owner, config, factory, executor(&called, operations.ProcessingResult{}), time.Second, time.Minute)
+
+		// when
+		processed, err := queue.processNext()
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, processed)
+		assert.False(t, called)
+		session.AssertExpectations(t)
+	})
+
+	t.Run("should reschedule operation with backoff when processing panics", func(t *testing.T) {
+		// given
+		session := &mocks.WriteSession{}
+		session.On("ClaimQueuedOperation", queueName, owner, time.Minute, time.Minute).Return(model.QueuedOperation{OperationID: operationID, Queue: queueName}, nil)
+		session.On("RescheduleQueuedOperation", operationID, owner, time.Millisecond, 1).Return(nil)
+
+		factory := &mocks.Factory{}
+		factory.On("NewWriteSession").Return(session)
+
+		queue := NewPostgresQueue(queueName, owner, config, factory, executorFunc(func(string) operations.ProcessingResult {
+			panic("error")
+		}), time.Second, time.Minute)
+
+		// when
+		processed, err := queue.processNext()
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, processed)
+		session.AssertExpectations(t)
+	})
+
+	t.Run("should not fail when operation was removed from queue while it was processed", func(t *testing.T) {
+		// given
+		session := &mocks.WriteSession{}
+		session.On("ClaimQueuedOperation", queueName, owner, time.Minute, time.Minute).Return(queuedOperation, nil)
+		session.On("DeleteClaimedQueuedOperation", operationID, owner).Return(dberrors.NotFound("error"))
+
+		factory := &mocks.Factory{}
+		factory.On("NewWriteSession").Return(session)
+
+		called := false
+		queue := NewPostgresQueue(queueName, owner, config, factory, executor(&called, operations.ProcessingResult{}), time.Second, time.Minute)
+
+		// when
+		processed, err := queue.processNext()
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, processed)
+		session.AssertExpectations(t)
+	})
+
+	t.Run("should return error when failed to reschedule operation", func(t *testing.T) {
+		// given
+		session := &mocks.WriteSession{}
+		session.On("ClaimQueuedOperation", queueName, owner, time.Minute, time.Minute).Return(queuedOperation, nil)
+		session.On("DeleteClaimedQueuedOperation", operationID, owner).Return(dberrors.Internal("error"))
+
+		factory := &mocks.Factory{}
+		factory.On("NewWriteSession").Return(session)
+
+		called := false
+		queue := NewPostgresQueue(queueName, owner, config, factory, executor(&called, operations.ProcessingResult{}), time.Second, time.Minute)
+
+		// when
+		_, err := queue.processNext()
+
+		// then
+		require.Error(t, err)
+	})
+
+	t.Run("should extend claim while operation is processed", func(t *testing.T) {
+		// given
+		session := &mocks.WriteSession{}
+		session.On("ClaimQueuedOperation", queueName, owner, time.Minute, 30*time.Millisecond).Return(queuedOperation, nil)
+		session.On("ExtendQueuedOperationClaim", operationID, owner, 30*time.Millisecond).Return(nil)
+		session.On("DeleteClaimedQueuedOperation", operationID, owner).Return(nil)
+
+		factory := &mocks.Factory{}
+		factory.On("NewWriteSession").Return(session)
+
+		queue := NewPostgresQueue(queueName, owner, config, factory, executorFunc(func(string) operations.ProcessingResult {
+			time.Sleep(50 * time.Millisecond)
+			return operations.ProcessingResult{}
+		}), time.Second, 30*time.Millisecond)
+
+		// when
+		processed, err := queue.processNext()
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, processed)
+		session.AssertExpectations(t)
+	})
+}
+
+func TestPostgresQueue_Add(t *testing.T) {
+	// given
+	session := &mocks.WriteSession{}
+	session.On("EnqueueOperation", queueName, operationID, model.HighPriority).Return(nil)
+
+	factory := &mocks.Factory{}
+	factory.On("NewWriteSession").Return(session)
+
+	queue := NewPostgresQueue(queueName, owner, config, factory, executorFunc(nil), time.Second, time.Minute)
+
+	// when
+	queue.Add(operationID, model.HighPriority)
+
+	// then
+	session.AssertExpectations(t)
+	assert.Len(t, queue.added, 1, "waiting worker should be woken up")
+}
+
+func TestPostgresQueue_backoff(t *testing.T) {
+	queue := NewPostgresQueue(queueName, owner, Config{BaseBackoff: time.Second, MaxBackoff: time.Minute}, &mocks.Factory{}, executorFunc(nil), time.Second, time.Minute)
+
+	assert.Equal(t, time.Second, queue.backoff(1))
+	assert.Equal(t, 8*time.Second, queue.backoff(4))
+	assert.Equal(t, time.Minute, queue.backoff(100))
+}
+
+func TestClaimFence_Acquire(t *testing.T) {
+	for _, testCase := range []struct {
+		description string
+		err         dberrors.Error
+		held        bool
+		fails       bool
+	}{
+		{description: "should hold operation when claim is extended", held: true},
+		{description: "should not hold operation when claim was lost", err: dberrors.NotFound("error")},
+		{description: "should return error when failed to extend claim", err: dberrors.Internal("error"), fails: true},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// given
+			session := &mocks.WriteSession{}
+			session.On("ExtendQueuedOperationClaim", operationID, owner, time.Minute).Return(testCase.err)
+
+			factory := &mocks.Factory{}
+			factory.On("NewWriteSession").Return(session)
+
+			fence := NewPostgresBackend(factory, owner, time.Second, time.Minute).Fence()
+
+			// when
+			held, err := fence.Acquire(operationID)
+
+			// then
+			assert.Equal(t, testCase.fails, err != nil)
+			assert.Equal(t, testCase.held, held)
+		})
+	}
+}
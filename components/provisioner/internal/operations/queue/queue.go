@@ -1,72 +1,295 @@
 package queue
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/lease"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/util/workqueue"
 )
 
 //go:generate mockery --name=OperationQueue
 type OperationQueue interface {
-	Add(processId string)
+	// Add queues the operation, the operation keeps its priority when it is requeued
+	Add(processId string, priority model.OperationPriority)
+	// Schedule stores the operation in the queue within the transaction which starts it, Add has to be called after the transaction is committed
+	Schedule(session dbsession.WriteSession, operationID string, priority model.OperationPriority) dberrors.Error
+	// Unschedule removes the operation from the queue within the transaction which finishes it
+	Unschedule(session dbsession.WriteSession, operationID string) dberrors.Error
 	Run(stop <-chan struct{})
+	// Shutdown stops processing of queued operations and waits until operations being processed are finished or the context is done
+	Shutdown(ctx context.Context) error
+	// Alive returns error when the queue is stopped, some of its workers are not running or some worker is stuck processing an operation
+	Alive() error
 }
 
-const (
-	workersAmount = 5
-)
+// Config allows tuning the throughput of every queue separately
+type Config struct {
+	Workers int `envconfig:"default=5"`
+	// BaseBackoff and MaxBackoff limit the exponential backoff of operations requeued without planned delay
+	BaseBackoff time.Duration `envconfig:"default=5ms"`
+	MaxBackoff  time.Duration `envconfig:"default=1000s"`
+	// QPS and Burst limit the rate of processing operations, every processing calls Gardener
+	QPS   float64 `envconfig:"default=10"`
+	Burst int     `envconfig:"default=100"`
+	// PriorityAging is the waiting time after which the operation is served as if its priority was one level higher, it prevents starvation of operations with low priority
+	PriorityAging time.Duration `envconfig:"default=5m"`
+	// WorkerTimeout is the time after which the worker processing a single operation is considered stuck, zero disables the check
+	WorkerTimeout time.Duration `envconfig:"default=10m"`
+}
+
+var priorities = []model.OperationPriority{model.LowPriority, model.NormalPriority, model.HighPriority}
 
 type Executor interface {
 	Execute(operationID string) operations.ProcessingResult
 }
 
+// Queue keeps operations of every priority in separate lane, operations due to be processed are served from all lanes by priority
 type Queue struct {
-	queue    workqueue.RateLimitingInterface
-	executor Executor
+	lanes           map[model.OperationPriority]workqueue.RateLimitingInterface
+	ready           *readyOperations
+	executor        Executor
+	leases          lease.Manager
+	workers         int
+	workerTimeout   time.Duration
+	gardenerLimiter *rate.Limiter
+
+	waitGroup  sync.WaitGroup
+	heartbeats []heartbeat
+	stopOnce   sync.Once
+	stopped    chan struct{}
+	stoppedCtx context.Context
+	cancel     context.CancelFunc
 }
 
-func NewQueue(executor Executor) *Queue {
+// NewQueue creates the queue, the name distinguishes metrics of the queue
+func NewQueue(name string, config Config, executor Executor, leases lease.Manager) *Queue {
+	lanes := make(map[model.OperationPriority]workqueue.RateLimitingInterface, len(priorities))
+	for _, priority := range priorities {
+		rateLimiter := workqueue.NewItemExponentialFailureRateLimiter(config.BaseBackoff, config.MaxBackoff)
+		lanes[priority] = workqueue.NewNamedRateLimitingQueue(rateLimiter, fmt.Sprintf("%s_%s", name, priority))
+	}
+	stoppedCtx, cancel := context.WithCancel(context.Background())
+
 	return &Queue{
-		queue:    workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "operations"),
-		executor: executor,
+		lanes:           lanes,
+		ready:           newReadyOperations(config.PriorityAging),
+		executor:        executor,
+		leases:          leases,
+		workers:         config.Workers,
+		workerTimeout:   config.WorkerTimeout,
+		heartbeats:      make([]heartbeat, config.Workers),
+		gardenerLimiter: rate.NewLimiter(rate.Limit(config.QPS), config.Burst),
+		stopped:         make(chan struct{}),
+		stoppedCtx:      stoppedCtx,
+		cancel:          cancel,
+	}
+}
+
+func (q *Queue) Add(operationId string, priority model.OperationPriority) {
+	lane, found := q.lanes[priority]
+	if !found {
+		lane = q.lanes[model.NormalPriority]
 	}
+
+	lane.Add(operationId)
+}
+
+// Schedule does nothing as the in-memory queue is rebuilt from operations in progress after restart
+func (q *Queue) Schedule(dbsession.WriteSession, string, model.OperationPriority) dberrors.Error {
+	return nil
 }
 
-func (q *Queue) Add(operationId string) {
-	q.queue.Add(operationId)
+// Unschedule does nothing as the executor drops operations which are no longer in progress
+func (q *Queue) Unschedule(dbsession.WriteSession, string) dberrors.Error {
+	return nil
 }
 
 func (q *Queue) Run(stop <-chan struct{}) {
-	var waitGroup sync.WaitGroup
+	// Operations which are due to be processed are moved from lanes to the ready operations one at a time when some worker is free
+	for priority, lane := range q.lanes {
+		go feed(lane, priority, q.ready)
+	}
+
+	for i := 0; i < q.workers; i++ {
+		createWorker(q.lanes, q.ready, q.process, q.stopped, &q.waitGroup, &q.heartbeats[i])
+	}
+
+	go func() {
+		select {
+		case <-stop:
+			q.stop()
+		case <-q.stopped:
+		}
+	}()
+}
+
+func (q *Queue) Shutdown(ctx context.Context) error {
+	q.stop()
+
+	finished := make(chan struct{})
+	go func() {
+		q.waitGroup.Wait()
+		close(finished)
+	}()
+
+	select {
+	case <-finished:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (q *Queue) Alive() error {
+	return workersAlive(q.stopped, q.heartbeats, q.workerTimeout)
+}
+
+func (q *Queue) stop() {
+	q.stopOnce.Do(func() {
+		close(q.stopped)
+		q.cancel()
+		q.ready.close()
+		for _, lane := range q.lanes {
+			lane.ShutDown()
+		}
+	})
+}
+
+// process executes the operation only if it is not processed by other replica, the lease is kept as long as the operation is requeued
+func (q *Queue) process(operationID string) operations.ProcessingResult {
+	acquired, err := q.leases.Acquire(operationID)
+	if err != nil {
+		logrus.Errorf("Failed to acquire lease of operation %s: %s", operationID, err.Error())
+		return operations.ProcessingResult{Requeue: true}
+	}
+	if !acquired {
+		logrus.Debugf("Operation %s is processed by other replica", operationID)
+		return operations.ProcessingResult{Requeue: false}
+	}
 
-	for i := 0; i < workersAmount; i++ {
-		createWorker(q.queue, q.executor.Execute, stop, &waitGroup)
+	keepLease := false
+	defer func() {
+		// Lease is released also after panic so that the operation can be picked up again
+		if !keepLease {
+			q.leases.Release(operationID)
+		}
+	}()
+
+	// Waiting for the limit of Gardener calls is interrupted when the queue is stopped
+	if err := q.gardenerLimiter.Wait(q.stoppedCtx); err != nil {
+		logrus.Debugf("Operation %s not processed: %s", operationID, err.Error())
+		return operations.ProcessingResult{Requeue: false}
+	}
+
+	result := q.executor.Execute(operationID)
+	keepLease = result.Requeue
+
+	return result
+}
+
+func feed(lane workqueue.RateLimitingInterface, priority model.OperationPriority, ready *readyOperations) {
+	for {
+		if !ready.awaitDemand(priority) {
+			return
+		}
+
+		key, quit := lane.Get()
+		if quit {
+			return
+		}
+
+		ready.push(key.(string), priority)
 	}
 }
 
-func createWorker(queue workqueue.RateLimitingInterface, process func(id string) operations.ProcessingResult, stopCh <-chan struct{}, waitGroup *sync.WaitGroup) {
+func createWorker(lanes map[model.OperationPriority]workqueue.RateLimitingInterface, ready *readyOperations, process func(id string) operations.ProcessingResult, stopCh <-chan struct{}, waitGroup *sync.WaitGroup, beat *heartbeat) {
 	waitGroup.Add(1)
+	beat.beat(true)
 	go func() {
-		wait.Until(worker(queue, process), time.Second, stopCh)
+		wait.Until(worker(lanes, ready, process, beat), time.Second, stopCh)
+		beat.stop()
 		waitGroup.Done()
 	}()
 }
 
-func worker(queue workqueue.RateLimitingInterface, process func(key string) operations.ProcessingResult) func() {
+// heartbeat is updated by the worker in every iteration, the worker is idle while it waits for the next operation
+type heartbeat struct {
+	// last is the Unix time in nanoseconds of the last update, zero means that the worker is not running
+	last atomic.Int64
+	idle atomic.Bool
+}
+
+func (h *heartbeat) beat(idle bool) {
+	h.idle.Store(idle)
+	h.last.Store(time.Now().UnixNano())
+}
+
+func (h *heartbeat) stop() {
+	h.last.Store(0)
+}
+
+func workersAlive(stopped <-chan struct{}, heartbeats []heartbeat, timeout time.Duration) error {
+	select {
+	case <-stopped:
+		return errors.New("queue is stopped")
+	default:
+	}
+
+	running := 0
+	for i := range heartbeats {
+		if heartbeats[i].last.Load() != 0 {
+			running++
+		}
+	}
+	if running < len(heartbeats) {
+		return fmt.Errorf("%d of %d workers are running", running, len(heartbeats))
+	}
+
+	if timeout == 0 {
+		return nil
+	}
+	for i := range heartbeats {
+		if heartbeats[i].idle.Load() {
+			continue
+		}
+		busy := time.Since(time.Unix(0, heartbeats[i].last.Load()))
+		if busy > timeout {
+			return fmt.Errorf("worker %d is processing operation for %s", i, busy.Round(time.Second))
+		}
+	}
+
+	return nil
+}
+
+func worker(lanes map[model.OperationPriority]workqueue.RateLimitingInterface, ready *readyOperations, process func(key string) operations.ProcessingResult, beat *heartbeat) func() {
 	return func() {
 		exit := false
 		for !exit {
 			exit = func() bool {
-				key, quit := queue.Get()
-				logrus.Debugf("Processing operation: %s", key)
-
+				beat.beat(true)
+				// Operations left in the queue are not started after shutdown, they are picked up again after restart
+				operation, quit := ready.pop()
 				if quit {
 					return true
 				}
+				beat.beat(false)
+				logrus.Debugf("Processing operation: %s", operation.id)
+
+				// Requeued operation returns to the lane of its priority
+				queue := lanes[operation.priority]
+				key := operation.id
 				defer func() {
 					if err := recover(); err != nil {
 						logrus.Errorf("panic error while processing key %s: %s", key, err)
@@ -74,8 +297,13 @@ func worker(queue workqueue.RateLimitingInterface, process func(key string) oper
 					queue.Done(key)
 				}()
 
-				result := process(key.(string))
+				result := process(key)
+				if result.Requeue && result.Delay == 0 {
+					queue.AddRateLimited(key)
+					return false
+				}
 				if result.Requeue {
+					queue.Forget(key)
 					queue.AddAfter(key, result.Delay)
 					return false
 				}
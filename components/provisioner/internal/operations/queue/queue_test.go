@@ -0,0 +1,432 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/lease/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const operationID = "operation-id"
+
+var config = Config{
+	Workers:       5,
+	BaseBackoff:   time.Millisecond,
+	MaxBackoff:    time.Second,
+	QPS:           100,
+	Burst:         100,
+	PriorityAging: time.Minute,
+}
+
+type executorFunc func(operationID string) operations.ProcessingResult
+
+func (f executorFunc) Execute(operationID string) operations.ProcessingResult {
+	return f(operationID)
+}
+
+func executor(called *bool, result operations.ProcessingResult) Executor {
+	return executorFunc(func(string) operations.ProcessingResult {
+		*called = true
+		return result
+	})
+}
+
+func TestQueue_process(t *testing.T) {
+	t.Run("should process operation and release lease when finished", func(t *testing.T) {
+		// given
+		leases := &mocks.Manager{}
+		leases.On("Acquire", operationID).Return(true, nil)
+		leases.On("Release", operationID).Return()
+		called := false
+
+		queue := NewQueue("operations", config, executor(&called, operations.ProcessingResult{Requeue: false}), leases)
+
+		// when
+		result := queue.process(operationID)
+
+		// then
+		assert.True(t, called)
+		assert.False(t, result.Requeue)
+		leases.AssertExpectations(t)
+	})
+
+	t.Run("should keep lease when operation is requeued", func(t *testing.T) {
+		// given
+		leases := &mocks.Manager{}
+		leases.On("Acquire", operationID).Return(true, nil)
+		called := false
+
+		queue := NewQueue("operations", config, executor(&called, operations.ProcessingResult{Requeue: true, Delay: time.Minute}), leases)
+
+		// when
+		result := queue.process(operationID)
+
+		// then
+		assert.True(t, called)
+		assert.Equal(t, operations.ProcessingResult{Requeue: true, Delay: time.Minute}, result)
+		leases.AssertNotCalled(t, "Release", operationID)
+	})
+
+	t.Run("should skip operation processed by other replica", func(t *testing.T) {
+		// given
+		leases := &mocks.Manager{}
+		leases.On("Acquire", operationID).Return(false, nil)
+		called := false
+
+		queue := NewQueue("operations", config, executor(&called, operations.ProcessingResult{}), leases)
+
+		// when
+		result := queue.process(operationID)
+
+		// then
+		assert.False(t, called)
+		assert.False(t, result.Requeue)
+		leases.AssertNotCalled(t, "Release", operationID)
+	})
+
+	t.Run("should requeue operation when failed to acquire lease", func(t *testing.T) {
+		// given
+		leases := &mocks.Manager{}
+		leases.On("Acquire", operationID).Return(false, errors.New("error"))
+		called := false
+
+		queue := NewQueue("operations", config, executor(&called, operations.ProcessingResult{}), leases)
+
+		// when
+		result := queue.process(operationID)
+
+		// then
+		assert.False(t, called)
+		assert.Equal(t, operations.ProcessingResult{Requeue: true}, result)
+	})
+
+	t.Run("should release lease when processing panics", func(t *testing.T) {
+		// given
+		leases := &mocks.Manager{}
+		leases.On("Acquire", operationID).Return(true, nil)
+		leases.On("Release", operationID).Return()
+
+		queue := NewQueue("operations", config, executorFunc(func(string) operations.ProcessingResult {
+			panic("error")
+		}), leases)
+
+		// when
+		assert.Panics(t, func() {
+			queue.process(operationID)
+		})
+
+		// then
+		leases.AssertExpectations(t)
+	})
+}
+
+func TestQueue_Shutdown(t *testing.T) {
+	t.Run("should wait for operation being processed", func(t *testing.T) {
+		// given
+		leases := &mocks.Manager{}
+		leases.On("Acquire", operationID).Return(true, nil)
+		leases.On("Release", operationID).Return()
+
+		started := make(chan struct{})
+		finished := false
+
+		queue := NewQueue("operations", config, executorFunc(func(string) operations.ProcessingResult {
+			close(started)
+			time.Sleep(100 * time.Millisecond)
+			finished = true
+			return operations.ProcessingResult{}
+		}), leases)
+
+		stop := make(chan struct{})
+		defer close(stop)
+
+		queue.Run(stop)
+		queue.Add(operationID, model.NormalPriority)
+		<-started
+
+		// when
+		err := queue.Shutdown(context.Background())
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, finished)
+		leases.AssertExpectations(t)
+	})
+
+	t.Run("should return error when operation is not finished before deadline", func(t *testing.T) {
+		// given
+		leases := &mocks.Manager{}
+		leases.On("Acquire", operationID).Return(true, nil)
+		leases.On("Release", operationID).Return()
+
+		started := make(chan struct{})
+		release := make(chan struct{})
+		defer close(release)
+
+		queue := NewQueue("operations", config, executorFunc(func(string) operations.ProcessingResult {
+			close(started)
+			<-release
+			return operations.ProcessingResult{}
+		}), leases)
+
+		stop := make(chan struct{})
+		defer close(stop)
+
+		queue.Run(stop)
+		queue.Add(operationID, model.NormalPriority)
+		<-started
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		// when
+		err := queue.Shutdown(ctx)
+
+		// then
+		require.Error(t, err)
+	})
+
+	t.Run("should not start queued operations after shutdown", func(t *testing.T) {
+		// given
+		leases := &mocks.Manager{}
+		called := false
+
+		queue := NewQueue("operations", config, executor(&called, operations.ProcessingResult{}), leases)
+		queue.Add(operationID, model.NormalPriority)
+
+		// when
+		err := queue.Shutdown(context.Background())
+		queue.Run(make(chan struct{}))
+		time.Sleep(50 * time.Millisecond)
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, called)
+		leases.AssertNotCalled(t, "Acquire", operationID)
+	})
+}
+
+func TestQueue_Alive(t *testing.T) {
+	t.Run("should return error before workers are started", func(t *testing.T) {
+		// given
+		queue := NewQueue("operations", config, executorFunc(func(string) operations.ProcessingResult {
+			return operations.ProcessingResult{}
+		}), &mocks.Manager{})
+
+		// when
+		err := queue.Alive()
+
+		// then
+		require.EqualError(t, err, "0 of 5 workers are running")
+	})
+
+	t.Run("should return no error when all workers are running", func(t *testing.T) {
+		// given
+		queue := NewQueue("operations", config, executorFunc(func(string) operations.ProcessingResult {
+			return operations.ProcessingResult{}
+		}), &mocks.Manager{})
+
+		stop := make(chan struct{})
+		defer close(stop)
+
+		// when
+		queue.Run(stop)
+
+		// then
+		require.NoError(t, queue.Alive())
+	})
+
+	t.Run("should return error after shutdown", func(t *testing.T) {
+		// given
+		queue := NewQueue("operations", config, executorFunc(func(string) operations.ProcessingResult {
+			return operations.ProcessingResult{}
+		}), &mocks.Manager{})
+		queue.Run(make(chan struct{}))
+
+		// when
+		err := queue.Shutdown(context.Background())
+		require.NoError(t, err)
+
+		// then
+		require.EqualError(t, queue.Alive(), "queue is stopped")
+	})
+
+	t.Run("should return error when worker is stuck processing operation", func(t *testing.T) {
+		// given
+		leases := &mocks.Manager{}
+		leases.On("Acquire", "operation").Return(true, nil)
+		leases.On("Release", "operation").Return()
+
+		processing := make(chan struct{})
+		unblock := make(chan struct{})
+		queue := NewQueue("operations", Config{Workers: 1, QPS: 100, Burst: 100, WorkerTimeout: 100 * time.Millisecond}, executorFunc(func(string) operations.ProcessingResult {
+			close(processing)
+			<-unblock
+			return operations.ProcessingResult{}
+		}), leases)
+
+		stop := make(chan struct{})
+		defer close(stop)
+		defer close(unblock)
+
+		queue.Run(stop)
+		require.NoError(t, queue.Alive())
+
+		// when
+		queue.Add("operation", model.NormalPriority)
+		<-processing
+
+		// then
+		require.Eventually(t, func() bool {
+			err := queue.Alive()
+			return err != nil && strings.HasPrefix(err.Error(), "worker 0 is processing operation for")
+		}, 2*time.Second, 20*time.Millisecond)
+	})
+
+	t.Run("should return no error when idle worker waits for operations longer than timeout", func(t *testing.T) {
+		// given
+		queue := NewQueue("operations", Config{Workers: 1, QPS: 100, Burst: 100, WorkerTimeout: 50 * time.Millisecond}, executorFunc(func(string) operations.ProcessingResult {
+			return operations.ProcessingResult{}
+		}), &mocks.Manager{})
+
+		stop := make(chan struct{})
+		defer close(stop)
+
+		// when
+		queue.Run(stop)
+		time.Sleep(100 * time.Millisecond)
+
+		// then
+		require.NoError(t, queue.Alive())
+	})
+}
+
+func TestQueue_Run(t *testing.T) {
+	t.Run("should process operations with configured number of workers", func(t *testing.T) {
+		// given
+		leases := &mocks.Manager{}
+		leases.On("Acquire", mock.Anything).Return(true, nil)
+		leases.On("Release", mock.Anything).Return()
+
+		var processing, maxProcessing int32
+		var processed sync.WaitGroup
+		processed.Add(3)
+
+		queue := NewQueue("operations", Config{Workers: 2, QPS: 100, Burst: 100}, executorFunc(func(string) operations.ProcessingResult {
+			current := atomic.AddInt32(&processing, 1)
+			for {
+				max := atomic.LoadInt32(&maxProcessing)
+				if current <= max || atomic.CompareAndSwapInt32(&maxProcessing, max, current) {
+					break
+				}
+			}
+			time.Sleep(50 * time.Millisecond)
+			atomic.AddInt32(&processing, -1)
+			processed.Done()
+			return operations.ProcessingResult{}
+		}), leases)
+
+		stop := make(chan struct{})
+		defer close(stop)
+
+		// when
+		queue.Run(stop)
+		queue.Add("operation-1", model.NormalPriority)
+		queue.Add("operation-2", model.NormalPriority)
+		queue.Add("operation-3", model.NormalPriority)
+		processed.Wait()
+
+		// then
+		assert.Equal(t, int32(2), atomic.LoadInt32(&maxProcessing))
+	})
+
+	t.Run("should keep operations in lanes until some worker is free", func(t *testing.T) {
+		// given
+		leases := &mocks.Manager{}
+		leases.On("Acquire", mock.Anything).Return(true, nil)
+		leases.On("Release", mock.Anything).Return()
+
+		started := make(chan struct{}, 3)
+		release := make(chan struct{})
+
+		queue := NewQueue("operations", Config{Workers: 1, QPS: 100, Burst: 100}, executorFunc(func(string) operations.ProcessingResult {
+			started <- struct{}{}
+			<-release
+			return operations.ProcessingResult{}
+		}), leases)
+
+		// when
+		queue.Run(make(chan struct{}))
+		queue.Add("operation-1", model.NormalPriority)
+		<-started
+		queue.Add("operation-2", model.NormalPriority)
+		queue.Add("operation-3", model.NormalPriority)
+		time.Sleep(50 * time.Millisecond)
+
+		// then
+		assert.Equal(t, 2, queue.lanes[model.NormalPriority].Len(), "operations should not be taken from the lane while the worker is busy")
+
+		close(release)
+		<-started
+		<-started
+		require.NoError(t, queue.Shutdown(context.Background()))
+	})
+
+	t.Run("should limit rate of processing and release lease when stopped while waiting", func(t *testing.T) {
+		// given
+		leases := &mocks.Manager{}
+		leases.On("Acquire", mock.Anything).Return(true, nil)
+		leases.On("Release", mock.Anything).Return()
+
+		processed := make(chan string, 2)
+
+		queue := NewQueue("operations", Config{Workers: 2, QPS: 0.001, Burst: 1}, executorFunc(func(operationID string) operations.ProcessingResult {
+			processed <- operationID
+			return operations.ProcessingResult{Requeue: true, Delay: time.Hour}
+		}), leases)
+
+		// when
+		queue.Run(make(chan struct{}))
+		queue.Add("operation-1", model.NormalPriority)
+		queue.Add("operation-2", model.NormalPriority)
+		<-processed
+		time.Sleep(50 * time.Millisecond)
+
+		err := queue.Shutdown(context.Background())
+
+		// then
+		require.NoError(t, err)
+		assert.Len(t, processed, 0)
+		leases.AssertNumberOfCalls(t, "Release", 1)
+	})
+}
+
+func TestWorker(t *testing.T) {
+	t.Run("should requeue operation to the lane of its priority", func(t *testing.T) {
+		// given
+		queue := NewQueue("operations", config, executorFunc(nil), &mocks.Manager{})
+		queue.ready.push(operationID, model.HighPriority)
+
+		process := func(string) operations.ProcessingResult {
+			queue.ready.close()
+			return operations.ProcessingResult{Requeue: true}
+		}
+
+		// when
+		worker(queue.lanes, queue.ready, process, &heartbeat{})()
+
+		// then
+		assert.Equal(t, 1, queue.lanes[model.HighPriority].NumRequeues(operationID))
+		assert.Equal(t, 0, queue.lanes[model.NormalPriority].NumRequeues(operationID))
+	})
+}
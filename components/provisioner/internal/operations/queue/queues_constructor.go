@@ -4,10 +4,13 @@ import (
 	"time"
 
 	gardener_apis "github.com/gardener/gardener/pkg/client/core/clientset/versioned/typed/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/events"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/metrics"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/failure"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/stages/deprovisioning"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/stages/hibernation"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/stages/provisioning"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/stages/shootupgrade"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
@@ -33,6 +36,7 @@ type DeprovisioningTimeouts struct {
 
 type HibernationTimeouts struct {
 	WaitingForClusterHibernation time.Duration `envconfig:"default=60m"`
+	WaitingForClusterWakeUp      time.Duration `envconfig:"default=60m"`
 }
 
 //go:generate mockery --name=KubeconfigProvider
@@ -41,12 +45,17 @@ type KubeconfigProvider interface {
 }
 
 func CreateProvisioningQueue(
+	config Config,
 	timeouts ProvisioningTimeouts,
 	factory dbsession.Factory,
 	shootClient gardener_apis.ShootInterface,
 	operatorRoleBindingConfig provisioning.OperatorRoleBinding,
 	k8sClientProvider k8s.K8sClientProvider,
-	kubeconfigProvider KubeconfigProvider) OperationQueue {
+	kubeconfigProvider KubeconfigProvider,
+	deleteShootOnFailure bool,
+	publisher events.Publisher,
+	recorder metrics.OperationsRecorder,
+	backend Backend) OperationQueue {
 
 	createBindingsForOperatorsStep := provisioning.NewCreateBindingsForOperatorsStep(k8sClientProvider, operatorRoleBindingConfig, kubeconfigProvider, model.FinishedStage, timeouts.BindingsCreation)
 	waitForClusterCreationStep := provisioning.NewWaitForClusterCreationStep(shootClient, factory.NewReadWriteSession(), createBindingsForOperatorsStep.Name(), timeouts.ClusterCreation)
@@ -58,20 +67,32 @@ func CreateProvisioningQueue(
 		model.WaitingForClusterCreation:    waitForClusterCreationStep,
 	}
 
+	var failureHandler operations.FailureHandler = failure.NewNoopFailureHandler()
+	if deleteShootOnFailure {
+		failureHandler = failure.NewProvisioningFailureHandler(shootClient)
+	}
+
 	provisioningExecutor := operations.NewExecutor(
 		factory.NewReadWriteSession(),
 		model.Provision,
 		provisionSteps,
-		failure.NewNoopFailureHandler(),
+		failureHandler,
+		publisher,
+		recorder,
+		backend.Fence(),
 	)
 
-	return NewQueue(provisioningExecutor)
+	return backend.NewQueue("provisioning", config, provisioningExecutor)
 }
 
 func CreateDeprovisioningQueue(
+	config Config,
 	timeouts DeprovisioningTimeouts,
 	factory dbsession.Factory,
 	shootClient gardener_apis.ShootInterface,
+	publisher events.Publisher,
+	recorder metrics.OperationsRecorder,
+	backend Backend,
 ) OperationQueue {
 
 	waitForClusterDeletion := deprovisioning.NewWaitForClusterDeletionStep(shootClient, factory, model.FinishedStage, timeouts.WaitingForClusterDeletion)
@@ -87,18 +108,25 @@ func CreateDeprovisioningQueue(
 		model.DeprovisionNoInstall,
 		deprovisioningSteps,
 		failure.NewNoopFailureHandler(),
+		publisher,
+		recorder,
+		backend.Fence(),
 	)
 
-	return NewQueue(deprovisioningExecutor)
+	return backend.NewQueue("deprovisioning", config, deprovisioningExecutor)
 }
 
 func CreateShootUpgradeQueue(
+	config Config,
 	timeouts ProvisioningTimeouts,
 	factory dbsession.Factory,
 	shootClient gardener_apis.ShootInterface,
 	operatorRoleBindingConfig provisioning.OperatorRoleBinding,
 	k8sClientProvider k8s.K8sClientProvider,
 	kubeconfigProvider KubeconfigProvider,
+	publisher events.Publisher,
+	recorder metrics.OperationsRecorder,
+	backend Backend,
 ) OperationQueue {
 
 	createBindingsForOperatorsStep := provisioning.NewCreateBindingsForOperatorsStep(k8sClientProvider, operatorRoleBindingConfig, kubeconfigProvider, model.FinishedStage, timeouts.BindingsCreation)
@@ -115,8 +143,69 @@ func CreateShootUpgradeQueue(
 		factory.NewReadWriteSession(),
 		model.UpgradeShoot,
 		upgradeSteps,
+		failure.NewShootUpgradeFailureHandler(factory),
+		publisher,
+		recorder,
+		backend.Fence(),
+	)
+
+	return backend.NewQueue("shoot_upgrade", config, upgradeClusterExecutor)
+}
+
+func CreateHibernationQueue(
+	config Config,
+	timeouts HibernationTimeouts,
+	factory dbsession.Factory,
+	shootClient gardener_apis.ShootInterface,
+	publisher events.Publisher,
+	recorder metrics.OperationsRecorder,
+	backend Backend,
+) OperationQueue {
+
+	waitForHibernation := hibernation.NewWaitForHibernationStep(shootClient, model.FinishedStage, timeouts.WaitingForClusterHibernation)
+
+	hibernationSteps := map[model.OperationStage]operations.Step{
+		model.WaitForHibernation: waitForHibernation,
+	}
+
+	hibernationExecutor := operations.NewExecutor(
+		factory.NewReadWriteSession(),
+		model.Hibernate,
+		hibernationSteps,
+		failure.NewNoopFailureHandler(),
+		publisher,
+		recorder,
+		backend.Fence(),
+	)
+
+	return backend.NewQueue("hibernation", config, hibernationExecutor)
+}
+
+func CreateWakeUpQueue(
+	config Config,
+	timeouts HibernationTimeouts,
+	factory dbsession.Factory,
+	shootClient gardener_apis.ShootInterface,
+	publisher events.Publisher,
+	recorder metrics.OperationsRecorder,
+	backend Backend,
+) OperationQueue {
+
+	waitForWakeUp := hibernation.NewWaitForWakeUpStep(shootClient, model.FinishedStage, timeouts.WaitingForClusterWakeUp)
+
+	wakeUpSteps := map[model.OperationStage]operations.Step{
+		model.WaitForWakeUp: waitForWakeUp,
+	}
+
+	wakeUpExecutor := operations.NewExecutor(
+		factory.NewReadWriteSession(),
+		model.WakeUp,
+		wakeUpSteps,
 		failure.NewNoopFailureHandler(),
+		publisher,
+		recorder,
+		backend.Fence(),
 	)
 
-	return NewQueue(upgradeClusterExecutor)
+	return backend.NewQueue("wake_up", config, wakeUpExecutor)
 }
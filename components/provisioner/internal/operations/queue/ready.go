@@ -0,0 +1,123 @@
+package queue
+
+import (
+	"sync"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+)
+
+type readyOperation struct {
+	id       string
+	priority model.OperationPriority
+	since    time.Time
+}
+
+// readyOperations hands operations due to be processed to free workers, the operation with the highest priority is served first
+// and the priority of the waiting operation is raised by one level every aging period.
+// It holds at most one operation of every priority, the rest is kept in the lanes until some worker is free
+type readyOperations struct {
+	mutex      sync.Mutex
+	cond       *sync.Cond
+	operations []readyOperation
+	aging      time.Duration
+	closed     bool
+	// waiting is the number of workers waiting for the operation
+	waiting int
+	now     func() time.Time
+}
+
+func newReadyOperations(aging time.Duration) *readyOperations {
+	ready := &readyOperations{
+		aging: aging,
+		now:   time.Now,
+	}
+	ready.cond = sync.NewCond(&ready.mutex)
+
+	return ready
+}
+
+func (r *readyOperations) push(id string, priority model.OperationPriority) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.closed {
+		return
+	}
+
+	r.operations = append(r.operations, readyOperation{id: id, priority: priority, since: r.now()})
+	// Workers and feeders wait for the same condition
+	r.cond.Broadcast()
+}
+
+// awaitDemand waits until some worker is free and no operation of the priority is waiting, it returns false when closed
+func (r *readyOperations) awaitDemand(priority model.OperationPriority) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for !r.closed && (r.waiting == 0 || r.holds(priority)) {
+		r.cond.Wait()
+	}
+
+	return !r.closed
+}
+
+func (r *readyOperations) holds(priority model.OperationPriority) bool {
+	for _, operation := range r.operations {
+		if operation.priority == priority {
+			return true
+		}
+	}
+
+	return false
+}
+
+// pop waits for the operation, it returns true when closed
+func (r *readyOperations) pop() (readyOperation, bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.waiting++
+	defer func() { r.waiting-- }()
+	// Feeders are woken up to hand operations of their lanes
+	r.cond.Broadcast()
+
+	for len(r.operations) == 0 && !r.closed {
+		r.cond.Wait()
+	}
+	if r.closed {
+		return readyOperation{}, true
+	}
+
+	now := r.now()
+	next := 0
+	// Operations are kept in the order of arrival so the one waiting longest is served from operations with the same priority
+	for i := 1; i < len(r.operations); i++ {
+		if r.effectivePriority(r.operations[i], now) > r.effectivePriority(r.operations[next], now) {
+			next = i
+		}
+	}
+
+	operation := r.operations[next]
+	r.operations = append(r.operations[:next], r.operations[next+1:]...)
+	// Feeder of the lane can hand the next operation to other waiting workers
+	r.cond.Broadcast()
+
+	return operation, false
+}
+
+func (r *readyOperations) close() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.closed = true
+	r.cond.Broadcast()
+}
+
+func (r *readyOperations) effectivePriority(operation readyOperation, now time.Time) int64 {
+	if r.aging <= 0 {
+		return int64(operation.priority)
+	}
+
+	return int64(operation.priority) + int64(now.Sub(operation.since)/r.aging)
+}
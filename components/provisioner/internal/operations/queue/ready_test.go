@@ -0,0 +1,161 @@
+package queue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadyOperations_pop(t *testing.T) {
+	popIDs := func(ready *readyOperations, count int) []string {
+		var ids []string
+		for i := 0; i < count; i++ {
+			operation, quit := ready.pop()
+			assert.False(t, quit)
+			ids = append(ids, operation.id)
+		}
+		return ids
+	}
+
+	t.Run("should serve operations with higher priority first and keep order of arrival within priority", func(t *testing.T) {
+		// given
+		ready := newReadyOperations(time.Minute)
+		ready.push("low", model.LowPriority)
+		ready.push("normal-1", model.NormalPriority)
+		ready.push("high", model.HighPriority)
+		ready.push("normal-2", model.NormalPriority)
+
+		// when
+		ids := popIDs(ready, 4)
+
+		// then
+		assert.Equal(t, []string{"high", "normal-1", "normal-2", "low"}, ids)
+	})
+
+	t.Run("should raise priority of waiting operations", func(t *testing.T) {
+		// given
+		now := time.Now()
+		ready := newReadyOperations(time.Minute)
+		ready.now = func() time.Time { return now }
+
+		ready.push("low", model.LowPriority)
+		now = now.Add(2 * time.Minute)
+		ready.push("normal", model.NormalPriority)
+
+		// when
+		ids := popIDs(ready, 2)
+
+		// then
+		assert.Equal(t, []string{"low", "normal"}, ids, "low priority operation waiting for two aging periods should be served before new operation with normal priority")
+	})
+
+	t.Run("should not raise priority when aging is disabled", func(t *testing.T) {
+		// given
+		now := time.Now()
+		ready := newReadyOperations(0)
+		ready.now = func() time.Time { return now }
+
+		ready.push("low", model.LowPriority)
+		now = now.Add(time.Hour)
+		ready.push("normal", model.NormalPriority)
+
+		// when
+		ids := popIDs(ready, 2)
+
+		// then
+		assert.Equal(t, []string{"normal", "low"}, ids)
+	})
+
+	t.Run("should quit waiting when closed", func(t *testing.T) {
+		// given
+		ready := newReadyOperations(time.Minute)
+		ready.push("operation", model.NormalPriority)
+
+		// when
+		ready.close()
+		_, quit := ready.pop()
+
+		// then
+		assert.True(t, quit, "operations left after close should not be served")
+	})
+}
+
+func TestReadyOperations_awaitDemand(t *testing.T) {
+	t.Run("should wait until some worker is free", func(t *testing.T) {
+		// given
+		ready := newReadyOperations(time.Minute)
+		demanded := make(chan bool)
+
+		// when
+		go func() {
+			demanded <- ready.awaitDemand(model.NormalPriority)
+		}()
+
+		// then
+		select {
+		case <-demanded:
+			t.Fatal("operation should not be demanded when no worker is free")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		go ready.pop()
+		select {
+		case open := <-demanded:
+			assert.True(t, open)
+		case <-time.After(time.Second):
+			t.Fatal("operation should be demanded by free worker")
+		}
+	})
+
+	t.Run("should not demand next operation of the priority until the waiting one is served", func(t *testing.T) {
+		// given
+		ready := newReadyOperations(time.Minute)
+		ready.push("normal", model.NormalPriority)
+		ready.waiting = 1
+
+		demanded := make(chan model.OperationPriority, 2)
+
+		// when
+		for _, priority := range []model.OperationPriority{model.NormalPriority, model.HighPriority} {
+			go func(priority model.OperationPriority) {
+				if ready.awaitDemand(priority) {
+					demanded <- priority
+				}
+			}(priority)
+		}
+
+		// then
+		select {
+		case priority := <-demanded:
+			assert.Equal(t, model.HighPriority, priority)
+		case <-time.After(time.Second):
+			t.Fatal("operation of other priority should be demanded")
+		}
+
+		select {
+		case <-demanded:
+			t.Fatal("operation with normal priority should not be demanded")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		ready.close()
+	})
+
+	t.Run("should quit waiting when closed", func(t *testing.T) {
+		// given
+		ready := newReadyOperations(time.Minute)
+		demanded := make(chan bool)
+
+		go func() {
+			demanded <- ready.awaitDemand(model.NormalPriority)
+		}()
+
+		// when
+		ready.close()
+
+		// then
+		assert.False(t, <-demanded)
+	})
+}
@@ -41,9 +41,13 @@ func (s *WaitForClusterDeletionStep) TimeLimit() time.Duration {
 	return s.timeLimit
 }
 
-func (s *WaitForClusterDeletionStep) Run(cluster model.Cluster, _ model.Operation, _ logrus.FieldLogger) (operations.StageResult, error) {
+func (s *WaitForClusterDeletionStep) RetryPolicy() operations.RetryPolicy {
+	return operations.DefaultRetryPolicy
+}
+
+func (s *WaitForClusterDeletionStep) Run(ctx context.Context, cluster model.Cluster, _ model.Operation, _ logrus.FieldLogger) (operations.StageResult, error) {
 
-	shootExists, err := s.shootExists(cluster.ClusterConfig.Name)
+	shootExists, err := s.shootExists(ctx, cluster.ClusterConfig.Name)
 	if err != nil {
 		return operations.StageResult{}, err
 	}
@@ -52,7 +56,7 @@ func (s *WaitForClusterDeletionStep) Run(cluster model.Cluster, _ model.Operatio
 		return operations.StageResult{Stage: s.Name(), Delay: 20 * time.Second}, nil
 	}
 
-	err = s.setDeprovisioningFinished(cluster)
+	err = s.setDeprovisioningFinished(ctx, cluster)
 	if err != nil {
 		return operations.StageResult{}, err
 	}
@@ -60,8 +64,8 @@ func (s *WaitForClusterDeletionStep) Run(cluster model.Cluster, _ model.Operatio
 	return operations.StageResult{Stage: s.nextStep, Delay: 0}, nil
 }
 
-func (s *WaitForClusterDeletionStep) shootExists(gardenerClusterName string) (bool, error) {
-	_, err := s.gardenerClient.Get(context.Background(), gardenerClusterName, v1.GetOptions{})
+func (s *WaitForClusterDeletionStep) shootExists(ctx context.Context, gardenerClusterName string) (bool, error) {
+	_, err := s.gardenerClient.Get(ctx, gardenerClusterName, v1.GetOptions{})
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
 			return false, nil
@@ -72,8 +76,8 @@ func (s *WaitForClusterDeletionStep) shootExists(gardenerClusterName string) (bo
 	return true, nil
 }
 
-func (s *WaitForClusterDeletionStep) setDeprovisioningFinished(cluster model.Cluster) error {
-	session, dberr := s.dbsFactory.NewSessionWithinTransaction()
+func (s *WaitForClusterDeletionStep) setDeprovisioningFinished(ctx context.Context, cluster model.Cluster) error {
+	session, dberr := dbsession.WithContext(ctx, s.dbsFactory).NewSessionWithinTransaction()
 	if dberr != nil {
 		return errors.Wrap(dberr, "error starting db session with transaction")
 	}
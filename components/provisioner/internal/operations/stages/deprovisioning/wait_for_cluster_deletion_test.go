@@ -74,7 +74,7 @@ func TestWaitForClusterDeletion_Run(t *testing.T) {
 			waitForClusterDeletionStep := NewWaitForClusterDeletionStep(gardenerClient, dbSessionFactory, nextStageName, 10*time.Minute)
 
 			// when
-			result, err := waitForClusterDeletionStep.Run(cluster, model.Operation{}, logrus.New())
+			result, err := waitForClusterDeletionStep.Run(context.Background(), cluster, model.Operation{}, logrus.New())
 
 			// then
 			require.NoError(t, err)
@@ -158,7 +158,7 @@ func TestWaitForClusterDeletion_Run(t *testing.T) {
 			waitForClusterDeletionStep := NewWaitForClusterDeletionStep(gardenerClient, dbSessionFactory, nextStageName, 10*time.Minute)
 
 			// when
-			_, err := waitForClusterDeletionStep.Run(testCase.cluster, model.Operation{}, logrus.New())
+			_, err := waitForClusterDeletionStep.Run(context.Background(), testCase.cluster, model.Operation{}, logrus.New())
 			appErr := operations.ConvertToAppError(err)
 
 			// then
@@ -0,0 +1,73 @@
+package hibernation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations"
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type GardenerClient interface {
+	Get(ctx context.Context, name string, options v1.GetOptions) (*v1beta1.Shoot, error)
+}
+
+type WaitForHibernationStep struct {
+	gardenerClient GardenerClient
+	nextStep       model.OperationStage
+	timeLimit      time.Duration
+}
+
+func NewWaitForHibernationStep(gardenerClient GardenerClient, nextStep model.OperationStage, timeLimit time.Duration) *WaitForHibernationStep {
+	return &WaitForHibernationStep{
+		gardenerClient: gardenerClient,
+		nextStep:       nextStep,
+		timeLimit:      timeLimit,
+	}
+}
+
+func (s *WaitForHibernationStep) Name() model.OperationStage {
+	return model.WaitForHibernation
+}
+
+func (s *WaitForHibernationStep) TimeLimit() time.Duration {
+	return s.timeLimit
+}
+
+func (s *WaitForHibernationStep) RetryPolicy() operations.RetryPolicy {
+	return operations.DefaultRetryPolicy
+}
+
+func (s *WaitForHibernationStep) Run(ctx context.Context, cluster model.Cluster, _ model.Operation, logger logrus.FieldLogger) (operations.StageResult, error) {
+	shoot, err := s.gardenerClient.Get(ctx, cluster.ClusterConfig.Name, v1.GetOptions{})
+	if err != nil {
+		return operations.StageResult{}, err
+	}
+
+	if shoot.Status.IsHibernated && reconciled(shoot) {
+		return operations.StageResult{Stage: s.nextStep, Delay: 0}, nil
+	}
+
+	lastOperation := shoot.Status.LastOperation
+	if lastOperation != nil && lastOperation.State == v1beta1.LastOperationStateFailed {
+		logger.Warningf("Gardener Shoot cluster hibernation failed! Last state: %s, Description: %s", lastOperation.State, lastOperation.Description)
+
+		err := fmt.Errorf("gardener Shoot cluster hibernation failed. Last Shoot state: %s, Shoot description: %s", lastOperation.State, lastOperation.Description)
+		return operations.StageResult{}, operations.NewNonRecoverableError(err)
+	}
+
+	return operations.StageResult{Stage: s.Name(), Delay: 20 * time.Second}, nil
+}
+
+// reconciled returns true if Gardener finished processing the latest Shoot spec
+func reconciled(shoot *v1beta1.Shoot) bool {
+	lastOperation := shoot.Status.LastOperation
+
+	return shoot.Status.ObservedGeneration == shoot.Generation &&
+		lastOperation != nil &&
+		lastOperation.State == v1beta1.LastOperationStateSucceeded
+}
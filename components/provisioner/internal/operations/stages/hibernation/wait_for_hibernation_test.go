@@ -0,0 +1,140 @@
+package hibernation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	gardener_mocks "github.com/kyma-project/control-plane/components/provisioner/internal/operations/stages/deprovisioning/mocks"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util/testkit"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	clusterName = "shootName"
+	runtimeID   = "runtimeID"
+	operationID = "operationID"
+)
+
+func TestWaitForHibernation(t *testing.T) {
+	cluster := model.Cluster{
+		ID: runtimeID,
+		ClusterConfig: model.GardenerConfig{
+			Name: clusterName,
+		},
+	}
+
+	for _, testCase := range []struct {
+		description   string
+		mockFunc      func(gardenerClient *gardener_mocks.GardenerClient)
+		expectedStage model.OperationStage
+		expectedDelay time.Duration
+	}{
+		{
+			description: "should continue waiting if cluster is not hibernated",
+			mockFunc: func(gardenerClient *gardener_mocks.GardenerClient) {
+				gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(
+					testkit.NewTestShoot(clusterName).
+						WithHibernationState(true, false).
+						WithOperationProcessing().
+						ToShoot(), nil)
+			},
+			expectedStage: model.WaitForHibernation,
+			expectedDelay: 20 * time.Second,
+		},
+		{
+			description: "should continue waiting if Gardener did not observe the latest generation",
+			mockFunc: func(gardenerClient *gardener_mocks.GardenerClient) {
+				gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(
+					testkit.NewTestShoot(clusterName).
+						WithGeneration(2).
+						WithObservedGeneration(1).
+						WithHibernationState(true, true).
+						WithOperationSucceeded().
+						ToShoot(), nil)
+			},
+			expectedStage: model.WaitForHibernation,
+			expectedDelay: 20 * time.Second,
+		},
+		{
+			description: "should go to the next step when cluster is hibernated",
+			mockFunc: func(gardenerClient *gardener_mocks.GardenerClient) {
+				gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(
+					testkit.NewTestShoot(clusterName).
+						WithHibernationState(true, true).
+						WithOperationSucceeded().
+						ToShoot(), nil)
+			},
+			expectedStage: model.FinishedStage,
+			expectedDelay: 0,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// given
+			gardenerClient := &gardener_mocks.GardenerClient{}
+
+			testCase.mockFunc(gardenerClient)
+
+			waitForHibernationStep := NewWaitForHibernationStep(gardenerClient, model.FinishedStage, time.Minute)
+
+			// when
+			result, err := waitForHibernationStep.Run(context.Background(), cluster, model.Operation{ID: operationID}, logrus.New())
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStage, result.Stage)
+			assert.Equal(t, testCase.expectedDelay, result.Delay)
+			gardenerClient.AssertExpectations(t)
+		})
+	}
+
+	for _, testCase := range []struct {
+		description        string
+		mockFunc           func(gardenerClient *gardener_mocks.GardenerClient)
+		unrecoverableError bool
+	}{
+		{
+			description: "should return error if failed to read Shoot",
+			mockFunc: func(gardenerClient *gardener_mocks.GardenerClient) {
+				gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(nil, errors.New("some error"))
+			},
+			unrecoverableError: false,
+		},
+		{
+			description: "should return unrecoverable error if Shoot is in failed state",
+			mockFunc: func(gardenerClient *gardener_mocks.GardenerClient) {
+				gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(
+					testkit.NewTestShoot(clusterName).
+						WithHibernationState(true, false).
+						WithOperationFailed().
+						ToShoot(), nil)
+			},
+			unrecoverableError: true,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// given
+			gardenerClient := &gardener_mocks.GardenerClient{}
+
+			testCase.mockFunc(gardenerClient)
+
+			waitForHibernationStep := NewWaitForHibernationStep(gardenerClient, model.FinishedStage, time.Minute)
+
+			// when
+			_, err := waitForHibernationStep.Run(context.Background(), cluster, model.Operation{}, logrus.New())
+
+			// then
+			require.Error(t, err)
+			require.Equal(t, testCase.unrecoverableError, errors.As(err, &operations.NonRecoverableError{}))
+			gardenerClient.AssertExpectations(t)
+		})
+	}
+}
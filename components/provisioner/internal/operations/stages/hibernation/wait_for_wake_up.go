@@ -0,0 +1,60 @@
+package hibernation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations"
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type WaitForWakeUpStep struct {
+	gardenerClient GardenerClient
+	nextStep       model.OperationStage
+	timeLimit      time.Duration
+}
+
+func NewWaitForWakeUpStep(gardenerClient GardenerClient, nextStep model.OperationStage, timeLimit time.Duration) *WaitForWakeUpStep {
+	return &WaitForWakeUpStep{
+		gardenerClient: gardenerClient,
+		nextStep:       nextStep,
+		timeLimit:      timeLimit,
+	}
+}
+
+func (s *WaitForWakeUpStep) Name() model.OperationStage {
+	return model.WaitForWakeUp
+}
+
+func (s *WaitForWakeUpStep) TimeLimit() time.Duration {
+	return s.timeLimit
+}
+
+func (s *WaitForWakeUpStep) RetryPolicy() operations.RetryPolicy {
+	return operations.DefaultRetryPolicy
+}
+
+func (s *WaitForWakeUpStep) Run(ctx context.Context, cluster model.Cluster, _ model.Operation, logger logrus.FieldLogger) (operations.StageResult, error) {
+	shoot, err := s.gardenerClient.Get(ctx, cluster.ClusterConfig.Name, v1.GetOptions{})
+	if err != nil {
+		return operations.StageResult{}, err
+	}
+
+	if !shoot.Status.IsHibernated && reconciled(shoot) {
+		return operations.StageResult{Stage: s.nextStep, Delay: 0}, nil
+	}
+
+	lastOperation := shoot.Status.LastOperation
+	if lastOperation != nil && lastOperation.State == v1beta1.LastOperationStateFailed {
+		logger.Warningf("Gardener Shoot cluster wake up failed! Last state: %s, Description: %s", lastOperation.State, lastOperation.Description)
+
+		err := fmt.Errorf("gardener Shoot cluster wake up failed. Last Shoot state: %s, Shoot description: %s", lastOperation.State, lastOperation.Description)
+		return operations.StageResult{}, operations.NewNonRecoverableError(err)
+	}
+
+	return operations.StageResult{Stage: s.Name(), Delay: 20 * time.Second}, nil
+}
@@ -30,6 +30,14 @@ const (
 	userKindSubject = "User"
 )
 
+// API server of the new cluster is often not reachable right after the cluster is created
+var createBindingsRetryPolicy = operations.RetryPolicy{
+	InitialDelay: 5 * time.Second,
+	Multiplier:   1.5,
+	MaxDelay:     time.Minute,
+	MaxAttempts:  20,
+}
+
 //go:generate mockery --name=DynamicKubeconfigProvider
 type DynamicKubeconfigProvider interface {
 	FetchFromRequest(shootName string) ([]byte, error)
@@ -71,7 +79,11 @@ func (s *CreateBindingsForOperatorsStep) TimeLimit() time.Duration {
 	return s.timeLimit
 }
 
-func (s *CreateBindingsForOperatorsStep) Run(cluster model.Cluster, _ model.Operation, log logrus.FieldLogger) (operations.StageResult, error) {
+func (s *CreateBindingsForOperatorsStep) RetryPolicy() operations.RetryPolicy {
+	return createBindingsRetryPolicy
+}
+
+func (s *CreateBindingsForOperatorsStep) Run(ctx context.Context, cluster model.Cluster, _ model.Operation, log logrus.FieldLogger) (operations.StageResult, error) {
 
 	var kubeconfig []byte
 	{
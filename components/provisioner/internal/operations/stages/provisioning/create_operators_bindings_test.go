@@ -54,7 +54,7 @@ func TestCreateBindingsForOperatorsStep_Run(t *testing.T) {
 		step := NewCreateBindingsForOperatorsStep(k8sClientProvider, operatorBindingConfig, dynamicKubeconfigProvider, nextStageName, time.Minute)
 
 		// when
-		result, err := step.Run(cluster, model.Operation{}, &logrus.Entry{})
+		result, err := step.Run(context.Background(), cluster, model.Operation{}, &logrus.Entry{})
 
 		// then
 		require.NoError(t, err)
@@ -83,7 +83,7 @@ func TestCreateBindingsForOperatorsStep_Run(t *testing.T) {
 		step := NewCreateBindingsForOperatorsStep(k8sClientProvider, operatorBindingConfig, dynamicKubeconfigProvider, nextStageName, time.Minute)
 
 		// when
-		result, err := step.Run(cluster, model.Operation{}, &logrus.Entry{})
+		result, err := step.Run(context.Background(), cluster, model.Operation{}, &logrus.Entry{})
 
 		// then
 		require.NoError(t, err)
@@ -109,7 +109,7 @@ func TestCreateBindingsForOperatorsStep_Run(t *testing.T) {
 		step := NewCreateBindingsForOperatorsStep(k8sClientProvider, operatorBindingConfig, dynamicKubeconfigProvider, nextStageName, time.Minute)
 
 		// when
-		result, err := step.Run(cluster, model.Operation{}, &logrus.Entry{})
+		result, err := step.Run(context.Background(), cluster, model.Operation{}, &logrus.Entry{})
 
 		// then
 		require.NoError(t, err)
@@ -125,7 +125,7 @@ func TestCreateBindingsForOperatorsStep_Run(t *testing.T) {
 		step := NewCreateBindingsForOperatorsStep(nil, operatorBindingConfig, dynamicKubeconfigProvider, nextStageName, time.Minute)
 
 		// when
-		result, err := step.Run(cluster, model.Operation{}, &logrus.Entry{})
+		result, err := step.Run(context.Background(), cluster, model.Operation{}, &logrus.Entry{})
 
 		// then
 		require.NoError(t, err)
@@ -141,7 +141,7 @@ func TestCreateBindingsForOperatorsStep_Run(t *testing.T) {
 		step := NewCreateBindingsForOperatorsStep(k8sClientProvider, operatorBindingConfig, dynamicKubeconfigProvider, nextStageName, time.Minute)
 
 		// when
-		_, err := step.Run(cluster, model.Operation{}, &logrus.Entry{})
+		_, err := step.Run(context.Background(), cluster, model.Operation{}, &logrus.Entry{})
 
 		// then
 		require.Error(t, err)
@@ -163,7 +163,7 @@ func TestCreateBindingsForOperatorsStep_Run(t *testing.T) {
 		step := NewCreateBindingsForOperatorsStep(k8sClientProvider, operatorBindingConfig, dynamicKubeconfigProvider, nextStageName, time.Minute)
 
 		// when
-		_, err := step.Run(cluster, model.Operation{}, &logrus.Entry{})
+		_, err := step.Run(context.Background(), cluster, model.Operation{}, &logrus.Entry{})
 
 		// then
 		require.Error(t, err)
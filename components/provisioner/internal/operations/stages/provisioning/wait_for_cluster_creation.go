@@ -40,8 +40,12 @@ func (s *WaitForClusterCreationStep) TimeLimit() time.Duration {
 	return s.timeLimit
 }
 
-func (s *WaitForClusterCreationStep) Run(cluster model.Cluster, _ model.Operation, logger log.FieldLogger) (operations.StageResult, error) {
-	shoot, err := s.gardenerClient.Get(context.Background(), cluster.ClusterConfig.Name, v1.GetOptions{})
+func (s *WaitForClusterCreationStep) RetryPolicy() operations.RetryPolicy {
+	return operations.DefaultRetryPolicy
+}
+
+func (s *WaitForClusterCreationStep) Run(ctx context.Context, cluster model.Cluster, _ model.Operation, logger log.FieldLogger) (operations.StageResult, error) {
+	shoot, err := s.gardenerClient.Get(ctx, cluster.ClusterConfig.Name, v1.GetOptions{})
 	if err != nil {
 		return operations.StageResult{}, util.K8SErrorToAppError(err).SetComponent(apperrors.ErrGardenerClient)
 	}
@@ -83,7 +87,7 @@ func (s *WaitForClusterCreationStep) proceedToInstallation(cluster model.Cluster
 
 	if cluster.ClusterConfig.Seed == "" && shoot.Spec.SeedName != nil && *shoot.Spec.SeedName != "" {
 		cluster.ClusterConfig.Seed = *shoot.Spec.SeedName
-		dberr := s.dbSession.UpdateGardenerClusterConfig(cluster.ClusterConfig)
+		dberr := s.dbSession.UpdateGardenerClusterSeed(cluster.ID, cluster.ClusterConfig.Seed)
 
 		if dberr != nil {
 			return operations.StageResult{}, dberr
@@ -7,8 +7,12 @@ import (
 	"time"
 
 	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/events"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/metrics"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/failure"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/lease"
 	gardener_mocks "github.com/kyma-project/control-plane/components/provisioner/internal/operations/stages/deprovisioning/mocks"
 	provisioning_mocks "github.com/kyma-project/control-plane/components/provisioner/internal/operations/stages/provisioning/mocks"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
@@ -86,7 +90,7 @@ func TestWaitForClusterInitialization_Run(t *testing.T) {
 				gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(fixShootInSucceededStateWithSeed(clusterName, "az-eu2"), nil)
 
 				dbSession.On("UpdateKubeconfig", cluster.ID, "kubeconfig").Return(nil)
-				dbSession.On("UpdateGardenerClusterConfig", cluster.ClusterConfig).Return(nil)
+				dbSession.On("UpdateGardenerClusterSeed", cluster.ID, "az-eu2").Return(nil)
 			},
 			expectedStage: nextStageName,
 			expectedDelay: 0,
@@ -103,7 +107,7 @@ func TestWaitForClusterInitialization_Run(t *testing.T) {
 
 			waitForClusterCreationStep := NewWaitForClusterCreationStep(gardenerClient, dbSession, nextStageName, 10*time.Minute)
 			// when
-			result, err := waitForClusterCreationStep.Run(testCase.cluster, model.Operation{}, logrus.New())
+			result, err := waitForClusterCreationStep.Run(context.Background(), testCase.cluster, model.Operation{}, logrus.New())
 
 			// then
 			require.NoError(t, err)
@@ -156,7 +160,7 @@ func TestWaitForClusterInitialization_Run(t *testing.T) {
 			mockFunc: func(gardenerClient *gardener_mocks.GardenerClient, dbSession *dbMocks.ReadWriteSession, _ *provisioning_mocks.DynamicKubeconfigProvider) {
 				gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(fixShootInSucceededStateWithSeed(clusterName, "az-eu2"), nil)
 
-				dbSession.On("UpdateGardenerClusterConfig", cluster.ClusterConfig).Return(dberrors.Internal("some error"))
+				dbSession.On("UpdateGardenerClusterSeed", cluster.ID, "az-eu2").Return(dberrors.Internal("some error"))
 			},
 			unrecoverableError: false,
 			cluster:            clusterWithoutSeed,
@@ -173,7 +177,7 @@ func TestWaitForClusterInitialization_Run(t *testing.T) {
 			waitForClusterCreationStep := NewWaitForClusterCreationStep(gardenerClient, dbSession, nextStageName, 10*time.Minute)
 
 			// when
-			_, err := waitForClusterCreationStep.Run(testCase.cluster, model.Operation{}, logrus.New())
+			_, err := waitForClusterCreationStep.Run(context.Background(), testCase.cluster, model.Operation{}, logrus.New())
 
 			// then
 			require.Error(t, err)
@@ -249,3 +253,67 @@ func fixShoot(name string, lastOperation *v1beta1.LastOperation) *v1beta1.Shoot
 		},
 	}
 }
+
+func TestWaitForClusterCreationStep_RetryPolicy(t *testing.T) {
+	cluster := model.Cluster{
+		ID:            "runtimeID",
+		ClusterConfig: model.GardenerConfig{Name: "name"},
+	}
+
+	for _, testCase := range []struct {
+		description     string
+		attempts        int
+		expectedRequeue bool
+		mockFunc        func(dbSession *dbMocks.ReadWriteSession)
+	}{
+		{
+			description:     "should retry operation when Gardener call failed",
+			attempts:        0,
+			expectedRequeue: true,
+			mockFunc: func(dbSession *dbMocks.ReadWriteSession) {
+				dbSession.On("UpdateOperationAttempts", "operationID", 1).Return(nil)
+			},
+		},
+		{
+			description:     "should fail operation when Gardener call failed max attempts times",
+			attempts:        operations.DefaultRetryPolicy.MaxAttempts - 1,
+			expectedRequeue: false,
+			mockFunc: func(dbSession *dbMocks.ReadWriteSession) {
+				dbSession.On("UpdateOperationState", "operationID", mock.AnythingOfType("string"), model.Failed, mock.AnythingOfType("time.Time")).Return(nil)
+			},
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// given
+			operation := model.Operation{
+				ID:             "operationID",
+				Type:           model.Provision,
+				State:          model.InProgress,
+				Stage:          model.WaitingForClusterCreation,
+				ClusterID:      cluster.ID,
+				StartTimestamp: time.Now(),
+				Attempts:       testCase.attempts,
+			}
+
+			gardenerClient := &gardener_mocks.GardenerClient{}
+			gardenerClient.On("Get", mock.Anything, "name", mock.Anything).Return(nil, errors.New("some error"))
+
+			dbSession := &dbMocks.ReadWriteSession{}
+			dbSession.On("GetOperation", operation.ID).Return(operation, nil)
+			dbSession.On("GetCluster", cluster.ID).Return(cluster, nil)
+			dbSession.On("UpdateOperationLastError", operation.ID, mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+			testCase.mockFunc(dbSession)
+
+			step := NewWaitForClusterCreationStep(gardenerClient, dbSession, nextStageName, 10*time.Minute)
+			executor := operations.NewExecutor(dbSession, model.Provision, map[model.OperationStage]operations.Step{model.WaitingForClusterCreation: step},
+				failure.NewNoopFailureHandler(), events.NewNoopPublisher(), metrics.NewOperationsCollector(), lease.NewNoopManager())
+
+			// when
+			result := executor.Execute(operation.ID)
+
+			// then
+			assert.Equal(t, testCase.expectedRequeue, result.Requeue)
+			dbSession.AssertExpectations(t)
+		})
+	}
+}
@@ -41,8 +41,12 @@ func (s *WaitForClusterDomainStep) TimeLimit() time.Duration {
 	return s.timeLimit
 }
 
-func (s *WaitForClusterDomainStep) Run(cluster model.Cluster, _ model.Operation, log logrus.FieldLogger) (operations.StageResult, error) {
-	shoot, err := s.gardenerClient.Get(context.Background(), cluster.ClusterConfig.Name, v1.GetOptions{})
+func (s *WaitForClusterDomainStep) RetryPolicy() operations.RetryPolicy {
+	return operations.DefaultRetryPolicy
+}
+
+func (s *WaitForClusterDomainStep) Run(ctx context.Context, cluster model.Cluster, _ model.Operation, log logrus.FieldLogger) (operations.StageResult, error) {
+	shoot, err := s.gardenerClient.Get(ctx, cluster.ClusterConfig.Name, v1.GetOptions{})
 	if err != nil {
 		return operations.StageResult{}, util.K8SErrorToAppError(err).SetComponent(apperrors.ErrGardenerClient)
 	}
@@ -92,7 +92,7 @@ func TestWaitForClusterDomain_Run(t *testing.T) {
 			waitForClusterDomainStep := NewWaitForClusterDomainStep(gardenerClient, nextStageName, 10*time.Minute)
 
 			// when
-			result, err := waitForClusterDomainStep.Run(cluster, model.Operation{}, logrus.New())
+			result, err := waitForClusterDomainStep.Run(context.Background(), cluster, model.Operation{}, logrus.New())
 
 			// then
 			require.NoError(t, err)
@@ -126,7 +126,7 @@ func TestWaitForClusterDomain_Run(t *testing.T) {
 			waitForClusterDomainStep := NewWaitForClusterDomainStep(gardenerClient, nextStageName, 10*time.Minute)
 
 			// when
-			_, err := waitForClusterDomainStep.Run(testCase.cluster, model.Operation{}, logrus.New())
+			_, err := waitForClusterDomainStep.Run(context.Background(), testCase.cluster, model.Operation{}, logrus.New())
 
 			// then
 			require.Error(t, err)
@@ -72,7 +72,7 @@ func TestWaitForNewShootVersion(t *testing.T) {
 			waitForShootClusterUpgradeStep := NewWaitForShootNewVersionStep(gardenerClient, model.WaitingForShootUpgrade, time.Minute)
 
 			// when
-			result, err := waitForShootClusterUpgradeStep.Run(cluster, model.Operation{ID: operationID}, logrus.New())
+			result, err := waitForShootClusterUpgradeStep.Run(context.Background(), cluster, model.Operation{ID: operationID}, logrus.New())
 
 			// then
 			require.NoError(t, err)
@@ -119,7 +119,7 @@ func TestWaitForNewShootVersion(t *testing.T) {
 			waitForClusterCreationStep := NewWaitForShootNewVersionStep(gardenerClient, model.FinishedStage, time.Minute)
 
 			// when
-			_, err := waitForClusterCreationStep.Run(testCase.cluster, model.Operation{}, logrus.New())
+			_, err := waitForClusterCreationStep.Run(context.Background(), testCase.cluster, model.Operation{}, logrus.New())
 
 			// then
 			require.Error(t, err)
@@ -61,11 +61,15 @@ func (s *WaitForShootUpgradeStep) TimeLimit() time.Duration {
 	return s.timeLimit
 }
 
-func (s *WaitForShootUpgradeStep) Run(cluster model.Cluster, _ model.Operation, logger logrus.FieldLogger) (operations.StageResult, error) {
+func (s *WaitForShootUpgradeStep) RetryPolicy() operations.RetryPolicy {
+	return operations.DefaultRetryPolicy
+}
+
+func (s *WaitForShootUpgradeStep) Run(ctx context.Context, cluster model.Cluster, _ model.Operation, logger logrus.FieldLogger) (operations.StageResult, error) {
 
 	gardenerConfig := cluster.ClusterConfig
 
-	shoot, err := s.gardenerClient.Get(context.Background(), gardenerConfig.Name, v1.GetOptions{})
+	shoot, err := s.gardenerClient.Get(ctx, gardenerConfig.Name, v1.GetOptions{})
 	if err != nil {
 		return operations.StageResult{}, err
 	}
@@ -108,7 +108,7 @@ func TestWaitForClusterUpgrade(t *testing.T) {
 
 			waitForShootClusterUpgradeStep := NewWaitForShootUpgradeStep(gardenerClient, dbSession, kubeconfigProvider, model.FinishedStage, time.Minute)
 			// when
-			result, err := waitForShootClusterUpgradeStep.Run(cluster, model.Operation{}, logrus.New())
+			result, err := waitForShootClusterUpgradeStep.Run(context.Background(), cluster, model.Operation{}, logrus.New())
 
 			// then
 			require.NoError(t, err)
@@ -180,7 +180,7 @@ func TestWaitForClusterUpgrade(t *testing.T) {
 			waitForClusterCreationStep := NewWaitForShootUpgradeStep(gardenerClient, dbSession, kubeconfigProvider, model.FinishedStage, time.Minute)
 
 			// when
-			_, err := waitForClusterCreationStep.Run(testCase.cluster, model.Operation{}, logrus.New())
+			_, err := waitForClusterCreationStep.Run(context.Background(), testCase.cluster, model.Operation{}, logrus.New())
 
 			// then
 			require.Error(t, err)
@@ -1,6 +1,8 @@
 package operations
 
 import (
+	"context"
+	"math"
 	"time"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
@@ -16,8 +18,40 @@ type ProcessingResult struct {
 
 type Step interface {
 	Name() model.OperationStage
-	Run(cluster model.Cluster, operation model.Operation, logger logrus.FieldLogger) (StageResult, error)
+	Run(ctx context.Context, cluster model.Cluster, operation model.Operation, logger logrus.FieldLogger) (StageResult, error)
 	TimeLimit() time.Duration
+	RetryPolicy() RetryPolicy
+}
+
+// RetryPolicy decides when the step which failed with recoverable error is run again
+type RetryPolicy struct {
+	InitialDelay time.Duration
+	Multiplier   float64
+	MaxDelay     time.Duration
+	// MaxAttempts is the number of failed attempts after which the error is treated as non-recoverable, zero means that the step is retried until its time limit is reached
+	MaxAttempts int
+}
+
+// DefaultRetryPolicy is used by steps which only wait for Gardener, the operation fails after about half an hour of failing Gardener calls
+var DefaultRetryPolicy = RetryPolicy{
+	InitialDelay: 2 * time.Second,
+	Multiplier:   2,
+	MaxDelay:     2 * time.Minute,
+	MaxAttempts:  20,
+}
+
+// Delay returns the delay of the next attempt after the given number of failed attempts
+func (p RetryPolicy) Delay(failedAttempts int) time.Duration {
+	delay := float64(p.InitialDelay) * math.Pow(p.Multiplier, float64(failedAttempts-1))
+	if delay > float64(p.MaxDelay) {
+		return p.MaxDelay
+	}
+
+	return time.Duration(delay)
+}
+
+func (p RetryPolicy) Exhausted(failedAttempts int) bool {
+	return p.MaxAttempts > 0 && failedAttempts >= p.MaxAttempts
 }
 
 type StageResult struct {
@@ -41,6 +75,12 @@ type FailureHandler interface {
 	HandleFailure(operation model.Operation, cluster model.Cluster) error
 }
 
+// Fence makes sure that the replica still owns the operation before its stage or state is stored
+type Fence interface {
+	// Acquire returns false if the operation was taken over by other replica
+	Acquire(operationID string) (bool, error)
+}
+
 func ConvertToAppError(err error) apperrors.AppError {
 	if nonRecoverErr := (NonRecoverableError{}); errors.As(err, &nonRecoverErr) {
 		err = nonRecoverErr.error
@@ -2,12 +2,16 @@ package provisioning
 
 import (
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 )
 
 type GraphQLConverter interface {
 	RuntimeStatusToGraphQLStatus(status model.RuntimeStatus) *gqlschema.RuntimeStatus
 	OperationStatusToGQLOperationStatus(operation model.Operation) *gqlschema.OperationStatus
+	RuntimeStatusToGraphQLSummary(status model.RuntimeStatus) *gqlschema.RuntimeSummary
+	OperationToGraphQLHistoryEntry(operation model.Operation) *gqlschema.OperationHistoryEntry
+	DryRunResultToGQLOperationStatus(runtimeID string, operationType model.OperationType, result model.DryRunResult) *gqlschema.OperationStatus
 }
 
 func NewGraphQLConverter() GraphQLConverter {
@@ -21,6 +25,8 @@ func (c graphQLConverter) RuntimeStatusToGraphQLStatus(status model.RuntimeStatu
 		LastOperationStatus:     c.OperationStatusToGQLOperationStatus(status.LastOperationStatus),
 		RuntimeConnectionStatus: c.runtimeConnectionStatusToGraphQLStatus(status.RuntimeConnectionStatus),
 		RuntimeConfiguration:    c.clusterToToGraphQLRuntimeConfiguration(status.RuntimeConfiguration),
+		HibernationStatus:       c.hibernationStatusToGraphQLStatus(status.HibernationStatus),
+		Drift:                   c.driftsToGraphQLDrifts(status.Drift),
 	}
 }
 
@@ -37,6 +43,101 @@ func (c graphQLConverter) OperationStatusToGQLOperationStatus(operation model.Op
 			Reason:     operation.Reason,
 			Component:  operation.Component,
 		},
+		Attempts: operation.Attempts,
+	}
+}
+
+func (c graphQLConverter) DryRunResultToGQLOperationStatus(runtimeID string, operationType model.OperationType, result model.DryRunResult) *gqlschema.OperationStatus {
+	diff := make([]*gqlschema.ShootFieldDiff, 0, len(result.Diff))
+	for _, fieldDiff := range result.Diff {
+		diff = append(diff, &gqlschema.ShootFieldDiff{
+			Path:     fieldDiff.Path,
+			OldValue: fieldDiff.OldValue,
+			NewValue: fieldDiff.NewValue,
+		})
+	}
+
+	return &gqlschema.OperationStatus{
+		Operation: c.operationTypeToGraphQLType(operationType),
+		State:     gqlschema.OperationStateSucceeded,
+		Message:   util.PtrTo("Dry run finished, no changes were applied"),
+		RuntimeID: &runtimeID,
+		DryRunResult: &gqlschema.DryRunResult{
+			Shoot: result.Manifest,
+			Diff:  diff,
+		},
+	}
+}
+
+func (c graphQLConverter) RuntimeStatusToGraphQLSummary(status model.RuntimeStatus) *gqlschema.RuntimeSummary {
+	cluster := status.RuntimeConfiguration
+
+	return &gqlschema.RuntimeSummary{
+		ID:                  cluster.ID,
+		Tenant:              cluster.Tenant,
+		SubAccountID:        cluster.SubAccountId,
+		Name:                cluster.ClusterConfig.Name,
+		Provider:            cluster.ClusterConfig.Provider,
+		Region:              cluster.ClusterConfig.Region,
+		KubernetesVersion:   cluster.ClusterConfig.KubernetesVersion,
+		Deleted:             cluster.Deleted,
+		CreationTimestamp:   cluster.CreationTimestamp,
+		LastOperationStatus: c.OperationStatusToGQLOperationStatus(status.LastOperationStatus),
+	}
+}
+
+func (c graphQLConverter) OperationToGraphQLHistoryEntry(operation model.Operation) *gqlschema.OperationHistoryEntry {
+	return &gqlschema.OperationHistoryEntry{
+		ID:             operation.ID,
+		Operation:      c.operationTypeToGraphQLType(operation.Type),
+		State:          c.operationStateToGraphQLState(operation.State),
+		Stage:          string(operation.Stage),
+		Message:        &operation.Message,
+		StartTimestamp: operation.StartTimestamp,
+		EndTimestamp:   operation.EndTimestamp,
+		LastTransition: operation.LastTransition,
+		LastError: &gqlschema.LastError{
+			ErrMessage: operation.ErrMessage,
+			Reason:     operation.Reason,
+			Component:  operation.Component,
+		},
+	}
+}
+
+func (c graphQLConverter) hibernationStatusToGraphQLStatus(status model.HibernationStatus) *gqlschema.HibernationStatus {
+	return &gqlschema.HibernationStatus{
+		Hibernated:          &status.Hibernated,
+		HibernationPossible: &status.HibernationPossible,
+	}
+}
+
+func (c graphQLConverter) driftsToGraphQLDrifts(drifts []model.ShootDrift) []*gqlschema.ShootDrift {
+	if len(drifts) == 0 {
+		return nil
+	}
+
+	gqlDrifts := make([]*gqlschema.ShootDrift, 0, len(drifts))
+	for _, drift := range drifts {
+		gqlDrifts = append(gqlDrifts, &gqlschema.ShootDrift{
+			Field:         drift.Field,
+			ExpectedValue: drift.ExpectedValue,
+			ActualValue:   drift.ActualValue,
+			Policy:        c.driftPolicyToGraphQLPolicy(drift.Policy),
+			DetectedAt:    drift.DetectedAt,
+		})
+	}
+
+	return gqlDrifts
+}
+
+func (c graphQLConverter) driftPolicyToGraphQLPolicy(policy model.DriftPolicy) gqlschema.DriftPolicy {
+	switch policy {
+	case model.DriftPolicyRevert:
+		return gqlschema.DriftPolicyRevert
+	case model.DriftPolicyAdopt:
+		return gqlschema.DriftPolicyAdopt
+	default:
+		return gqlschema.DriftPolicyRecord
 	}
 }
 
@@ -105,7 +206,77 @@ func (c graphQLConverter) gardenerConfigToGraphQLConfig(config model.GardenerCon
 		ShootNetworkingFilterDisabled:       config.ShootNetworkingFilterDisabled,
 		ControlPlaneFailureTolerance:        config.ControlPlaneFailureTolerance,
 		EuAccess:                            &config.EuAccess,
+		NodeConfig:                          c.nodeConfigToGraphQLConfig(config.NodeConfig),
+		AdditionalWorkerPools:               c.workerPoolsToGraphQLWorkerPools(config.AdditionalWorkerPools),
+	}
+}
+
+func (c graphQLConverter) workerPoolsToGraphQLWorkerPools(pools []model.WorkerPool) []*gqlschema.WorkerPool {
+	if len(pools) == 0 {
+		return nil
+	}
+
+	gqlPools := make([]*gqlschema.WorkerPool, 0, len(pools))
+	for _, pool := range pools {
+		gqlPools = append(gqlPools, &gqlschema.WorkerPool{
+			Name:                pool.Name,
+			MachineType:         pool.MachineType,
+			MachineImage:        pool.MachineImage,
+			MachineImageVersion: pool.MachineImageVersion,
+			DiskType:            pool.DiskType,
+			VolumeSizeGb:        pool.VolumeSizeGB,
+			AutoScalerMin:       pool.AutoScalerMin,
+			AutoScalerMax:       pool.AutoScalerMax,
+			MaxSurge:            pool.MaxSurge,
+			MaxUnavailable:      pool.MaxUnavailable,
+			NodeConfig:          c.nodeConfigToGraphQLConfig(pool.NodeConfig),
+		})
+	}
+
+	return gqlPools
+}
+
+func (c graphQLConverter) nodeConfigToGraphQLConfig(config *model.NodeConfig) *gqlschema.NodeConfig {
+	if config == nil {
+		return nil
+	}
+
+	nodeConfig := &gqlschema.NodeConfig{
+		CriName: config.CRIName,
 	}
+
+	if len(config.Labels) != 0 {
+		nodeConfig.Labels = make(gqlschema.Labels, len(config.Labels))
+		for key, value := range config.Labels {
+			nodeConfig.Labels[key] = value
+		}
+	}
+
+	for _, taint := range config.Taints {
+		nodeConfig.Taints = append(nodeConfig.Taints, &gqlschema.Taint{
+			Key:    taint.Key,
+			Value:  util.PtrTo(taint.Value),
+			Effect: taint.Effect,
+		})
+	}
+
+	if config.KubeletConfig != nil {
+		nodeConfig.KubeletConfig = &gqlschema.KubeletConfig{
+			MaxPods:      config.KubeletConfig.MaxPods,
+			PodPidsLimit: config.KubeletConfig.PodPIDsLimit,
+		}
+		if eviction := config.KubeletConfig.EvictionHard; eviction != nil {
+			nodeConfig.KubeletConfig.EvictionHard = &gqlschema.KubeletEviction{
+				MemoryAvailable:   eviction.MemoryAvailable,
+				ImageFSAvailable:  eviction.ImageFSAvailable,
+				ImageFSInodesFree: eviction.ImageFSInodesFree,
+				NodeFSAvailable:   eviction.NodeFSAvailable,
+				NodeFSInodesFree:  eviction.NodeFSInodesFree,
+			}
+		}
+	}
+
+	return nodeConfig
 }
 
 func (c graphQLConverter) oidcConfigToGraphQLConfig(config *model.OIDCConfig) *gqlschema.OIDCConfig {
@@ -177,6 +348,8 @@ func (c graphQLConverter) operationTypeToGraphQLType(operationType model.Operati
 		return gqlschema.OperationTypeReconnectRuntime
 	case model.Hibernate:
 		return gqlschema.OperationTypeHibernate
+	case model.WakeUp:
+		return gqlschema.OperationTypeWakeUp
 	default:
 		return ""
 	}
@@ -190,6 +363,8 @@ func (c graphQLConverter) operationStateToGraphQLState(state model.OperationStat
 		return gqlschema.OperationStateSucceeded
 	case model.Failed:
 		return gqlschema.OperationStateFailed
+	case model.Cancelled:
+		return gqlschema.OperationStateCancelled
 	default:
 		return ""
 	}
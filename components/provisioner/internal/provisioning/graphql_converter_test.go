@@ -109,6 +109,10 @@ func TestRuntimeStatusToGraphQLStatus(t *testing.T) {
 				LastError: model.LastError{},
 			},
 			RuntimeConnectionStatus: model.RuntimeAgentConnectionStatusDisconnected,
+			HibernationStatus: model.HibernationStatus{
+				Hibernated:          true,
+				HibernationPossible: true,
+			},
 			RuntimeConfiguration: model.Cluster{
 				ClusterConfig: model.GardenerConfig{
 					Name:                                clusterName,
@@ -141,6 +145,8 @@ func TestRuntimeStatusToGraphQLStatus(t *testing.T) {
 					ShootNetworkingFilterDisabled:       &shootNetworkingFilterDisabled,
 					ControlPlaneFailureTolerance:        &controlPlaneFailureTolerance,
 					EuAccess:                            euAccess,
+					NodeConfig:                          fixNodeConfig(),
+					AdditionalWorkerPools:               []model.WorkerPool{fixWorkerPool("spot")},
 				},
 				Kubeconfig: &kubeconfig,
 				KymaConfig: fixKymaConfig(nil),
@@ -213,10 +219,36 @@ func TestRuntimeStatusToGraphQLStatus(t *testing.T) {
 					ShootNetworkingFilterDisabled: &shootNetworkingFilterDisabled,
 					ControlPlaneFailureTolerance:  &controlPlaneFailureTolerance,
 					EuAccess:                      &euAccess,
+					NodeConfig: &gqlschema.NodeConfig{
+						Labels:  gqlschema.Labels{"workload": "memory"},
+						Taints:  []*gqlschema.Taint{{Key: "dedicated", Value: util.PtrTo("memory"), Effect: "NoSchedule"}},
+						CriName: util.PtrTo("containerd"),
+						KubeletConfig: &gqlschema.KubeletConfig{
+							MaxPods:      util.PtrTo(200),
+							PodPidsLimit: util.PtrTo(4096),
+							EvictionHard: &gqlschema.KubeletEviction{MemoryAvailable: util.PtrTo("200Mi")},
+						},
+					},
+					AdditionalWorkerPools: []*gqlschema.WorkerPool{
+						{
+							Name:           "spot",
+							MachineType:    "m5.4xlarge",
+							DiskType:       util.PtrTo("gp3"),
+							VolumeSizeGb:   util.PtrTo(100),
+							AutoScalerMin:  1,
+							AutoScalerMax:  5,
+							MaxSurge:       1,
+							MaxUnavailable: 0,
+						},
+					},
 				},
 				KymaConfig: fixKymaGraphQLConfig(nil),
 				Kubeconfig: &kubeconfig,
 			},
+			HibernationStatus: &gqlschema.HibernationStatus{
+				Hibernated:          util.PtrTo(true),
+				HibernationPossible: util.PtrTo(true),
+			},
 		}
 
 		//when
@@ -365,6 +397,10 @@ func TestRuntimeStatusToGraphQLStatus(t *testing.T) {
 				},
 				Kubeconfig: &kubeconfig,
 			},
+			HibernationStatus: &gqlschema.HibernationStatus{
+				Hibernated:          util.PtrTo(false),
+				HibernationPossible: util.PtrTo(false),
+			},
 		}
 
 		//when
@@ -506,6 +542,10 @@ func TestRuntimeStatusToGraphQLStatus(t *testing.T) {
 				KymaConfig: fixKymaGraphQLConfig(&gqlProductionProfile),
 				Kubeconfig: &kubeconfig,
 			},
+			HibernationStatus: &gqlschema.HibernationStatus{
+				Hibernated:          util.PtrTo(false),
+				HibernationPossible: util.PtrTo(false),
+			},
 		}
 
 		//when
@@ -0,0 +1,36 @@
+package provisioning
+
+import (
+	"context"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+)
+
+type idempotencyKeyContextKey struct{}
+
+type idempotencyKey struct {
+	tenant string
+	key    string
+}
+
+// WithIdempotencyKey returns context of the request which reserved the idempotency key,
+// the operation started by the request is linked to the key within the transaction which stores the operation
+func WithIdempotencyKey(ctx context.Context, tenant, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, idempotencyKey{tenant: tenant, key: key})
+}
+
+// linkIdempotencyKey stores the operation with the key reserved by the request, nothing is stored for requests without the key
+func linkIdempotencyKey(ctx context.Context, session dbsession.WriteSession, operationID string) dberrors.Error {
+	reserved, ok := ctx.Value(idempotencyKeyContextKey{}).(idempotencyKey)
+	if !ok {
+		return nil
+	}
+
+	dberr := session.SetIdempotencyKeyOperation(reserved.tenant, reserved.key, operationID)
+	if dberr != nil {
+		return dberr.Append("Failed to store operation %s for idempotency key %s", operationID, reserved.key)
+	}
+
+	return nil
+}
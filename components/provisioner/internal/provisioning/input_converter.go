@@ -1,6 +1,7 @@
 package provisioning
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
@@ -22,6 +23,8 @@ type InputConverter interface {
 	ProvisioningInputToCluster(runtimeID string, input gqlschema.ProvisionRuntimeInput, tenant, subAccountId string) (model.Cluster, apperrors.AppError)
 	KymaConfigFromInput(runtimeID string, input gqlschema.KymaConfigInput) (model.KymaConfig, apperrors.AppError)
 	UpgradeShootInputToGardenerConfig(input gqlschema.GardenerUpgradeInput, existing model.GardenerConfig) (model.GardenerConfig, apperrors.AppError)
+	RuntimeFilterInputToFilter(input *gqlschema.RuntimeFilterInput) (model.RuntimeFilter, apperrors.AppError)
+	OperationFilterFromInput(types []gqlschema.OperationType, states []gqlschema.OperationState) (model.OperationFilter, apperrors.AppError)
 }
 
 func NewInputConverter(
@@ -134,9 +137,74 @@ func (c converter) gardenerConfigFromInput(runtimeID string, input *gqlschema.Ga
 		ShootNetworkingFilterDisabled:       input.ShootNetworkingFilterDisabled,
 		ControlPlaneFailureTolerance:        input.ControlPlaneFailureTolerance,
 		EuAccess:                            util.UnwrapOrDefault(input.EuAccess, c.defaultEuAccess),
+		NodeConfig:                          nodeConfigFromInput(input.NodeConfig),
+		AdditionalWorkerPools:               workerPoolsFromInput(input.AdditionalWorkerPools),
 	}, nil
 }
 
+func workerPoolsFromInput(input []*gqlschema.WorkerPoolInput) []model.WorkerPool {
+	var pools []model.WorkerPool
+	for _, pool := range input {
+		pools = append(pools, model.WorkerPool{
+			Name:                pool.Name,
+			MachineType:         pool.MachineType,
+			MachineImage:        pool.MachineImage,
+			MachineImageVersion: pool.MachineImageVersion,
+			DiskType:            pool.DiskType,
+			VolumeSizeGB:        pool.VolumeSizeGb,
+			AutoScalerMin:       pool.AutoScalerMin,
+			AutoScalerMax:       pool.AutoScalerMax,
+			MaxSurge:            pool.MaxSurge,
+			MaxUnavailable:      pool.MaxUnavailable,
+			NodeConfig:          nodeConfigFromInput(pool.NodeConfig),
+		})
+	}
+	return pools
+}
+
+func nodeConfigFromInput(input *gqlschema.NodeConfigInput) *model.NodeConfig {
+	if input == nil {
+		return nil
+	}
+
+	config := model.NodeConfig{
+		CRIName: input.CriName,
+	}
+
+	if len(input.Labels) != 0 {
+		config.Labels = make(map[string]string, len(input.Labels))
+		for key, value := range input.Labels {
+			config.Labels[key] = fmt.Sprint(value)
+		}
+	}
+
+	for _, taint := range input.Taints {
+		config.Taints = append(config.Taints, model.Taint{
+			Key:    taint.Key,
+			Value:  util.UnwrapOrZero(taint.Value),
+			Effect: taint.Effect,
+		})
+	}
+
+	if input.KubeletConfig != nil {
+		config.KubeletConfig = &model.KubeletConfig{
+			MaxPods:      input.KubeletConfig.MaxPods,
+			PodPIDsLimit: input.KubeletConfig.PodPidsLimit,
+		}
+		if eviction := input.KubeletConfig.EvictionHard; eviction != nil {
+			config.KubeletConfig.EvictionHard = &model.KubeletEviction{
+				MemoryAvailable:   eviction.MemoryAvailable,
+				ImageFSAvailable:  eviction.ImageFSAvailable,
+				ImageFSInodesFree: eviction.ImageFSInodesFree,
+				NodeFSAvailable:   eviction.NodeFSAvailable,
+				NodeFSInodesFree:  eviction.NodeFSInodesFree,
+			}
+		}
+	}
+
+	return &config
+}
+
 func oidcConfigFromInput(config *gqlschema.OIDCConfigInput) *model.OIDCConfig {
 	if config != nil {
 		return &model.OIDCConfig{
@@ -188,6 +256,16 @@ func (c converter) UpgradeShootInputToGardenerConfig(input gqlschema.GardenerUpg
 		providerSpecificConfig = config.GardenerProviderConfig
 	}
 
+	nodeConfig := config.NodeConfig
+	if input.NodeConfig != nil {
+		nodeConfig = nodeConfigFromInput(input.NodeConfig)
+	}
+
+	additionalWorkerPools := config.AdditionalWorkerPools
+	if input.AdditionalWorkerPools != nil {
+		additionalWorkerPools = workerPoolsFromInput(input.AdditionalWorkerPools)
+	}
+
 	return model.GardenerConfig{
 		ID:           config.ID,
 		ClusterID:    config.ClusterID,
@@ -217,6 +295,8 @@ func (c converter) UpgradeShootInputToGardenerConfig(input gqlschema.GardenerUpg
 		OIDCConfig:                          oidcConfigFromInput(input.OidcConfig),
 		ExposureClassName:                   util.OkOrDefault(input.ExposureClassName, config.ExposureClassName),
 		ShootNetworkingFilterDisabled:       util.OkOrDefault(input.ShootNetworkingFilterDisabled, config.ShootNetworkingFilterDisabled),
+		NodeConfig:                          nodeConfig,
+		AdditionalWorkerPools:               additionalWorkerPools,
 	}, nil
 }
 
@@ -246,6 +326,9 @@ func (c converter) providerSpecificConfigFromInput(input *gqlschema.ProviderSpec
 		}
 		return model.NewOpenStackGardenerConfig(input.OpenStackConfig)
 	}
+	if input.AlicloudConfig != nil {
+		return model.NewAlicloudGardenerConfig(input.AlicloudConfig)
+	}
 
 	return nil, apperrors.BadRequest("provider config not specified")
 }
@@ -279,6 +362,92 @@ func (c converter) KymaConfigFromInput(runtimeID string, input gqlschema.KymaCon
 	}, nil
 }
 
+func (c converter) RuntimeFilterInputToFilter(input *gqlschema.RuntimeFilterInput) (model.RuntimeFilter, apperrors.AppError) {
+	if input == nil {
+		return model.RuntimeFilter{}, nil
+	}
+
+	filter := model.RuntimeFilter{
+		Tenant:            input.Tenant,
+		SubAccountID:      input.SubAccountID,
+		Provider:          input.Provider,
+		Region:            input.Region,
+		KubernetesVersion: input.KubernetesVersion,
+		Deleted:           input.Deleted,
+	}
+
+	if input.LastOperationState != nil {
+		state, err := c.graphQLStateToOperationState(*input.LastOperationState)
+		if err != nil {
+			return model.RuntimeFilter{}, err
+		}
+		filter.LastOperationState = &state
+	}
+
+	return filter, nil
+}
+
+func (c converter) OperationFilterFromInput(types []gqlschema.OperationType, states []gqlschema.OperationState) (model.OperationFilter, apperrors.AppError) {
+	var filter model.OperationFilter
+
+	for _, operationType := range types {
+		modelTypes, err := c.graphQLTypeToOperationTypes(operationType)
+		if err != nil {
+			return model.OperationFilter{}, err
+		}
+		filter.Types = append(filter.Types, modelTypes...)
+	}
+
+	for _, state := range states {
+		modelState, err := c.graphQLStateToOperationState(state)
+		if err != nil {
+			return model.OperationFilter{}, err
+		}
+		filter.States = append(filter.States, modelState)
+	}
+
+	return filter, nil
+}
+
+// graphQLTypeToOperationTypes returns all operation types which are exposed as the given GraphQL type
+func (c converter) graphQLTypeToOperationTypes(operationType gqlschema.OperationType) ([]model.OperationType, apperrors.AppError) {
+	switch operationType {
+	case gqlschema.OperationTypeProvision:
+		return []model.OperationType{model.Provision, model.ProvisionNoInstall}, nil
+	case gqlschema.OperationTypeDeprovision:
+		return []model.OperationType{model.Deprovision}, nil
+	case gqlschema.OperationTypeDeprovisionNoInstall:
+		return []model.OperationType{model.DeprovisionNoInstall}, nil
+	case gqlschema.OperationTypeUpgrade:
+		return []model.OperationType{model.Upgrade}, nil
+	case gqlschema.OperationTypeUpgradeShoot:
+		return []model.OperationType{model.UpgradeShoot}, nil
+	case gqlschema.OperationTypeReconnectRuntime:
+		return []model.OperationType{model.ReconnectRuntime}, nil
+	case gqlschema.OperationTypeHibernate:
+		return []model.OperationType{model.Hibernate}, nil
+	case gqlschema.OperationTypeWakeUp:
+		return []model.OperationType{model.WakeUp}, nil
+	default:
+		return nil, apperrors.BadRequest("operation type %s is not supported", operationType)
+	}
+}
+
+func (c converter) graphQLStateToOperationState(state gqlschema.OperationState) (model.OperationState, apperrors.AppError) {
+	switch state {
+	case gqlschema.OperationStateInProgress:
+		return model.InProgress, nil
+	case gqlschema.OperationStateSucceeded:
+		return model.Succeeded, nil
+	case gqlschema.OperationStateFailed:
+		return model.Failed, nil
+	case gqlschema.OperationStateCancelled:
+		return model.Cancelled, nil
+	default:
+		return "", apperrors.BadRequest("operation state %s is not supported", state)
+	}
+}
+
 func (c converter) graphQLProfileToProfile(profile *gqlschema.KymaProfile) *model.KymaProfile {
 	if profile == nil {
 		return nil
@@ -299,6 +468,21 @@ func (c converter) graphQLProfileToProfile(profile *gqlschema.KymaProfile) *mode
 
 }
 
+func priorityFromInput(priority *gqlschema.OperationPriority) model.OperationPriority {
+	if priority == nil {
+		return model.NormalPriority
+	}
+
+	switch *priority {
+	case gqlschema.OperationPriorityLow:
+		return model.LowPriority
+	case gqlschema.OperationPriorityHigh:
+		return model.HighPriority
+	default:
+		return model.NormalPriority
+	}
+}
+
 func (c converter) configurationFromInput(input []*gqlschema.ConfigEntryInput, conflict *gqlschema.ConflictStrategy) model.Configuration {
 	configuration := model.Configuration{
 		ConfigEntries: make([]model.ConfigEntry, 0, len(input)),
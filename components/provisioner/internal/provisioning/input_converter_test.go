@@ -71,6 +71,8 @@ func Test_ProvisioningInputToCluster(t *testing.T) {
 				ShootNetworkingFilterDisabled: util.PtrTo(true),
 				ControlPlaneFailureTolerance:  util.PtrTo("zone"),
 				EuAccess:                      util.PtrTo(true),
+				NodeConfig:                    fixNodeConfigInput(),
+				AdditionalWorkerPools:         []*gqlschema.WorkerPoolInput{fixWorkerPoolInput("spot")},
 			},
 			Administrators: []string{administrator},
 		},
@@ -114,6 +116,8 @@ func Test_ProvisioningInputToCluster(t *testing.T) {
 			ShootNetworkingFilterDisabled:       util.PtrTo(true),
 			ControlPlaneFailureTolerance:        util.PtrTo("zone"),
 			EuAccess:                            true,
+			NodeConfig:                          fixNodeConfig(),
+			AdditionalWorkerPools:               []model.WorkerPool{fixWorkerPool("spot")},
 		},
 		Kubeconfig:     nil,
 		KymaConfig:     fixKymaConfig(&modelProductionProfile),
@@ -406,6 +410,100 @@ func Test_ProvisioningInputToCluster(t *testing.T) {
 		Administrators: []string{administrator},
 	}
 
+	alicloudGardenerProvider := &gqlschema.AlicloudProviderConfigInput{
+		AlicloudZones: []*gqlschema.AlicloudZoneInput{
+			{
+				Name:       "eu-central-1a",
+				WorkerCidr: "10.250.0.0/19",
+			},
+		},
+		VpcCidr: "10.250.0.0/16",
+	}
+
+	gardenerAlicloudGQLInput := gqlschema.ProvisionRuntimeInput{
+		RuntimeInput: &gqlschema.RuntimeInput{
+			Name:        "runtimeName",
+			Description: nil,
+			Labels:      gqlschema.Labels{},
+		},
+		ClusterConfig: &gqlschema.ClusterConfigInput{
+			GardenerConfig: &gqlschema.GardenerConfigInput{
+				Name:                              "verylon",
+				KubernetesVersion:                 "1.20.7",
+				VolumeSizeGb:                      util.PtrTo(50),
+				MachineType:                       "ecs.g7.xlarge",
+				MachineImage:                      util.PtrTo("gardenlinux"),
+				MachineImageVersion:               util.PtrTo("25.0.0"),
+				Region:                            "eu-central-1",
+				Provider:                          "Alicloud",
+				Purpose:                           util.PtrTo("testing"),
+				Seed:                              util.PtrTo("ali-eu1"),
+				TargetSecret:                      "secret",
+				DiskType:                          util.PtrTo("cloud_essd"),
+				WorkerCidr:                        "10.250.0.0/16",
+				PodsCidr:                          util.PtrTo("10.64.0.0/11"),
+				ServicesCidr:                      util.PtrTo("10.243.0.0/16"),
+				AutoScalerMin:                     1,
+				AutoScalerMax:                     5,
+				MaxSurge:                          1,
+				MaxUnavailable:                    2,
+				EnableKubernetesVersionAutoUpdate: util.PtrTo(true),
+				ProviderSpecificConfig: &gqlschema.ProviderSpecificInput{
+					AlicloudConfig: alicloudGardenerProvider,
+				},
+				OidcConfig:                    oidcInput(),
+				DNSConfig:                     dnsInput(),
+				ShootNetworkingFilterDisabled: util.PtrTo(false),
+				EuAccess:                      nil,
+			},
+			Administrators: []string{administrator},
+		},
+		KymaConfig: fixKymaGraphQLConfigInput(&gqlEvaluationProfile),
+	}
+
+	expectedAlicloudProviderCfg, err := model.NewAlicloudGardenerConfig(alicloudGardenerProvider)
+	require.NoError(t, err)
+
+	expectedGardenerAlicloudRuntimeConfig := model.Cluster{
+		ID: "runtimeID",
+		ClusterConfig: model.GardenerConfig{
+			ID:                                  "id",
+			Name:                                "verylon",
+			ProjectName:                         gardenerProject,
+			MachineType:                         "ecs.g7.xlarge",
+			MachineImage:                        util.PtrTo("gardenlinux"),
+			MachineImageVersion:                 util.PtrTo("25.0.0"),
+			Region:                              "eu-central-1",
+			KubernetesVersion:                   "1.20.7",
+			VolumeSizeGB:                        util.PtrTo(50),
+			DiskType:                            util.PtrTo("cloud_essd"),
+			Provider:                            "Alicloud",
+			Purpose:                             util.PtrTo("testing"),
+			Seed:                                "ali-eu1",
+			TargetSecret:                        "secret",
+			WorkerCidr:                          "10.250.0.0/16",
+			PodsCIDR:                            util.PtrTo("10.64.0.0/11"),
+			ServicesCIDR:                        util.PtrTo("10.243.0.0/16"),
+			AutoScalerMin:                       1,
+			AutoScalerMax:                       5,
+			MaxSurge:                            1,
+			MaxUnavailable:                      2,
+			ClusterID:                           "runtimeID",
+			EnableKubernetesVersionAutoUpdate:   true,
+			EnableMachineImageVersionAutoUpdate: false,
+			GardenerProviderConfig:              expectedAlicloudProviderCfg,
+			OIDCConfig:                          oidcConfig(),
+			DNSConfig:                           dnsConfig(),
+			ShootNetworkingFilterDisabled:       util.PtrTo(false),
+			EuAccess:                            false,
+		},
+		Kubeconfig:     nil,
+		KymaConfig:     fixKymaConfig(&modelEvaluationProfile),
+		Tenant:         tenant,
+		SubAccountId:   util.PtrTo(subAccountId),
+		Administrators: []string{administrator},
+	}
+
 	gardenerZones := []string{"fix-az-zone-1", "fix-az-zone-2"}
 
 	configurations := []struct {
@@ -438,6 +536,11 @@ func Test_ProvisioningInputToCluster(t *testing.T) {
 			expected:    expectedGardenerOpenStackRuntimeConfig,
 			description: "Should create proper runtime config struct with Gardener input for OpenStack provider",
 		},
+		{
+			input:       gardenerAlicloudGQLInput,
+			expected:    expectedGardenerAlicloudRuntimeConfig,
+			description: "Should create proper runtime config struct with Gardener input for Alicloud provider",
+		},
 	}
 
 	for _, testCase := range configurations {
@@ -819,8 +922,12 @@ func Test_UpgradeShootInputToGardenerConfig(t *testing.T) {
 				OIDCConfig:                    oidcConfig(),
 				ExposureClassName:             util.PtrTo("internet"),
 				ShootNetworkingFilterDisabled: util.PtrTo(false),
+				NodeConfig:                    fixNodeConfig(),
+				AdditionalWorkerPools:         []model.WorkerPool{fixWorkerPool("spot")},
 			},
 			upgradedConfig: model.GardenerConfig{
+				NodeConfig:                    fixNodeConfig(),
+				AdditionalWorkerPools:         []model.WorkerPool{fixWorkerPool("spot")},
 				KubernetesVersion:             "1.20.7",
 				VolumeSizeGB:                  util.PtrTo(1),
 				DiskType:                      util.PtrTo("ssd"),
@@ -837,6 +944,74 @@ func Test_UpgradeShootInputToGardenerConfig(t *testing.T) {
 				ShootNetworkingFilterDisabled: util.PtrTo(false),
 			},
 		},
+		{
+			description: "shoot upgrade replacing worker pools",
+			upgradeInput: func() gqlschema.UpgradeShootInput {
+				input := newUpgradeShootInputWithNilValues()
+				input.GardenerConfig.AdditionalWorkerPools = []*gqlschema.WorkerPoolInput{fixWorkerPoolInput("high-memory")}
+				return input
+			}(),
+			initialConfig: model.GardenerConfig{
+				KubernetesVersion:     "1.20.7",
+				MachineType:           "1",
+				AutoScalerMin:         1,
+				AutoScalerMax:         2,
+				AdditionalWorkerPools: []model.WorkerPool{fixWorkerPool("spot")},
+			},
+			upgradedConfig: model.GardenerConfig{
+				KubernetesVersion:     "1.20.7",
+				MachineType:           "1",
+				AutoScalerMin:         1,
+				AutoScalerMax:         2,
+				OIDCConfig:            upgradedOidcConfig(),
+				AdditionalWorkerPools: []model.WorkerPool{fixWorkerPool("high-memory")},
+			},
+		},
+		{
+			description: "shoot upgrade replacing node config",
+			upgradeInput: func() gqlschema.UpgradeShootInput {
+				input := newUpgradeShootInputWithNilValues()
+				input.GardenerConfig.NodeConfig = &gqlschema.NodeConfigInput{Labels: gqlschema.Labels{"workload": "batch"}}
+				return input
+			}(),
+			initialConfig: model.GardenerConfig{
+				KubernetesVersion: "1.20.7",
+				MachineType:       "1",
+				AutoScalerMin:     1,
+				AutoScalerMax:     2,
+				NodeConfig:        fixNodeConfig(),
+			},
+			upgradedConfig: model.GardenerConfig{
+				KubernetesVersion: "1.20.7",
+				MachineType:       "1",
+				AutoScalerMin:     1,
+				AutoScalerMax:     2,
+				OIDCConfig:        upgradedOidcConfig(),
+				NodeConfig:        &model.NodeConfig{Labels: map[string]string{"workload": "batch"}},
+			},
+		},
+		{
+			description: "shoot upgrade removing all worker pools",
+			upgradeInput: func() gqlschema.UpgradeShootInput {
+				input := newUpgradeShootInputWithNilValues()
+				input.GardenerConfig.AdditionalWorkerPools = []*gqlschema.WorkerPoolInput{}
+				return input
+			}(),
+			initialConfig: model.GardenerConfig{
+				KubernetesVersion:     "1.20.7",
+				MachineType:           "1",
+				AutoScalerMin:         1,
+				AutoScalerMax:         2,
+				AdditionalWorkerPools: []model.WorkerPool{fixWorkerPool("spot")},
+			},
+			upgradedConfig: model.GardenerConfig{
+				KubernetesVersion: "1.20.7",
+				MachineType:       "1",
+				AutoScalerMin:     1,
+				AutoScalerMax:     2,
+				OIDCConfig:        upgradedOidcConfig(),
+			},
+		},
 	}
 
 	casesWithErrors := []struct {
@@ -1005,6 +1180,58 @@ func newUpgradeShootInputWithoutProviderConfig(newPurpose string) gqlschema.Upgr
 	return input
 }
 
+func fixWorkerPoolInput(name string) *gqlschema.WorkerPoolInput {
+	return &gqlschema.WorkerPoolInput{
+		Name:           name,
+		MachineType:    "m5.4xlarge",
+		DiskType:       util.PtrTo("gp3"),
+		VolumeSizeGb:   util.PtrTo(100),
+		AutoScalerMin:  1,
+		AutoScalerMax:  5,
+		MaxSurge:       1,
+		MaxUnavailable: 0,
+	}
+}
+
+func fixNodeConfigInput() *gqlschema.NodeConfigInput {
+	return &gqlschema.NodeConfigInput{
+		Labels:  gqlschema.Labels{"workload": "memory"},
+		Taints:  []*gqlschema.TaintInput{{Key: "dedicated", Value: util.PtrTo("memory"), Effect: "NoSchedule"}},
+		CriName: util.PtrTo("containerd"),
+		KubeletConfig: &gqlschema.KubeletConfigInput{
+			MaxPods:      util.PtrTo(200),
+			PodPidsLimit: util.PtrTo(4096),
+			EvictionHard: &gqlschema.KubeletEvictionInput{MemoryAvailable: util.PtrTo("200Mi")},
+		},
+	}
+}
+
+func fixNodeConfig() *model.NodeConfig {
+	return &model.NodeConfig{
+		CRIName: util.PtrTo("containerd"),
+		KubeletConfig: &model.KubeletConfig{
+			EvictionHard: &model.KubeletEviction{MemoryAvailable: util.PtrTo("200Mi")},
+			MaxPods:      util.PtrTo(200),
+			PodPIDsLimit: util.PtrTo(4096),
+		},
+		Labels: map[string]string{"workload": "memory"},
+		Taints: []model.Taint{{Key: "dedicated", Value: "memory", Effect: "NoSchedule"}},
+	}
+}
+
+func fixWorkerPool(name string) model.WorkerPool {
+	return model.WorkerPool{
+		Name:           name,
+		MachineType:    "m5.4xlarge",
+		DiskType:       util.PtrTo("gp3"),
+		VolumeSizeGB:   util.PtrTo(100),
+		AutoScalerMin:  1,
+		AutoScalerMax:  5,
+		MaxSurge:       1,
+		MaxUnavailable: 0,
+	}
+}
+
 func fixKymaGraphQLConfigInput(profile *gqlschema.KymaProfile) *gqlschema.KymaConfigInput {
 	return &gqlschema.KymaConfigInput{
 		Version: kymaVersion,
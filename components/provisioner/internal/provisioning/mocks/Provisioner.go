@@ -4,6 +4,9 @@ package mocks
 
 import (
 	apperrors "github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+
+	context "context"
+
 	mock "github.com/stretchr/testify/mock"
 
 	model "github.com/kyma-project/control-plane/components/provisioner/internal/model"
@@ -14,23 +17,75 @@ type Provisioner struct {
 	mock.Mock
 }
 
-// DeprovisionCluster provides a mock function with given fields: cluster, operationId
-func (_m *Provisioner) DeprovisionCluster(cluster model.Cluster, operationId string) (model.Operation, apperrors.AppError) {
-	ret := _m.Called(cluster, operationId)
+// DeprovisionCluster provides a mock function with given fields: ctx, cluster, operationId
+func (_m *Provisioner) DeprovisionCluster(ctx context.Context, cluster model.Cluster, operationId string) (model.Operation, apperrors.AppError) {
+	ret := _m.Called(ctx, cluster, operationId)
 
 	var r0 model.Operation
 	var r1 apperrors.AppError
-	if rf, ok := ret.Get(0).(func(model.Cluster, string) (model.Operation, apperrors.AppError)); ok {
-		return rf(cluster, operationId)
+	if rf, ok := ret.Get(0).(func(context.Context, model.Cluster, string) (model.Operation, apperrors.AppError)); ok {
+		return rf(ctx, cluster, operationId)
 	}
-	if rf, ok := ret.Get(0).(func(model.Cluster, string) model.Operation); ok {
-		r0 = rf(cluster, operationId)
+	if rf, ok := ret.Get(0).(func(context.Context, model.Cluster, string) model.Operation); ok {
+		r0 = rf(ctx, cluster, operationId)
 	} else {
 		r0 = ret.Get(0).(model.Operation)
 	}
 
-	if rf, ok := ret.Get(1).(func(model.Cluster, string) apperrors.AppError); ok {
-		r1 = rf(cluster, operationId)
+	if rf, ok := ret.Get(1).(func(context.Context, model.Cluster, string) apperrors.AppError); ok {
+		r1 = rf(ctx, cluster, operationId)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// DryRunProvisionCluster provides a mock function with given fields: ctx, cluster
+func (_m *Provisioner) DryRunProvisionCluster(ctx context.Context, cluster model.Cluster) (model.DryRunResult, apperrors.AppError) {
+	ret := _m.Called(ctx, cluster)
+
+	var r0 model.DryRunResult
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(context.Context, model.Cluster) (model.DryRunResult, apperrors.AppError)); ok {
+		return rf(ctx, cluster)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Cluster) model.DryRunResult); ok {
+		r0 = rf(ctx, cluster)
+	} else {
+		r0 = ret.Get(0).(model.DryRunResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Cluster) apperrors.AppError); ok {
+		r1 = rf(ctx, cluster)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// DryRunUpgradeCluster provides a mock function with given fields: ctx, clusterID, upgradeConfig
+func (_m *Provisioner) DryRunUpgradeCluster(ctx context.Context, clusterID string, upgradeConfig model.GardenerConfig) (model.DryRunResult, apperrors.AppError) {
+	ret := _m.Called(ctx, clusterID, upgradeConfig)
+
+	var r0 model.DryRunResult
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.GardenerConfig) (model.DryRunResult, apperrors.AppError)); ok {
+		return rf(ctx, clusterID, upgradeConfig)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.GardenerConfig) model.DryRunResult); ok {
+		r0 = rf(ctx, clusterID, upgradeConfig)
+	} else {
+		r0 = ret.Get(0).(model.DryRunResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.GardenerConfig) apperrors.AppError); ok {
+		r1 = rf(ctx, clusterID, upgradeConfig)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(apperrors.AppError)
@@ -40,13 +95,45 @@ func (_m *Provisioner) DeprovisionCluster(cluster model.Cluster, operationId str
 	return r0, r1
 }
 
-// ProvisionCluster provides a mock function with given fields: cluster, operationId
-func (_m *Provisioner) ProvisionCluster(cluster model.Cluster, operationId string) apperrors.AppError {
-	ret := _m.Called(cluster, operationId)
+// HibernateCluster provides a mock function with given fields: ctx, clusterID, gardenerConfig
+func (_m *Provisioner) HibernateCluster(ctx context.Context, clusterID string, gardenerConfig model.GardenerConfig) apperrors.AppError {
+	ret := _m.Called(ctx, clusterID, gardenerConfig)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.GardenerConfig) apperrors.AppError); ok {
+		r0 = rf(ctx, clusterID, gardenerConfig)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// ProvisionCluster provides a mock function with given fields: ctx, cluster, operationId
+func (_m *Provisioner) ProvisionCluster(ctx context.Context, cluster model.Cluster, operationId string) apperrors.AppError {
+	ret := _m.Called(ctx, cluster, operationId)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(context.Context, model.Cluster, string) apperrors.AppError); ok {
+		r0 = rf(ctx, cluster, operationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// UpgradeCluster provides a mock function with given fields: ctx, clusterID, upgradeConfig
+func (_m *Provisioner) UpgradeCluster(ctx context.Context, clusterID string, upgradeConfig model.GardenerConfig) apperrors.AppError {
+	ret := _m.Called(ctx, clusterID, upgradeConfig)
 
 	var r0 apperrors.AppError
-	if rf, ok := ret.Get(0).(func(model.Cluster, string) apperrors.AppError); ok {
-		r0 = rf(cluster, operationId)
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.GardenerConfig) apperrors.AppError); ok {
+		r0 = rf(ctx, clusterID, upgradeConfig)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(apperrors.AppError)
@@ -56,13 +143,13 @@ func (_m *Provisioner) ProvisionCluster(cluster model.Cluster, operationId strin
 	return r0
 }
 
-// UpgradeCluster provides a mock function with given fields: clusterID, upgradeConfig
-func (_m *Provisioner) UpgradeCluster(clusterID string, upgradeConfig model.GardenerConfig) apperrors.AppError {
-	ret := _m.Called(clusterID, upgradeConfig)
+// WakeUpCluster provides a mock function with given fields: ctx, clusterID, gardenerConfig
+func (_m *Provisioner) WakeUpCluster(ctx context.Context, clusterID string, gardenerConfig model.GardenerConfig) apperrors.AppError {
+	ret := _m.Called(ctx, clusterID, gardenerConfig)
 
 	var r0 apperrors.AppError
-	if rf, ok := ret.Get(0).(func(string, model.GardenerConfig) apperrors.AppError); ok {
-		r0 = rf(clusterID, upgradeConfig)
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.GardenerConfig) apperrors.AppError); ok {
+		r0 = rf(ctx, clusterID, gardenerConfig)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(apperrors.AppError)
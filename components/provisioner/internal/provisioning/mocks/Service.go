@@ -4,6 +4,9 @@ package mocks
 
 import (
 	apperrors "github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+
+	context "context"
+
 	gqlschema "github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 
 	mock "github.com/stretchr/testify/mock"
@@ -14,23 +17,163 @@ type Service struct {
 	mock.Mock
 }
 
-// DeprovisionRuntime provides a mock function with given fields: id
-func (_m *Service) DeprovisionRuntime(id string) (string, apperrors.AppError) {
-	ret := _m.Called(id)
+// CancelOperation provides a mock function with given fields: ctx, id
+func (_m *Service) CancelOperation(ctx context.Context, id string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *gqlschema.OperationStatus
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*gqlschema.OperationStatus, apperrors.AppError)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *gqlschema.OperationStatus); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gqlschema.OperationStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) apperrors.AppError); ok {
+		r1 = rf(ctx, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeprovisionRuntime provides a mock function with given fields: ctx, id, priority
+func (_m *Service) DeprovisionRuntime(ctx context.Context, id string, priority *gqlschema.OperationPriority) (string, apperrors.AppError) {
+	ret := _m.Called(ctx, id, priority)
 
 	var r0 string
 	var r1 apperrors.AppError
-	if rf, ok := ret.Get(0).(func(string) (string, apperrors.AppError)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *gqlschema.OperationPriority) (string, apperrors.AppError)); ok {
+		return rf(ctx, id, priority)
 	}
-	if rf, ok := ret.Get(0).(func(string) string); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *gqlschema.OperationPriority) string); ok {
+		r0 = rf(ctx, id, priority)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, string, *gqlschema.OperationPriority) apperrors.AppError); ok {
+		r1 = rf(ctx, id, priority)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// DryRunProvisionRuntime provides a mock function with given fields: ctx, config, tenant, subAccount
+func (_m *Service) DryRunProvisionRuntime(ctx context.Context, config gqlschema.ProvisionRuntimeInput, tenant string, subAccount string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	ret := _m.Called(ctx, config, tenant, subAccount)
+
+	var r0 *gqlschema.OperationStatus
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(context.Context, gqlschema.ProvisionRuntimeInput, string, string) (*gqlschema.OperationStatus, apperrors.AppError)); ok {
+		return rf(ctx, config, tenant, subAccount)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gqlschema.ProvisionRuntimeInput, string, string) *gqlschema.OperationStatus); ok {
+		r0 = rf(ctx, config, tenant, subAccount)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gqlschema.OperationStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gqlschema.ProvisionRuntimeInput, string, string) apperrors.AppError); ok {
+		r1 = rf(ctx, config, tenant, subAccount)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// DryRunUpgradeGardenerShoot provides a mock function with given fields: ctx, id, input
+func (_m *Service) DryRunUpgradeGardenerShoot(ctx context.Context, id string, input gqlschema.UpgradeShootInput) (*gqlschema.OperationStatus, apperrors.AppError) {
+	ret := _m.Called(ctx, id, input)
+
+	var r0 *gqlschema.OperationStatus
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(context.Context, string, gqlschema.UpgradeShootInput) (*gqlschema.OperationStatus, apperrors.AppError)); ok {
+		return rf(ctx, id, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, gqlschema.UpgradeShootInput) *gqlschema.OperationStatus); ok {
+		r0 = rf(ctx, id, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gqlschema.OperationStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, gqlschema.UpgradeShootInput) apperrors.AppError); ok {
+		r1 = rf(ctx, id, input)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// HibernateCluster provides a mock function with given fields: ctx, id, priority
+func (_m *Service) HibernateCluster(ctx context.Context, id string, priority *gqlschema.OperationPriority) (*gqlschema.OperationStatus, apperrors.AppError) {
+	ret := _m.Called(ctx, id, priority)
+
+	var r0 *gqlschema.OperationStatus
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(context.Context, string, *gqlschema.OperationPriority) (*gqlschema.OperationStatus, apperrors.AppError)); ok {
+		return rf(ctx, id, priority)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *gqlschema.OperationPriority) *gqlschema.OperationStatus); ok {
+		r0 = rf(ctx, id, priority)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gqlschema.OperationStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *gqlschema.OperationPriority) apperrors.AppError); ok {
+		r1 = rf(ctx, id, priority)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListRuntimes provides a mock function with given fields: ctx, filter, first, after
+func (_m *Service) ListRuntimes(ctx context.Context, filter *gqlschema.RuntimeFilterInput, first *int, after *string) (*gqlschema.RuntimeSummaryPage, apperrors.AppError) {
+	ret := _m.Called(ctx, filter, first, after)
+
+	var r0 *gqlschema.RuntimeSummaryPage
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(context.Context, *gqlschema.RuntimeFilterInput, *int, *string) (*gqlschema.RuntimeSummaryPage, apperrors.AppError)); ok {
+		return rf(ctx, filter, first, after)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gqlschema.RuntimeFilterInput, *int, *string) *gqlschema.RuntimeSummaryPage); ok {
+		r0 = rf(ctx, filter, first, after)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gqlschema.RuntimeSummaryPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gqlschema.RuntimeFilterInput, *int, *string) apperrors.AppError); ok {
+		r1 = rf(ctx, filter, first, after)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(apperrors.AppError)
@@ -40,25 +183,25 @@ func (_m *Service) DeprovisionRuntime(id string) (string, apperrors.AppError) {
 	return r0, r1
 }
 
-// ProvisionRuntime provides a mock function with given fields: config, tenant, subAccount
-func (_m *Service) ProvisionRuntime(config gqlschema.ProvisionRuntimeInput, tenant string, subAccount string) (*gqlschema.OperationStatus, apperrors.AppError) {
-	ret := _m.Called(config, tenant, subAccount)
+// ProvisionRuntime provides a mock function with given fields: ctx, config, tenant, subAccount
+func (_m *Service) ProvisionRuntime(ctx context.Context, config gqlschema.ProvisionRuntimeInput, tenant string, subAccount string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	ret := _m.Called(ctx, config, tenant, subAccount)
 
 	var r0 *gqlschema.OperationStatus
 	var r1 apperrors.AppError
-	if rf, ok := ret.Get(0).(func(gqlschema.ProvisionRuntimeInput, string, string) (*gqlschema.OperationStatus, apperrors.AppError)); ok {
-		return rf(config, tenant, subAccount)
+	if rf, ok := ret.Get(0).(func(context.Context, gqlschema.ProvisionRuntimeInput, string, string) (*gqlschema.OperationStatus, apperrors.AppError)); ok {
+		return rf(ctx, config, tenant, subAccount)
 	}
-	if rf, ok := ret.Get(0).(func(gqlschema.ProvisionRuntimeInput, string, string) *gqlschema.OperationStatus); ok {
-		r0 = rf(config, tenant, subAccount)
+	if rf, ok := ret.Get(0).(func(context.Context, gqlschema.ProvisionRuntimeInput, string, string) *gqlschema.OperationStatus); ok {
+		r0 = rf(ctx, config, tenant, subAccount)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*gqlschema.OperationStatus)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(gqlschema.ProvisionRuntimeInput, string, string) apperrors.AppError); ok {
-		r1 = rf(config, tenant, subAccount)
+	if rf, ok := ret.Get(1).(func(context.Context, gqlschema.ProvisionRuntimeInput, string, string) apperrors.AppError); ok {
+		r1 = rf(ctx, config, tenant, subAccount)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(apperrors.AppError)
@@ -68,23 +211,23 @@ func (_m *Service) ProvisionRuntime(config gqlschema.ProvisionRuntimeInput, tena
 	return r0, r1
 }
 
-// ReconnectRuntimeAgent provides a mock function with given fields: id
-func (_m *Service) ReconnectRuntimeAgent(id string) (string, apperrors.AppError) {
-	ret := _m.Called(id)
+// ReconnectRuntimeAgent provides a mock function with given fields: ctx, id
+func (_m *Service) ReconnectRuntimeAgent(ctx context.Context, id string) (string, apperrors.AppError) {
+	ret := _m.Called(ctx, id)
 
 	var r0 string
 	var r1 apperrors.AppError
-	if rf, ok := ret.Get(0).(func(string) (string, apperrors.AppError)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, apperrors.AppError)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(string) string); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, string) apperrors.AppError); ok {
+		r1 = rf(ctx, id)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(apperrors.AppError)
@@ -94,25 +237,25 @@ func (_m *Service) ReconnectRuntimeAgent(id string) (string, apperrors.AppError)
 	return r0, r1
 }
 
-// RuntimeOperationStatus provides a mock function with given fields: id
-func (_m *Service) RuntimeOperationStatus(id string) (*gqlschema.OperationStatus, apperrors.AppError) {
-	ret := _m.Called(id)
+// RetryOperation provides a mock function with given fields: ctx, id
+func (_m *Service) RetryOperation(ctx context.Context, id string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	ret := _m.Called(ctx, id)
 
 	var r0 *gqlschema.OperationStatus
 	var r1 apperrors.AppError
-	if rf, ok := ret.Get(0).(func(string) (*gqlschema.OperationStatus, apperrors.AppError)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*gqlschema.OperationStatus, apperrors.AppError)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(string) *gqlschema.OperationStatus); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *gqlschema.OperationStatus); ok {
+		r0 = rf(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*gqlschema.OperationStatus)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, string) apperrors.AppError); ok {
+		r1 = rf(ctx, id)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(apperrors.AppError)
@@ -122,25 +265,109 @@ func (_m *Service) RuntimeOperationStatus(id string) (*gqlschema.OperationStatus
 	return r0, r1
 }
 
-// RuntimeStatus provides a mock function with given fields: id
-func (_m *Service) RuntimeStatus(id string) (*gqlschema.RuntimeStatus, apperrors.AppError) {
-	ret := _m.Called(id)
+// RuntimeOperationStatus provides a mock function with given fields: ctx, id
+func (_m *Service) RuntimeOperationStatus(ctx context.Context, id string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *gqlschema.OperationStatus
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*gqlschema.OperationStatus, apperrors.AppError)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *gqlschema.OperationStatus); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gqlschema.OperationStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) apperrors.AppError); ok {
+		r1 = rf(ctx, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// RuntimeOperations provides a mock function with given fields: ctx, runtimeID, types, states, first, after
+func (_m *Service) RuntimeOperations(ctx context.Context, runtimeID string, types []gqlschema.OperationType, states []gqlschema.OperationState, first *int, after *string) (*gqlschema.OperationHistoryPage, apperrors.AppError) {
+	ret := _m.Called(ctx, runtimeID, types, states, first, after)
+
+	var r0 *gqlschema.OperationHistoryPage
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(context.Context, string, []gqlschema.OperationType, []gqlschema.OperationState, *int, *string) (*gqlschema.OperationHistoryPage, apperrors.AppError)); ok {
+		return rf(ctx, runtimeID, types, states, first, after)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []gqlschema.OperationType, []gqlschema.OperationState, *int, *string) *gqlschema.OperationHistoryPage); ok {
+		r0 = rf(ctx, runtimeID, types, states, first, after)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gqlschema.OperationHistoryPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []gqlschema.OperationType, []gqlschema.OperationState, *int, *string) apperrors.AppError); ok {
+		r1 = rf(ctx, runtimeID, types, states, first, after)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// RuntimeStatus provides a mock function with given fields: ctx, id
+func (_m *Service) RuntimeStatus(ctx context.Context, id string) (*gqlschema.RuntimeStatus, apperrors.AppError) {
+	ret := _m.Called(ctx, id)
 
 	var r0 *gqlschema.RuntimeStatus
 	var r1 apperrors.AppError
-	if rf, ok := ret.Get(0).(func(string) (*gqlschema.RuntimeStatus, apperrors.AppError)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*gqlschema.RuntimeStatus, apperrors.AppError)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(string) *gqlschema.RuntimeStatus); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *gqlschema.RuntimeStatus); ok {
+		r0 = rf(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*gqlschema.RuntimeStatus)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, string) apperrors.AppError); ok {
+		r1 = rf(ctx, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// UpgradeGardenerShoot provides a mock function with given fields: ctx, id, input
+func (_m *Service) UpgradeGardenerShoot(ctx context.Context, id string, input gqlschema.UpgradeShootInput) (*gqlschema.OperationStatus, apperrors.AppError) {
+	ret := _m.Called(ctx, id, input)
+
+	var r0 *gqlschema.OperationStatus
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(context.Context, string, gqlschema.UpgradeShootInput) (*gqlschema.OperationStatus, apperrors.AppError)); ok {
+		return rf(ctx, id, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, gqlschema.UpgradeShootInput) *gqlschema.OperationStatus); ok {
+		r0 = rf(ctx, id, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gqlschema.OperationStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, gqlschema.UpgradeShootInput) apperrors.AppError); ok {
+		r1 = rf(ctx, id, input)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(apperrors.AppError)
@@ -150,25 +377,25 @@ func (_m *Service) RuntimeStatus(id string) (*gqlschema.RuntimeStatus, apperrors
 	return r0, r1
 }
 
-// UpgradeGardenerShoot provides a mock function with given fields: id, input
-func (_m *Service) UpgradeGardenerShoot(id string, input gqlschema.UpgradeShootInput) (*gqlschema.OperationStatus, apperrors.AppError) {
-	ret := _m.Called(id, input)
+// WakeUpCluster provides a mock function with given fields: ctx, id, priority
+func (_m *Service) WakeUpCluster(ctx context.Context, id string, priority *gqlschema.OperationPriority) (*gqlschema.OperationStatus, apperrors.AppError) {
+	ret := _m.Called(ctx, id, priority)
 
 	var r0 *gqlschema.OperationStatus
 	var r1 apperrors.AppError
-	if rf, ok := ret.Get(0).(func(string, gqlschema.UpgradeShootInput) (*gqlschema.OperationStatus, apperrors.AppError)); ok {
-		return rf(id, input)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *gqlschema.OperationPriority) (*gqlschema.OperationStatus, apperrors.AppError)); ok {
+		return rf(ctx, id, priority)
 	}
-	if rf, ok := ret.Get(0).(func(string, gqlschema.UpgradeShootInput) *gqlschema.OperationStatus); ok {
-		r0 = rf(id, input)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *gqlschema.OperationPriority) *gqlschema.OperationStatus); ok {
+		r0 = rf(ctx, id, priority)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*gqlschema.OperationStatus)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, gqlschema.UpgradeShootInput) apperrors.AppError); ok {
-		r1 = rf(id, input)
+	if rf, ok := ret.Get(1).(func(context.Context, string, *gqlschema.OperationPriority) apperrors.AppError); ok {
+		r1 = rf(ctx, id, priority)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(apperrors.AppError)
@@ -0,0 +1,51 @@
+package provisioning
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+func pageSize(first *int) (int, apperrors.AppError) {
+	if first == nil {
+		return defaultPageSize, nil
+	}
+
+	if *first < 1 || *first > maxPageSize {
+		return 0, apperrors.BadRequest("page size must be between 1 and %d, got %d", maxPageSize, *first)
+	}
+
+	return *first, nil
+}
+
+func encodeCursor(cursor model.PageCursor) string {
+	// Marshaling a struct of a time and a string cannot fail
+	data, _ := json.Marshal(cursor)
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(after *string) (*model.PageCursor, apperrors.AppError) {
+	if after == nil || *after == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(*after)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid page cursor: %s", err.Error())
+	}
+
+	var cursor model.PageCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, apperrors.BadRequest("invalid page cursor: %s", err.Error())
+	}
+
+	return &cursor, nil
+}
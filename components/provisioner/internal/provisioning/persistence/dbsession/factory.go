@@ -1,6 +1,7 @@
 package dbsession
 
 import (
+	"context"
 	"time"
 
 	"github.com/pkg/errors"
@@ -16,6 +17,8 @@ type Factory interface {
 	NewWriteSession() WriteSession
 	NewReadWriteSession() ReadWriteSession
 	NewSessionWithinTransaction() (WriteSessionWithinTransaction, dberrors.Error)
+	// Ping verifies that the database can be reached
+	Ping(ctx context.Context) dberrors.Error
 }
 
 //go:generate mockery --name=ReadSession
@@ -26,27 +29,63 @@ type ReadSession interface {
 	GetGardenerClusterByName(name string) (model.Cluster, dberrors.Error)
 	GetTenant(runtimeID string) (string, dberrors.Error)
 	ListInProgressOperations() ([]model.Operation, dberrors.Error)
+	ListInProgressOperationsWithoutLease(owner string) ([]model.Operation, dberrors.Error)
 	GetRuntimeUpgrade(operationId string) (model.RuntimeUpgrade, dberrors.Error)
 	GetTenantForOperation(operationID string) (string, dberrors.Error)
 	InProgressOperationsCount() (model.OperationsCount, dberrors.Error)
+	ListRuntimes(filter model.RuntimeFilter, after *model.PageCursor, limit int) ([]model.RuntimeStatus, dberrors.Error)
+	ListOperations(runtimeID string, filter model.OperationFilter, after *model.PageCursor, limit int) ([]model.Operation, dberrors.Error)
+	GetGardenerConfigBackup(operationID string) (model.GardenerConfig, dberrors.Error)
+	GetUpgradedGardenerConfigBackup(operationID string) (model.GardenerConfig, dberrors.Error)
+	GetIdempotencyKey(tenant, key string) (model.IdempotencyKey, dberrors.Error)
+	ListShootDrifts(runtimeID string) ([]model.ShootDrift, dberrors.Error)
 }
 
 //go:generate mockery --name=WriteSession
 type WriteSession interface {
 	InsertCluster(cluster model.Cluster) dberrors.Error
+	// LockTenantRuntimes serializes creation of Runtimes of the tenant until the end of the transaction, so that counted Runtimes do not change
+	LockTenantRuntimes(tenant string) dberrors.Error
+	CountActiveRuntimes(tenant string, subAccountID *string) (int, dberrors.Error)
+	// LockLastOperation locks the configuration and the last operation of the Runtime until the end of the transaction, so that no operation changing the configuration starts meanwhile
+	LockLastOperation(runtimeID string) (model.Operation, dberrors.Error)
 	InsertGardenerConfig(config model.GardenerConfig) dberrors.Error
+	// UpdateGardenerClusterConfig replaces the configuration together with worker pools, it has to be called within transaction
 	UpdateGardenerClusterConfig(config model.GardenerConfig) dberrors.Error
+	UpdateGardenerClusterSeed(runtimeID string, seed string) dberrors.Error
+	UpdateAdoptedGardenerConfig(config model.GardenerConfig, fields []string) dberrors.Error
 	InsertAdministrators(clusterId string, administrators []string) dberrors.Error
 	InsertOperation(operation model.Operation) dberrors.Error
 	UpdateOperationState(operationID string, message string, state model.OperationState, endTime time.Time) dberrors.Error
+	CancelOperation(operationID string, message string, endTime time.Time) dberrors.Error
+	RetryOperation(operationID string, message string, transitionTime time.Time) dberrors.Error
+	InsertOperationRetry(retry model.OperationRetry) dberrors.Error
+	InsertGardenerConfigBackup(operationID string, config, upgradedConfig model.GardenerConfig) dberrors.Error
+	InsertIdempotencyKey(key model.IdempotencyKey, staleBefore time.Time) dberrors.Error
+	SetIdempotencyKeyOperation(tenant, key string, operationID string) dberrors.Error
+	DeleteIdempotencyKey(tenant, key string) dberrors.Error
+	AcquireOperationLease(operationID, owner string, ttl time.Duration) (bool, dberrors.Error)
+	RenewOperationLeases(owner string, ttl time.Duration) dberrors.Error
+	ReleaseOperationLease(operationID, owner string) dberrors.Error
+	ReleaseOperationLeases(owner string) dberrors.Error
+	EnqueueOperation(queue, operationID string, priority model.OperationPriority) dberrors.Error
+	// ClaimQueuedOperation hides the operation due to be processed from other owners until the claim expires, it returns NotFound error when there is no such operation
+	ClaimQueuedOperation(queue, owner string, priorityAging, claimTimeout time.Duration) (model.QueuedOperation, dberrors.Error)
+	ExtendQueuedOperationClaim(operationID, owner string, claimTimeout time.Duration) dberrors.Error
+	// RescheduleQueuedOperation releases the claim of the owner, it returns NotFound error when the operation is no longer claimed by the owner
+	RescheduleQueuedOperation(operationID, owner string, delay time.Duration, attempts int) dberrors.Error
+	DeleteClaimedQueuedOperation(operationID, owner string) dberrors.Error
+	DeleteQueuedOperation(operationID string) dberrors.Error
 	UpdateOperationLastError(operationID, msg, reason, component string) dberrors.Error
+	UpdateOperationAttempts(operationID string, attempts int) dberrors.Error
 	TransitionOperation(operationID string, message string, stage model.OperationStage, transitionTime time.Time) dberrors.Error
 	UpdateKubeconfig(runtimeID string, kubeconfig string) dberrors.Error
 	DeleteCluster(runtimeID string) dberrors.Error
 	MarkClusterAsDeleted(runtimeID string) dberrors.Error
 	UpdateTenant(runtimeID string, tenant string) dberrors.Error
-	UpdateKubernetesVersion(runtimeID string, version string) dberrors.Error
 	UpdateShootNetworkingFilterDisabled(runtimeID string, shootNetworkingFilterDisabled *bool) dberrors.Error
+	ReplaceShootDrifts(runtimeID string, drifts []model.ShootDrift) dberrors.Error
+	InsertGardenerConfigChanges(changes []model.GardenerConfigChange) dberrors.Error
 }
 
 //go:generate mockery --name=ReadWriteSession
@@ -70,6 +109,8 @@ type factory struct {
 	connection *dbr.Connection
 	encrypt    encryptFunc
 	decrypt    decryptFunc
+	// receiver is nil unless the factory was created with WithContext
+	receiver dbr.EventReceiver
 }
 
 func NewFactory(connection *dbr.Connection, secretKey string) (Factory, error) {
@@ -85,20 +126,20 @@ func NewFactory(connection *dbr.Connection, secretKey string) (Factory, error) {
 
 func (sf *factory) NewReadSession() ReadSession {
 	return readSession{
-		session: sf.connection.NewSession(nil),
+		session: sf.connection.NewSession(sf.receiver),
 		decrypt: sf.decrypt,
 	}
 }
 
 func (sf *factory) NewWriteSession() WriteSession {
 	return writeSession{
-		session: sf.connection.NewSession(nil),
+		session: sf.connection.NewSession(sf.receiver),
 		encrypt: sf.encrypt,
 	}
 }
 
 func (sf *factory) NewReadWriteSession() ReadWriteSession {
-	session := sf.connection.NewSession(nil)
+	session := sf.connection.NewSession(sf.receiver)
 	return readWriteSession{
 		readSession:  readSession{session: session, decrypt: sf.decrypt},
 		writeSession: writeSession{session: session, encrypt: sf.encrypt},
@@ -111,7 +152,7 @@ type readWriteSession struct {
 }
 
 func (sf *factory) NewSessionWithinTransaction() (WriteSessionWithinTransaction, dberrors.Error) {
-	dbSession := sf.connection.NewSession(nil)
+	dbSession := sf.connection.NewSession(sf.receiver)
 	dbTransaction, err := dbSession.Begin()
 
 	if err != nil {
@@ -124,3 +165,11 @@ func (sf *factory) NewSessionWithinTransaction() (WriteSessionWithinTransaction,
 		encrypt:     sf.encrypt,
 	}, nil
 }
+
+func (sf *factory) Ping(ctx context.Context) dberrors.Error {
+	if err := sf.connection.PingContext(ctx); err != nil {
+		return dberrors.Internal("Failed to ping database: %s", err)
+	}
+
+	return nil
+}
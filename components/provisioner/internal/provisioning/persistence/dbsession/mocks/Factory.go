@@ -4,6 +4,9 @@ package mocks
 
 import (
 	apperrors "github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+
+	context "context"
+
 	dbsession "github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -89,6 +92,22 @@ func (_m *Factory) NewWriteSession() dbsession.WriteSession {
 	return r0
 }
 
+// Ping provides a mock function with given fields: ctx
+func (_m *Factory) Ping(ctx context.Context) apperrors.AppError {
+	ret := _m.Called(ctx)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(context.Context) apperrors.AppError); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // NewFactory creates a new instance of Factory. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewFactory(t interface {
@@ -67,6 +67,58 @@ func (_m *ReadSession) GetGardenerClusterByName(name string) (model.Cluster, app
 	return r0, r1
 }
 
+// GetGardenerConfigBackup provides a mock function with given fields: operationID
+func (_m *ReadSession) GetGardenerConfigBackup(operationID string) (model.GardenerConfig, apperrors.AppError) {
+	ret := _m.Called(operationID)
+
+	var r0 model.GardenerConfig
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) (model.GardenerConfig, apperrors.AppError)); ok {
+		return rf(operationID)
+	}
+	if rf, ok := ret.Get(0).(func(string) model.GardenerConfig); ok {
+		r0 = rf(operationID)
+	} else {
+		r0 = ret.Get(0).(model.GardenerConfig)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(operationID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetIdempotencyKey provides a mock function with given fields: tenant, key
+func (_m *ReadSession) GetIdempotencyKey(tenant string, key string) (model.IdempotencyKey, apperrors.AppError) {
+	ret := _m.Called(tenant, key)
+
+	var r0 model.IdempotencyKey
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string) (model.IdempotencyKey, apperrors.AppError)); ok {
+		return rf(tenant, key)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) model.IdempotencyKey); ok {
+		r0 = rf(tenant, key)
+	} else {
+		r0 = ret.Get(0).(model.IdempotencyKey)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) apperrors.AppError); ok {
+		r1 = rf(tenant, key)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // GetLastOperation provides a mock function with given fields: runtimeID
 func (_m *ReadSession) GetLastOperation(runtimeID string) (model.Operation, apperrors.AppError) {
 	ret := _m.Called(runtimeID)
@@ -197,6 +249,32 @@ func (_m *ReadSession) GetTenantForOperation(operationID string) (string, apperr
 	return r0, r1
 }
 
+// GetUpgradedGardenerConfigBackup provides a mock function with given fields: operationID
+func (_m *ReadSession) GetUpgradedGardenerConfigBackup(operationID string) (model.GardenerConfig, apperrors.AppError) {
+	ret := _m.Called(operationID)
+
+	var r0 model.GardenerConfig
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) (model.GardenerConfig, apperrors.AppError)); ok {
+		return rf(operationID)
+	}
+	if rf, ok := ret.Get(0).(func(string) model.GardenerConfig); ok {
+		r0 = rf(operationID)
+	} else {
+		r0 = ret.Get(0).(model.GardenerConfig)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(operationID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // InProgressOperationsCount provides a mock function with given fields:
 func (_m *ReadSession) InProgressOperationsCount() (model.OperationsCount, apperrors.AppError) {
 	ret := _m.Called()
@@ -251,6 +329,118 @@ func (_m *ReadSession) ListInProgressOperations() ([]model.Operation, apperrors.
 	return r0, r1
 }
 
+// ListInProgressOperationsWithoutLease provides a mock function with given fields: owner
+func (_m *ReadSession) ListInProgressOperationsWithoutLease(owner string) ([]model.Operation, apperrors.AppError) {
+	ret := _m.Called(owner)
+
+	var r0 []model.Operation
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) ([]model.Operation, apperrors.AppError)); ok {
+		return rf(owner)
+	}
+	if rf, ok := ret.Get(0).(func(string) []model.Operation); ok {
+		r0 = rf(owner)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(owner)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListOperations provides a mock function with given fields: runtimeID, filter, after, limit
+func (_m *ReadSession) ListOperations(runtimeID string, filter model.OperationFilter, after *model.PageCursor, limit int) ([]model.Operation, apperrors.AppError) {
+	ret := _m.Called(runtimeID, filter, after, limit)
+
+	var r0 []model.Operation
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, model.OperationFilter, *model.PageCursor, int) ([]model.Operation, apperrors.AppError)); ok {
+		return rf(runtimeID, filter, after, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, model.OperationFilter, *model.PageCursor, int) []model.Operation); ok {
+		r0 = rf(runtimeID, filter, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, model.OperationFilter, *model.PageCursor, int) apperrors.AppError); ok {
+		r1 = rf(runtimeID, filter, after, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListRuntimes provides a mock function with given fields: filter, after, limit
+func (_m *ReadSession) ListRuntimes(filter model.RuntimeFilter, after *model.PageCursor, limit int) ([]model.RuntimeStatus, apperrors.AppError) {
+	ret := _m.Called(filter, after, limit)
+
+	var r0 []model.RuntimeStatus
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(model.RuntimeFilter, *model.PageCursor, int) ([]model.RuntimeStatus, apperrors.AppError)); ok {
+		return rf(filter, after, limit)
+	}
+	if rf, ok := ret.Get(0).(func(model.RuntimeFilter, *model.PageCursor, int) []model.RuntimeStatus); ok {
+		r0 = rf(filter, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.RuntimeStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(model.RuntimeFilter, *model.PageCursor, int) apperrors.AppError); ok {
+		r1 = rf(filter, after, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListShootDrifts provides a mock function with given fields: runtimeID
+func (_m *ReadSession) ListShootDrifts(runtimeID string) ([]model.ShootDrift, apperrors.AppError) {
+	ret := _m.Called(runtimeID)
+
+	var r0 []model.ShootDrift
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) ([]model.ShootDrift, apperrors.AppError)); ok {
+		return rf(runtimeID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []model.ShootDrift); ok {
+		r0 = rf(runtimeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.ShootDrift)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(runtimeID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // NewReadSession creates a new instance of ReadSession. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewReadSession(t interface {
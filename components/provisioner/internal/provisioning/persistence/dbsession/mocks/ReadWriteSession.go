@@ -17,6 +17,116 @@ type ReadWriteSession struct {
 	mock.Mock
 }
 
+// AcquireOperationLease provides a mock function with given fields: operationID, owner, ttl
+func (_m *ReadWriteSession) AcquireOperationLease(operationID string, owner string, ttl time.Duration) (bool, apperrors.AppError) {
+	ret := _m.Called(operationID, owner, ttl)
+
+	var r0 bool
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string, time.Duration) (bool, apperrors.AppError)); ok {
+		return rf(operationID, owner, ttl)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, time.Duration) bool); ok {
+		r0 = rf(operationID, owner, ttl)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, time.Duration) apperrors.AppError); ok {
+		r1 = rf(operationID, owner, ttl)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// CancelOperation provides a mock function with given fields: operationID, message, endTime
+func (_m *ReadWriteSession) CancelOperation(operationID string, message string, endTime time.Time) apperrors.AppError {
+	ret := _m.Called(operationID, message, endTime)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string, time.Time) apperrors.AppError); ok {
+		r0 = rf(operationID, message, endTime)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// ClaimQueuedOperation provides a mock function with given fields: queue, owner, priorityAging, claimTimeout
+func (_m *ReadWriteSession) ClaimQueuedOperation(queue string, owner string, priorityAging time.Duration, claimTimeout time.Duration) (model.QueuedOperation, apperrors.AppError) {
+	ret := _m.Called(queue, owner, priorityAging, claimTimeout)
+
+	var r0 model.QueuedOperation
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string, time.Duration, time.Duration) (model.QueuedOperation, apperrors.AppError)); ok {
+		return rf(queue, owner, priorityAging, claimTimeout)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, time.Duration, time.Duration) model.QueuedOperation); ok {
+		r0 = rf(queue, owner, priorityAging, claimTimeout)
+	} else {
+		r0 = ret.Get(0).(model.QueuedOperation)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, time.Duration, time.Duration) apperrors.AppError); ok {
+		r1 = rf(queue, owner, priorityAging, claimTimeout)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// CountActiveRuntimes provides a mock function with given fields: tenant, subAccountID
+func (_m *ReadWriteSession) CountActiveRuntimes(tenant string, subAccountID *string) (int, apperrors.AppError) {
+	ret := _m.Called(tenant, subAccountID)
+
+	var r0 int
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, *string) (int, apperrors.AppError)); ok {
+		return rf(tenant, subAccountID)
+	}
+	if rf, ok := ret.Get(0).(func(string, *string) int); ok {
+		r0 = rf(tenant, subAccountID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *string) apperrors.AppError); ok {
+		r1 = rf(tenant, subAccountID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteClaimedQueuedOperation provides a mock function with given fields: operationID, owner
+func (_m *ReadWriteSession) DeleteClaimedQueuedOperation(operationID string, owner string) apperrors.AppError {
+	ret := _m.Called(operationID, owner)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string) apperrors.AppError); ok {
+		r0 = rf(operationID, owner)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // DeleteCluster provides a mock function with given fields: runtimeID
 func (_m *ReadWriteSession) DeleteCluster(runtimeID string) apperrors.AppError {
 	ret := _m.Called(runtimeID)
@@ -33,6 +143,70 @@ func (_m *ReadWriteSession) DeleteCluster(runtimeID string) apperrors.AppError {
 	return r0
 }
 
+// DeleteIdempotencyKey provides a mock function with given fields: tenant, key
+func (_m *ReadWriteSession) DeleteIdempotencyKey(tenant string, key string) apperrors.AppError {
+	ret := _m.Called(tenant, key)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string) apperrors.AppError); ok {
+		r0 = rf(tenant, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// DeleteQueuedOperation provides a mock function with given fields: operationID
+func (_m *ReadWriteSession) DeleteQueuedOperation(operationID string) apperrors.AppError {
+	ret := _m.Called(operationID)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) apperrors.AppError); ok {
+		r0 = rf(operationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// EnqueueOperation provides a mock function with given fields: queue, operationID, priority
+func (_m *ReadWriteSession) EnqueueOperation(queue string, operationID string, priority model.OperationPriority) apperrors.AppError {
+	ret := _m.Called(queue, operationID, priority)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string, model.OperationPriority) apperrors.AppError); ok {
+		r0 = rf(queue, operationID, priority)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// ExtendQueuedOperationClaim provides a mock function with given fields: operationID, owner, claimTimeout
+func (_m *ReadWriteSession) ExtendQueuedOperationClaim(operationID string, owner string, claimTimeout time.Duration) apperrors.AppError {
+	ret := _m.Called(operationID, owner, claimTimeout)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string, time.Duration) apperrors.AppError); ok {
+		r0 = rf(operationID, owner, claimTimeout)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // GetCluster provides a mock function with given fields: runtimeID
 func (_m *ReadWriteSession) GetCluster(runtimeID string) (model.Cluster, apperrors.AppError) {
 	ret := _m.Called(runtimeID)
@@ -85,6 +259,58 @@ func (_m *ReadWriteSession) GetGardenerClusterByName(name string) (model.Cluster
 	return r0, r1
 }
 
+// GetGardenerConfigBackup provides a mock function with given fields: operationID
+func (_m *ReadWriteSession) GetGardenerConfigBackup(operationID string) (model.GardenerConfig, apperrors.AppError) {
+	ret := _m.Called(operationID)
+
+	var r0 model.GardenerConfig
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) (model.GardenerConfig, apperrors.AppError)); ok {
+		return rf(operationID)
+	}
+	if rf, ok := ret.Get(0).(func(string) model.GardenerConfig); ok {
+		r0 = rf(operationID)
+	} else {
+		r0 = ret.Get(0).(model.GardenerConfig)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(operationID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetIdempotencyKey provides a mock function with given fields: tenant, key
+func (_m *ReadWriteSession) GetIdempotencyKey(tenant string, key string) (model.IdempotencyKey, apperrors.AppError) {
+	ret := _m.Called(tenant, key)
+
+	var r0 model.IdempotencyKey
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string) (model.IdempotencyKey, apperrors.AppError)); ok {
+		return rf(tenant, key)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) model.IdempotencyKey); ok {
+		r0 = rf(tenant, key)
+	} else {
+		r0 = ret.Get(0).(model.IdempotencyKey)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) apperrors.AppError); ok {
+		r1 = rf(tenant, key)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // GetLastOperation provides a mock function with given fields: runtimeID
 func (_m *ReadWriteSession) GetLastOperation(runtimeID string) (model.Operation, apperrors.AppError) {
 	ret := _m.Called(runtimeID)
@@ -215,6 +441,32 @@ func (_m *ReadWriteSession) GetTenantForOperation(operationID string) (string, a
 	return r0, r1
 }
 
+// GetUpgradedGardenerConfigBackup provides a mock function with given fields: operationID
+func (_m *ReadWriteSession) GetUpgradedGardenerConfigBackup(operationID string) (model.GardenerConfig, apperrors.AppError) {
+	ret := _m.Called(operationID)
+
+	var r0 model.GardenerConfig
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) (model.GardenerConfig, apperrors.AppError)); ok {
+		return rf(operationID)
+	}
+	if rf, ok := ret.Get(0).(func(string) model.GardenerConfig); ok {
+		r0 = rf(operationID)
+	} else {
+		r0 = ret.Get(0).(model.GardenerConfig)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(operationID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // InProgressOperationsCount provides a mock function with given fields:
 func (_m *ReadWriteSession) InProgressOperationsCount() (model.OperationsCount, apperrors.AppError) {
 	ret := _m.Called()
@@ -289,6 +541,54 @@ func (_m *ReadWriteSession) InsertGardenerConfig(config model.GardenerConfig) ap
 	return r0
 }
 
+// InsertGardenerConfigBackup provides a mock function with given fields: operationID, config, upgradedConfig
+func (_m *ReadWriteSession) InsertGardenerConfigBackup(operationID string, config model.GardenerConfig, upgradedConfig model.GardenerConfig) apperrors.AppError {
+	ret := _m.Called(operationID, config, upgradedConfig)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, model.GardenerConfig, model.GardenerConfig) apperrors.AppError); ok {
+		r0 = rf(operationID, config, upgradedConfig)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// InsertGardenerConfigChanges provides a mock function with given fields: changes
+func (_m *ReadWriteSession) InsertGardenerConfigChanges(changes []model.GardenerConfigChange) apperrors.AppError {
+	ret := _m.Called(changes)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func([]model.GardenerConfigChange) apperrors.AppError); ok {
+		r0 = rf(changes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// InsertIdempotencyKey provides a mock function with given fields: key, staleBefore
+func (_m *ReadWriteSession) InsertIdempotencyKey(key model.IdempotencyKey, staleBefore time.Time) apperrors.AppError {
+	ret := _m.Called(key, staleBefore)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(model.IdempotencyKey, time.Time) apperrors.AppError); ok {
+		r0 = rf(key, staleBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // InsertOperation provides a mock function with given fields: operation
 func (_m *ReadWriteSession) InsertOperation(operation model.Operation) apperrors.AppError {
 	ret := _m.Called(operation)
@@ -305,6 +605,22 @@ func (_m *ReadWriteSession) InsertOperation(operation model.Operation) apperrors
 	return r0
 }
 
+// InsertOperationRetry provides a mock function with given fields: retry
+func (_m *ReadWriteSession) InsertOperationRetry(retry model.OperationRetry) apperrors.AppError {
+	ret := _m.Called(retry)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(model.OperationRetry) apperrors.AppError); ok {
+		r0 = rf(retry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // ListInProgressOperations provides a mock function with given fields:
 func (_m *ReadWriteSession) ListInProgressOperations() ([]model.Operation, apperrors.AppError) {
 	ret := _m.Called()
@@ -333,6 +649,160 @@ func (_m *ReadWriteSession) ListInProgressOperations() ([]model.Operation, apper
 	return r0, r1
 }
 
+// ListInProgressOperationsWithoutLease provides a mock function with given fields: owner
+func (_m *ReadWriteSession) ListInProgressOperationsWithoutLease(owner string) ([]model.Operation, apperrors.AppError) {
+	ret := _m.Called(owner)
+
+	var r0 []model.Operation
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) ([]model.Operation, apperrors.AppError)); ok {
+		return rf(owner)
+	}
+	if rf, ok := ret.Get(0).(func(string) []model.Operation); ok {
+		r0 = rf(owner)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(owner)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListOperations provides a mock function with given fields: runtimeID, filter, after, limit
+func (_m *ReadWriteSession) ListOperations(runtimeID string, filter model.OperationFilter, after *model.PageCursor, limit int) ([]model.Operation, apperrors.AppError) {
+	ret := _m.Called(runtimeID, filter, after, limit)
+
+	var r0 []model.Operation
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, model.OperationFilter, *model.PageCursor, int) ([]model.Operation, apperrors.AppError)); ok {
+		return rf(runtimeID, filter, after, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, model.OperationFilter, *model.PageCursor, int) []model.Operation); ok {
+		r0 = rf(runtimeID, filter, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, model.OperationFilter, *model.PageCursor, int) apperrors.AppError); ok {
+		r1 = rf(runtimeID, filter, after, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListRuntimes provides a mock function with given fields: filter, after, limit
+func (_m *ReadWriteSession) ListRuntimes(filter model.RuntimeFilter, after *model.PageCursor, limit int) ([]model.RuntimeStatus, apperrors.AppError) {
+	ret := _m.Called(filter, after, limit)
+
+	var r0 []model.RuntimeStatus
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(model.RuntimeFilter, *model.PageCursor, int) ([]model.RuntimeStatus, apperrors.AppError)); ok {
+		return rf(filter, after, limit)
+	}
+	if rf, ok := ret.Get(0).(func(model.RuntimeFilter, *model.PageCursor, int) []model.RuntimeStatus); ok {
+		r0 = rf(filter, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.RuntimeStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(model.RuntimeFilter, *model.PageCursor, int) apperrors.AppError); ok {
+		r1 = rf(filter, after, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListShootDrifts provides a mock function with given fields: runtimeID
+func (_m *ReadWriteSession) ListShootDrifts(runtimeID string) ([]model.ShootDrift, apperrors.AppError) {
+	ret := _m.Called(runtimeID)
+
+	var r0 []model.ShootDrift
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) ([]model.ShootDrift, apperrors.AppError)); ok {
+		return rf(runtimeID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []model.ShootDrift); ok {
+		r0 = rf(runtimeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.ShootDrift)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(runtimeID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// LockLastOperation provides a mock function with given fields: runtimeID
+func (_m *ReadWriteSession) LockLastOperation(runtimeID string) (model.Operation, apperrors.AppError) {
+	ret := _m.Called(runtimeID)
+
+	var r0 model.Operation
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) (model.Operation, apperrors.AppError)); ok {
+		return rf(runtimeID)
+	}
+	if rf, ok := ret.Get(0).(func(string) model.Operation); ok {
+		r0 = rf(runtimeID)
+	} else {
+		r0 = ret.Get(0).(model.Operation)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(runtimeID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// LockTenantRuntimes provides a mock function with given fields: tenant
+func (_m *ReadWriteSession) LockTenantRuntimes(tenant string) apperrors.AppError {
+	ret := _m.Called(tenant)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) apperrors.AppError); ok {
+		r0 = rf(tenant)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // MarkClusterAsDeleted provides a mock function with given fields: runtimeID
 func (_m *ReadWriteSession) MarkClusterAsDeleted(runtimeID string) apperrors.AppError {
 	ret := _m.Called(runtimeID)
@@ -349,6 +819,118 @@ func (_m *ReadWriteSession) MarkClusterAsDeleted(runtimeID string) apperrors.App
 	return r0
 }
 
+// ReleaseOperationLease provides a mock function with given fields: operationID, owner
+func (_m *ReadWriteSession) ReleaseOperationLease(operationID string, owner string) apperrors.AppError {
+	ret := _m.Called(operationID, owner)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string) apperrors.AppError); ok {
+		r0 = rf(operationID, owner)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// ReleaseOperationLeases provides a mock function with given fields: owner
+func (_m *ReadWriteSession) ReleaseOperationLeases(owner string) apperrors.AppError {
+	ret := _m.Called(owner)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) apperrors.AppError); ok {
+		r0 = rf(owner)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// RenewOperationLeases provides a mock function with given fields: owner, ttl
+func (_m *ReadWriteSession) RenewOperationLeases(owner string, ttl time.Duration) apperrors.AppError {
+	ret := _m.Called(owner, ttl)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, time.Duration) apperrors.AppError); ok {
+		r0 = rf(owner, ttl)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// ReplaceShootDrifts provides a mock function with given fields: runtimeID, drifts
+func (_m *ReadWriteSession) ReplaceShootDrifts(runtimeID string, drifts []model.ShootDrift) apperrors.AppError {
+	ret := _m.Called(runtimeID, drifts)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, []model.ShootDrift) apperrors.AppError); ok {
+		r0 = rf(runtimeID, drifts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// RescheduleQueuedOperation provides a mock function with given fields: operationID, owner, delay, attempts
+func (_m *ReadWriteSession) RescheduleQueuedOperation(operationID string, owner string, delay time.Duration, attempts int) apperrors.AppError {
+	ret := _m.Called(operationID, owner, delay, attempts)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string, time.Duration, int) apperrors.AppError); ok {
+		r0 = rf(operationID, owner, delay, attempts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// RetryOperation provides a mock function with given fields: operationID, message, transitionTime
+func (_m *ReadWriteSession) RetryOperation(operationID string, message string, transitionTime time.Time) apperrors.AppError {
+	ret := _m.Called(operationID, message, transitionTime)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string, time.Time) apperrors.AppError); ok {
+		r0 = rf(operationID, message, transitionTime)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// SetIdempotencyKeyOperation provides a mock function with given fields: tenant, key, operationID
+func (_m *ReadWriteSession) SetIdempotencyKeyOperation(tenant string, key string, operationID string) apperrors.AppError {
+	ret := _m.Called(tenant, key, operationID)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string, string) apperrors.AppError); ok {
+		r0 = rf(tenant, key, operationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // TransitionOperation provides a mock function with given fields: operationID, message, stage, transitionTime
 func (_m *ReadWriteSession) TransitionOperation(operationID string, message string, stage model.OperationStage, transitionTime time.Time) apperrors.AppError {
 	ret := _m.Called(operationID, message, stage, transitionTime)
@@ -365,6 +947,22 @@ func (_m *ReadWriteSession) TransitionOperation(operationID string, message stri
 	return r0
 }
 
+// UpdateAdoptedGardenerConfig provides a mock function with given fields: config, fields
+func (_m *ReadWriteSession) UpdateAdoptedGardenerConfig(config model.GardenerConfig, fields []string) apperrors.AppError {
+	ret := _m.Called(config, fields)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(model.GardenerConfig, []string) apperrors.AppError); ok {
+		r0 = rf(config, fields)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // UpdateGardenerClusterConfig provides a mock function with given fields: config
 func (_m *ReadWriteSession) UpdateGardenerClusterConfig(config model.GardenerConfig) apperrors.AppError {
 	ret := _m.Called(config)
@@ -381,6 +979,22 @@ func (_m *ReadWriteSession) UpdateGardenerClusterConfig(config model.GardenerCon
 	return r0
 }
 
+// UpdateGardenerClusterSeed provides a mock function with given fields: runtimeID, seed
+func (_m *ReadWriteSession) UpdateGardenerClusterSeed(runtimeID string, seed string) apperrors.AppError {
+	ret := _m.Called(runtimeID, seed)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string) apperrors.AppError); ok {
+		r0 = rf(runtimeID, seed)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // UpdateKubeconfig provides a mock function with given fields: runtimeID, kubeconfig
 func (_m *ReadWriteSession) UpdateKubeconfig(runtimeID string, kubeconfig string) apperrors.AppError {
 	ret := _m.Called(runtimeID, kubeconfig)
@@ -397,13 +1011,13 @@ func (_m *ReadWriteSession) UpdateKubeconfig(runtimeID string, kubeconfig string
 	return r0
 }
 
-// UpdateKubernetesVersion provides a mock function with given fields: runtimeID, version
-func (_m *ReadWriteSession) UpdateKubernetesVersion(runtimeID string, version string) apperrors.AppError {
-	ret := _m.Called(runtimeID, version)
+// UpdateOperationAttempts provides a mock function with given fields: operationID, attempts
+func (_m *ReadWriteSession) UpdateOperationAttempts(operationID string, attempts int) apperrors.AppError {
+	ret := _m.Called(operationID, attempts)
 
 	var r0 apperrors.AppError
-	if rf, ok := ret.Get(0).(func(string, string) apperrors.AppError); ok {
-		r0 = rf(runtimeID, version)
+	if rf, ok := ret.Get(0).(func(string, int) apperrors.AppError); ok {
+		r0 = rf(operationID, attempts)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(apperrors.AppError)
@@ -17,6 +17,116 @@ type WriteSession struct {
 	mock.Mock
 }
 
+// AcquireOperationLease provides a mock function with given fields: operationID, owner, ttl
+func (_m *WriteSession) AcquireOperationLease(operationID string, owner string, ttl time.Duration) (bool, apperrors.AppError) {
+	ret := _m.Called(operationID, owner, ttl)
+
+	var r0 bool
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string, time.Duration) (bool, apperrors.AppError)); ok {
+		return rf(operationID, owner, ttl)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, time.Duration) bool); ok {
+		r0 = rf(operationID, owner, ttl)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, time.Duration) apperrors.AppError); ok {
+		r1 = rf(operationID, owner, ttl)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// CancelOperation provides a mock function with given fields: operationID, message, endTime
+func (_m *WriteSession) CancelOperation(operationID string, message string, endTime time.Time) apperrors.AppError {
+	ret := _m.Called(operationID, message, endTime)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string, time.Time) apperrors.AppError); ok {
+		r0 = rf(operationID, message, endTime)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// ClaimQueuedOperation provides a mock function with given fields: queue, owner, priorityAging, claimTimeout
+func (_m *WriteSession) ClaimQueuedOperation(queue string, owner string, priorityAging time.Duration, claimTimeout time.Duration) (model.QueuedOperation, apperrors.AppError) {
+	ret := _m.Called(queue, owner, priorityAging, claimTimeout)
+
+	var r0 model.QueuedOperation
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string, time.Duration, time.Duration) (model.QueuedOperation, apperrors.AppError)); ok {
+		return rf(queue, owner, priorityAging, claimTimeout)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, time.Duration, time.Duration) model.QueuedOperation); ok {
+		r0 = rf(queue, owner, priorityAging, claimTimeout)
+	} else {
+		r0 = ret.Get(0).(model.QueuedOperation)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, time.Duration, time.Duration) apperrors.AppError); ok {
+		r1 = rf(queue, owner, priorityAging, claimTimeout)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// CountActiveRuntimes provides a mock function with given fields: tenant, subAccountID
+func (_m *WriteSession) CountActiveRuntimes(tenant string, subAccountID *string) (int, apperrors.AppError) {
+	ret := _m.Called(tenant, subAccountID)
+
+	var r0 int
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, *string) (int, apperrors.AppError)); ok {
+		return rf(tenant, subAccountID)
+	}
+	if rf, ok := ret.Get(0).(func(string, *string) int); ok {
+		r0 = rf(tenant, subAccountID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *string) apperrors.AppError); ok {
+		r1 = rf(tenant, subAccountID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteClaimedQueuedOperation provides a mock function with given fields: operationID, owner
+func (_m *WriteSession) DeleteClaimedQueuedOperation(operationID string, owner string) apperrors.AppError {
+	ret := _m.Called(operationID, owner)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string) apperrors.AppError); ok {
+		r0 = rf(operationID, owner)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // DeleteCluster provides a mock function with given fields: runtimeID
 func (_m *WriteSession) DeleteCluster(runtimeID string) apperrors.AppError {
 	ret := _m.Called(runtimeID)
@@ -33,6 +143,70 @@ func (_m *WriteSession) DeleteCluster(runtimeID string) apperrors.AppError {
 	return r0
 }
 
+// DeleteIdempotencyKey provides a mock function with given fields: tenant, key
+func (_m *WriteSession) DeleteIdempotencyKey(tenant string, key string) apperrors.AppError {
+	ret := _m.Called(tenant, key)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string) apperrors.AppError); ok {
+		r0 = rf(tenant, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// DeleteQueuedOperation provides a mock function with given fields: operationID
+func (_m *WriteSession) DeleteQueuedOperation(operationID string) apperrors.AppError {
+	ret := _m.Called(operationID)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) apperrors.AppError); ok {
+		r0 = rf(operationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// EnqueueOperation provides a mock function with given fields: queue, operationID, priority
+func (_m *WriteSession) EnqueueOperation(queue string, operationID string, priority model.OperationPriority) apperrors.AppError {
+	ret := _m.Called(queue, operationID, priority)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string, model.OperationPriority) apperrors.AppError); ok {
+		r0 = rf(queue, operationID, priority)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// ExtendQueuedOperationClaim provides a mock function with given fields: operationID, owner, claimTimeout
+func (_m *WriteSession) ExtendQueuedOperationClaim(operationID string, owner string, claimTimeout time.Duration) apperrors.AppError {
+	ret := _m.Called(operationID, owner, claimTimeout)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string, time.Duration) apperrors.AppError); ok {
+		r0 = rf(operationID, owner, claimTimeout)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // InsertAdministrators provides a mock function with given fields: clusterId, administrators
 func (_m *WriteSession) InsertAdministrators(clusterId string, administrators []string) apperrors.AppError {
 	ret := _m.Called(clusterId, administrators)
@@ -81,6 +255,54 @@ func (_m *WriteSession) InsertGardenerConfig(config model.GardenerConfig) apperr
 	return r0
 }
 
+// InsertGardenerConfigBackup provides a mock function with given fields: operationID, config, upgradedConfig
+func (_m *WriteSession) InsertGardenerConfigBackup(operationID string, config model.GardenerConfig, upgradedConfig model.GardenerConfig) apperrors.AppError {
+	ret := _m.Called(operationID, config, upgradedConfig)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, model.GardenerConfig, model.GardenerConfig) apperrors.AppError); ok {
+		r0 = rf(operationID, config, upgradedConfig)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// InsertGardenerConfigChanges provides a mock function with given fields: changes
+func (_m *WriteSession) InsertGardenerConfigChanges(changes []model.GardenerConfigChange) apperrors.AppError {
+	ret := _m.Called(changes)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func([]model.GardenerConfigChange) apperrors.AppError); ok {
+		r0 = rf(changes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// InsertIdempotencyKey provides a mock function with given fields: key, staleBefore
+func (_m *WriteSession) InsertIdempotencyKey(key model.IdempotencyKey, staleBefore time.Time) apperrors.AppError {
+	ret := _m.Called(key, staleBefore)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(model.IdempotencyKey, time.Time) apperrors.AppError); ok {
+		r0 = rf(key, staleBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // InsertOperation provides a mock function with given fields: operation
 func (_m *WriteSession) InsertOperation(operation model.Operation) apperrors.AppError {
 	ret := _m.Called(operation)
@@ -97,6 +319,64 @@ func (_m *WriteSession) InsertOperation(operation model.Operation) apperrors.App
 	return r0
 }
 
+// InsertOperationRetry provides a mock function with given fields: retry
+func (_m *WriteSession) InsertOperationRetry(retry model.OperationRetry) apperrors.AppError {
+	ret := _m.Called(retry)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(model.OperationRetry) apperrors.AppError); ok {
+		r0 = rf(retry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// LockLastOperation provides a mock function with given fields: runtimeID
+func (_m *WriteSession) LockLastOperation(runtimeID string) (model.Operation, apperrors.AppError) {
+	ret := _m.Called(runtimeID)
+
+	var r0 model.Operation
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) (model.Operation, apperrors.AppError)); ok {
+		return rf(runtimeID)
+	}
+	if rf, ok := ret.Get(0).(func(string) model.Operation); ok {
+		r0 = rf(runtimeID)
+	} else {
+		r0 = ret.Get(0).(model.Operation)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(runtimeID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// LockTenantRuntimes provides a mock function with given fields: tenant
+func (_m *WriteSession) LockTenantRuntimes(tenant string) apperrors.AppError {
+	ret := _m.Called(tenant)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) apperrors.AppError); ok {
+		r0 = rf(tenant)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // MarkClusterAsDeleted provides a mock function with given fields: runtimeID
 func (_m *WriteSession) MarkClusterAsDeleted(runtimeID string) apperrors.AppError {
 	ret := _m.Called(runtimeID)
@@ -113,6 +393,118 @@ func (_m *WriteSession) MarkClusterAsDeleted(runtimeID string) apperrors.AppErro
 	return r0
 }
 
+// ReleaseOperationLease provides a mock function with given fields: operationID, owner
+func (_m *WriteSession) ReleaseOperationLease(operationID string, owner string) apperrors.AppError {
+	ret := _m.Called(operationID, owner)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string) apperrors.AppError); ok {
+		r0 = rf(operationID, owner)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// ReleaseOperationLeases provides a mock function with given fields: owner
+func (_m *WriteSession) ReleaseOperationLeases(owner string) apperrors.AppError {
+	ret := _m.Called(owner)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) apperrors.AppError); ok {
+		r0 = rf(owner)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// RenewOperationLeases provides a mock function with given fields: owner, ttl
+func (_m *WriteSession) RenewOperationLeases(owner string, ttl time.Duration) apperrors.AppError {
+	ret := _m.Called(owner, ttl)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, time.Duration) apperrors.AppError); ok {
+		r0 = rf(owner, ttl)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// ReplaceShootDrifts provides a mock function with given fields: runtimeID, drifts
+func (_m *WriteSession) ReplaceShootDrifts(runtimeID string, drifts []model.ShootDrift) apperrors.AppError {
+	ret := _m.Called(runtimeID, drifts)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, []model.ShootDrift) apperrors.AppError); ok {
+		r0 = rf(runtimeID, drifts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// RescheduleQueuedOperation provides a mock function with given fields: operationID, owner, delay, attempts
+func (_m *WriteSession) RescheduleQueuedOperation(operationID string, owner string, delay time.Duration, attempts int) apperrors.AppError {
+	ret := _m.Called(operationID, owner, delay, attempts)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string, time.Duration, int) apperrors.AppError); ok {
+		r0 = rf(operationID, owner, delay, attempts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// RetryOperation provides a mock function with given fields: operationID, message, transitionTime
+func (_m *WriteSession) RetryOperation(operationID string, message string, transitionTime time.Time) apperrors.AppError {
+	ret := _m.Called(operationID, message, transitionTime)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string, time.Time) apperrors.AppError); ok {
+		r0 = rf(operationID, message, transitionTime)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// SetIdempotencyKeyOperation provides a mock function with given fields: tenant, key, operationID
+func (_m *WriteSession) SetIdempotencyKeyOperation(tenant string, key string, operationID string) apperrors.AppError {
+	ret := _m.Called(tenant, key, operationID)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string, string) apperrors.AppError); ok {
+		r0 = rf(tenant, key, operationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // TransitionOperation provides a mock function with given fields: operationID, message, stage, transitionTime
 func (_m *WriteSession) TransitionOperation(operationID string, message string, stage model.OperationStage, transitionTime time.Time) apperrors.AppError {
 	ret := _m.Called(operationID, message, stage, transitionTime)
@@ -129,6 +521,22 @@ func (_m *WriteSession) TransitionOperation(operationID string, message string,
 	return r0
 }
 
+// UpdateAdoptedGardenerConfig provides a mock function with given fields: config, fields
+func (_m *WriteSession) UpdateAdoptedGardenerConfig(config model.GardenerConfig, fields []string) apperrors.AppError {
+	ret := _m.Called(config, fields)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(model.GardenerConfig, []string) apperrors.AppError); ok {
+		r0 = rf(config, fields)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // UpdateGardenerClusterConfig provides a mock function with given fields: config
 func (_m *WriteSession) UpdateGardenerClusterConfig(config model.GardenerConfig) apperrors.AppError {
 	ret := _m.Called(config)
@@ -145,6 +553,22 @@ func (_m *WriteSession) UpdateGardenerClusterConfig(config model.GardenerConfig)
 	return r0
 }
 
+// UpdateGardenerClusterSeed provides a mock function with given fields: runtimeID, seed
+func (_m *WriteSession) UpdateGardenerClusterSeed(runtimeID string, seed string) apperrors.AppError {
+	ret := _m.Called(runtimeID, seed)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string) apperrors.AppError); ok {
+		r0 = rf(runtimeID, seed)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // UpdateKubeconfig provides a mock function with given fields: runtimeID, kubeconfig
 func (_m *WriteSession) UpdateKubeconfig(runtimeID string, kubeconfig string) apperrors.AppError {
 	ret := _m.Called(runtimeID, kubeconfig)
@@ -161,13 +585,13 @@ func (_m *WriteSession) UpdateKubeconfig(runtimeID string, kubeconfig string) ap
 	return r0
 }
 
-// UpdateKubernetesVersion provides a mock function with given fields: runtimeID, version
-func (_m *WriteSession) UpdateKubernetesVersion(runtimeID string, version string) apperrors.AppError {
-	ret := _m.Called(runtimeID, version)
+// UpdateOperationAttempts provides a mock function with given fields: operationID, attempts
+func (_m *WriteSession) UpdateOperationAttempts(operationID string, attempts int) apperrors.AppError {
+	ret := _m.Called(operationID, attempts)
 
 	var r0 apperrors.AppError
-	if rf, ok := ret.Get(0).(func(string, string) apperrors.AppError); ok {
-		r0 = rf(runtimeID, version)
+	if rf, ok := ret.Get(0).(func(string, int) apperrors.AppError); ok {
+		r0 = rf(operationID, attempts)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(apperrors.AppError)
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/gocraft/dbr/v2"
 
@@ -103,6 +104,12 @@ func (r readSession) GetCluster(runtimeID string) (model.Cluster, dberrors.Error
 	}
 	cluster.ClusterConfig.DNSConfig = dnsConfig
 
+	workerPools, dberr := r.getWorkerPools(providerConfig.ID)
+	if dberr != nil {
+		return model.Cluster{}, dberr.Append("Cannot get worker pools for runtimeID: %s", runtimeID)
+	}
+	cluster.ClusterConfig.AdditionalWorkerPools = workerPools
+
 	if cluster.ActiveKymaConfigId != nil {
 		kymaConfig, dberr := r.getKymaConfig(runtimeID, *cluster.ActiveKymaConfigId)
 		if dberr != nil {
@@ -294,7 +301,8 @@ func (r readSession) getClusterAdministrators(runtimeID string) ([]model.Cluster
 
 type gardenerConfigRead struct {
 	model.GardenerConfig
-	ProviderSpecificConfig string `db:"provider_specific_config"`
+	ProviderSpecificConfig string  `db:"provider_specific_config"`
+	RawNodeConfig          *string `db:"node_config"`
 }
 
 func (gcr *gardenerConfigRead) DecodeProviderConfig() error {
@@ -307,6 +315,116 @@ func (gcr *gardenerConfigRead) DecodeProviderConfig() error {
 	return nil
 }
 
+func decodeNodeConfig(data *string) (*model.NodeConfig, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	var nodeConfig model.NodeConfig
+	err := json.Unmarshal([]byte(*data), &nodeConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding node config: %s", err.Error())
+	}
+
+	return &nodeConfig, nil
+}
+
+// gardenerConfigBackup is the JSON representation of the Gardener config stored before the Shoot upgrade
+type gardenerConfigBackup struct {
+	model.GardenerConfig
+	GardenerProviderConfig string
+}
+
+func (r readSession) GetGardenerConfigBackup(operationID string) (model.GardenerConfig, dberrors.Error) {
+	var data string
+
+	err := r.session.
+		Select("config").
+		From("gardener_config_backup").
+		Where(dbr.Eq("operation_id", operationID)).
+		LoadOne(&data)
+
+	if err != nil {
+		if err == dbr.ErrNotFound {
+			return model.GardenerConfig{}, dberrors.NotFound("Cannot find Gardener config backup for operationID:'%s", operationID)
+		}
+
+		return model.GardenerConfig{}, dberrors.Internal("Failed to get Gardener config backup: %s", err)
+	}
+
+	config, err := decodeGardenerConfigBackup(data)
+	if err != nil {
+		return model.GardenerConfig{}, dberrors.Internal("Failed to decode Gardener config backup: %s", err)
+	}
+
+	return config, nil
+}
+
+// GetUpgradedGardenerConfigBackup returns the configuration the Shoot was upgraded to by the operation
+func (r readSession) GetUpgradedGardenerConfigBackup(operationID string) (model.GardenerConfig, dberrors.Error) {
+	var data *string
+
+	err := r.session.
+		Select("upgraded_config").
+		From("gardener_config_backup").
+		Where(dbr.Eq("operation_id", operationID)).
+		LoadOne(&data)
+
+	if err != nil {
+		if err == dbr.ErrNotFound {
+			return model.GardenerConfig{}, dberrors.NotFound("Cannot find upgraded Gardener config backup for operationID:'%s", operationID)
+		}
+
+		return model.GardenerConfig{}, dberrors.Internal("Failed to get upgraded Gardener config backup: %s", err)
+	}
+
+	// Backups stored before the upgraded configuration was kept do not contain it
+	if data == nil {
+		return model.GardenerConfig{}, dberrors.NotFound("Upgraded Gardener config backup for operationID:'%s' not stored", operationID)
+	}
+
+	config, err := decodeGardenerConfigBackup(*data)
+	if err != nil {
+		return model.GardenerConfig{}, dberrors.Internal("Failed to decode upgraded Gardener config backup: %s", err)
+	}
+
+	return config, nil
+}
+
+func encodeGardenerConfigBackup(config model.GardenerConfig) (string, error) {
+	backup := gardenerConfigBackup{GardenerConfig: config}
+	if config.GardenerProviderConfig != nil {
+		backup.GardenerProviderConfig = config.GardenerProviderConfig.RawJSON()
+	}
+
+	data, err := json.Marshal(backup)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+func decodeGardenerConfigBackup(data string) (model.GardenerConfig, error) {
+	var backup gardenerConfigBackup
+
+	err := json.Unmarshal([]byte(data), &backup)
+	if err != nil {
+		return model.GardenerConfig{}, err
+	}
+
+	config := backup.GardenerConfig
+	if backup.GardenerProviderConfig != "" {
+		providerConfig, appErr := model.NewGardenerProviderConfigFromJSON(backup.GardenerProviderConfig)
+		if appErr != nil {
+			return model.GardenerConfig{}, appErr
+		}
+		config.GardenerProviderConfig = providerConfig
+	}
+
+	return config, nil
+}
+
 func (r readSession) getGardenerConfig(runtimeID string) (model.GardenerConfig, dberrors.Error) {
 	gardenerConfig := gardenerConfigRead{}
 
@@ -317,7 +435,7 @@ func (r readSession) getGardenerConfig(runtimeID string) (model.GardenerConfig,
 			"auto_scaler_min", "auto_scaler_max", "max_surge", "max_unavailable",
 			"enable_kubernetes_version_auto_update", "enable_machine_image_version_auto_update",
 			"exposure_class_name", "provider_specific_config",
-			"shoot_networking_filter_disabled", "control_plane_failure_tolerance", "eu_access").
+			"shoot_networking_filter_disabled", "control_plane_failure_tolerance", "eu_access", "node_config").
 		From("cluster").
 		Join("gardener_config", "cluster.id=gardener_config.cluster_id").
 		Where(dbr.Eq("cluster.id", runtimeID)).
@@ -336,12 +454,17 @@ func (r readSession) getGardenerConfig(runtimeID string) (model.GardenerConfig,
 		return model.GardenerConfig{}, dberrors.Internal("Failed to decode Gardener provider config fetched from database: %s", err.Error())
 	}
 
+	gardenerConfig.NodeConfig, err = decodeNodeConfig(gardenerConfig.RawNodeConfig)
+	if err != nil {
+		return model.GardenerConfig{}, dberrors.Internal("Failed to decode node config fetched from database: %s", err.Error())
+	}
+
 	return gardenerConfig.GardenerConfig, nil
 }
 
 var (
 	operationColumns = []string{
-		"id", "type", "start_timestamp", "stage", "end_timestamp", "state", "message", "cluster_id", "last_transition", "err_message", "reason", "component",
+		"id", "type", "start_timestamp", "stage", "end_timestamp", "state", "message", "cluster_id", "last_transition", "priority", "attempts", "trace_context", "err_message", "reason", "component",
 	}
 )
 
@@ -388,6 +511,37 @@ func (r readSession) GetLastOperation(runtimeID string) (model.Operation, dberro
 	return operation, nil
 }
 
+func (r readSession) ListOperations(runtimeID string, filter model.OperationFilter, after *model.PageCursor, limit int) ([]model.Operation, dberrors.Error) {
+	var operations []model.Operation
+
+	query := r.session.
+		Select(operationColumns...).
+		From("operation").
+		Where(dbr.Eq("cluster_id", runtimeID))
+
+	if len(filter.Types) > 0 {
+		query = query.Where(dbr.Eq("type", filter.Types))
+	}
+	if len(filter.States) > 0 {
+		query = query.Where(dbr.Eq("state", filter.States))
+	}
+	if after != nil {
+		query = query.Where("(start_timestamp, id) > (?, ?)", after.Timestamp, after.ID)
+	}
+
+	_, err := query.
+		OrderAsc("start_timestamp").
+		OrderAsc("id").
+		Limit(uint64(limit)).
+		Load(&operations)
+
+	if err != nil {
+		return nil, dberrors.Internal("Failed to list operations for runtime %s: %s", runtimeID, err)
+	}
+
+	return operations, nil
+}
+
 func (r readSession) ListInProgressOperations() ([]model.Operation, dberrors.Error) {
 	var operations []model.Operation
 
@@ -407,6 +561,149 @@ func (r readSession) ListInProgressOperations() ([]model.Operation, dberrors.Err
 	return operations, nil
 }
 
+// ListInProgressOperationsWithoutLease returns operations in progress which are not processed by any replica,
+// operations with lease held by the owner or not expired lease of other replica are skipped
+func (r readSession) ListInProgressOperationsWithoutLease(owner string) ([]model.Operation, dberrors.Error) {
+	var operations []model.Operation
+
+	_, err := r.session.
+		Select(operationColumns...).
+		From("operation").
+		Where(dbr.Eq("state", model.InProgress)).
+		Where("NOT EXISTS (SELECT 1 FROM operation_lease WHERE operation_lease.operation_id = operation.id "+
+			"AND (operation_lease.owner = ? OR operation_lease.expires_at >= clock_timestamp()))", owner).
+		Load(&operations)
+
+	if err != nil {
+		if err == dbr.ErrNotFound {
+			return []model.Operation{}, nil
+		}
+		return nil, dberrors.Internal("Failed to list In Progress operations without lease: %s", err)
+	}
+
+	return operations, nil
+}
+
+type runtimeStatusDTO struct {
+	ID                string
+	Tenant            string
+	SubAccountId      *string
+	CreationTimestamp time.Time
+	Deleted           bool
+	Name              string
+	Provider          string
+	Region            string
+	KubernetesVersion string
+	OperationID       string `db:"operation_id"`
+	Type              model.OperationType
+	StartTimestamp    time.Time
+	EndTimestamp      *time.Time
+	State             model.OperationState
+	Message           string
+	Stage             model.OperationStage
+	LastTransition    *time.Time
+	Attempts          int
+	model.LastError
+}
+
+func (dto runtimeStatusDTO) toRuntimeStatus() model.RuntimeStatus {
+	return model.RuntimeStatus{
+		LastOperationStatus: model.Operation{
+			ID:             dto.OperationID,
+			Type:           dto.Type,
+			StartTimestamp: dto.StartTimestamp,
+			EndTimestamp:   dto.EndTimestamp,
+			State:          dto.State,
+			Message:        dto.Message,
+			ClusterID:      dto.ID,
+			Stage:          dto.Stage,
+			LastTransition: dto.LastTransition,
+			Attempts:       dto.Attempts,
+			LastError:      dto.LastError,
+		},
+		RuntimeConfiguration: model.Cluster{
+			ID:                dto.ID,
+			CreationTimestamp: dto.CreationTimestamp,
+			Deleted:           dto.Deleted,
+			Tenant:            dto.Tenant,
+			SubAccountId:      dto.SubAccountId,
+			ClusterConfig: model.GardenerConfig{
+				ClusterID:         dto.ID,
+				Name:              dto.Name,
+				Provider:          dto.Provider,
+				Region:            dto.Region,
+				KubernetesVersion: dto.KubernetesVersion,
+			},
+		},
+	}
+}
+
+func (r readSession) ListRuntimes(filter model.RuntimeFilter, after *model.PageCursor, limit int) ([]model.RuntimeStatus, dberrors.Error) {
+	var runtimes []runtimeStatusDTO
+
+	// Operations started at the same time are ordered by ID so that exactly one last operation is picked per cluster
+	lastOperations := r.session.
+		Select("DISTINCT ON (cluster_id) *").
+		From("operation").
+		OrderAsc("cluster_id").
+		OrderDesc("start_timestamp").
+		OrderAsc("id").
+		As("operation")
+
+	query := r.session.
+		Select(
+			"cluster.id", "cluster.tenant", "cluster.sub_account_id", "cluster.creation_timestamp", "cluster.deleted",
+			"gardener_config.name", "gardener_config.provider", "gardener_config.region", "gardener_config.kubernetes_version",
+			"operation.id AS operation_id", "operation.type", "operation.start_timestamp", "operation.end_timestamp",
+			"operation.state", "operation.message", "operation.stage", "operation.last_transition",
+			"operation.attempts", "operation.err_message", "operation.reason", "operation.component").
+		From("cluster").
+		Join("gardener_config", "cluster.id=gardener_config.cluster_id").
+		Join(lastOperations, "cluster.id=operation.cluster_id")
+
+	if filter.Tenant != nil {
+		query = query.Where(dbr.Eq("cluster.tenant", *filter.Tenant))
+	}
+	if filter.SubAccountID != nil {
+		query = query.Where(dbr.Eq("cluster.sub_account_id", *filter.SubAccountID))
+	}
+	if filter.Provider != nil {
+		query = query.Where(dbr.Eq("gardener_config.provider", *filter.Provider))
+	}
+	if filter.Region != nil {
+		query = query.Where(dbr.Eq("gardener_config.region", *filter.Region))
+	}
+	if filter.KubernetesVersion != nil {
+		query = query.Where(dbr.Eq("gardener_config.kubernetes_version", *filter.KubernetesVersion))
+	}
+	if filter.LastOperationState != nil {
+		query = query.Where(dbr.Eq("operation.state", *filter.LastOperationState))
+	}
+	if filter.Deleted != nil {
+		query = query.Where(dbr.Eq("cluster.deleted", *filter.Deleted))
+	}
+	if after != nil {
+		query = query.Where("(cluster.creation_timestamp, cluster.id) > (?, ?)", after.Timestamp, after.ID)
+	}
+
+	_, err := query.
+		OrderAsc("cluster.creation_timestamp").
+		OrderAsc("cluster.id").
+		Limit(uint64(limit)).
+		Load(&runtimes)
+
+	if err != nil {
+		return nil, dberrors.Internal("Failed to list Runtimes: %s", err)
+	}
+
+	runtimeStatuses := make([]model.RuntimeStatus, 0, len(runtimes))
+	for _, runtime := range runtimes {
+		runtimeStatuses = append(runtimeStatuses, runtime.toRuntimeStatus())
+	}
+
+	return runtimeStatuses, nil
+}
+
 func (r readSession) GetRuntimeUpgrade(operationId string) (model.RuntimeUpgrade, dberrors.Error) {
 	var runtimeUpgrade model.RuntimeUpgrade
 
@@ -484,6 +781,36 @@ func (r readSession) getOidcConfig(gardenerConfigID string) (model.OIDCConfig, d
 	return oidc, nil
 }
 
+func (r readSession) getWorkerPools(gardenerConfigID string) ([]model.WorkerPool, dberrors.Error) {
+	var poolsRead []struct {
+		model.WorkerPool
+		RawNodeConfig *string `db:"node_config"`
+	}
+
+	_, err := r.session.
+		Select("name", "machine_type", "machine_image", "machine_image_version", "disk_type", "volume_size_gb",
+			"auto_scaler_min", "auto_scaler_max", "max_surge", "max_unavailable", "node_config").
+		From("worker_pool").
+		Where(dbr.Eq("gardener_config_id", gardenerConfigID)).
+		OrderBy("name").
+		Load(&poolsRead)
+
+	if err != nil {
+		return nil, dberrors.Internal("Failed to get worker pools: %s", err)
+	}
+
+	var pools []model.WorkerPool
+	for _, pool := range poolsRead {
+		pool.NodeConfig, err = decodeNodeConfig(pool.RawNodeConfig)
+		if err != nil {
+			return nil, dberrors.Internal("Failed to decode node config of %s worker pool: %s", pool.Name, err)
+		}
+		pools = append(pools, pool.WorkerPool)
+	}
+
+	return pools, nil
+}
+
 func (r readSession) getDNSConfig(gardenerConfigID string) (*model.DNSConfig, dberrors.Error) {
 	var dnsConfigWithID struct {
 		model.DNSConfig
@@ -562,3 +889,40 @@ func (r readSession) decryptClusterAdministrators(
 	}
 	return decryptedClusterAdministrators, nil
 }
+
+func (r readSession) GetIdempotencyKey(tenant, key string) (model.IdempotencyKey, dberrors.Error) {
+	var idempotencyKey model.IdempotencyKey
+
+	err := r.session.
+		Select("tenant", "key", "payload_hash", "operation_id", "created_at").
+		From("idempotency_key").
+		Where(dbr.And(dbr.Eq("tenant", tenant), dbr.Eq("key", key))).
+		LoadOne(&idempotencyKey)
+
+	if err != nil {
+		if err == dbr.ErrNotFound {
+			return model.IdempotencyKey{}, dberrors.NotFound("Cannot find idempotency key: %s", key)
+		}
+
+		return model.IdempotencyKey{}, dberrors.Internal("Failed to get idempotency key: %s", err)
+	}
+
+	return idempotencyKey, nil
+}
+
+func (r readSession) ListShootDrifts(runtimeID string) ([]model.ShootDrift, dberrors.Error) {
+	var drifts []model.ShootDrift
+
+	_, err := r.session.
+		Select("cluster_id", "field", "expected_value", "actual_value", "policy", "detected_at").
+		From("shoot_drift").
+		Where(dbr.Eq("cluster_id", runtimeID)).
+		OrderAsc("field").
+		Load(&drifts)
+
+	if err != nil {
+		return nil, dberrors.Internal("Failed to list drift of runtime %s: %s", runtimeID, err)
+	}
+
+	return drifts, nil
+}
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -133,3 +135,83 @@ func Test_parseToKymaConfig(t *testing.T) {
 	}
 
 }
+
+func Test_gardenerConfigBackup(t *testing.T) {
+	providerConfig, err := model.NewGCPGardenerConfig(&gqlschema.GCPProviderConfigInput{Zones: []string{"europe-west1-a", "europe-west1-b"}})
+	require.NoError(t, err)
+
+	config := model.GardenerConfig{
+		ID:                     "abc-config-def",
+		ClusterID:              "abc-runtime-def",
+		Name:                   "shoot",
+		KubernetesVersion:      "1.27.5",
+		MachineType:            "n2-standard-4",
+		MachineImageVersion:    util.PtrTo("934.11.0"),
+		VolumeSizeGB:           util.PtrTo(50),
+		AutoScalerMin:          3,
+		AutoScalerMax:          10,
+		GardenerProviderConfig: providerConfig,
+		OIDCConfig: &model.OIDCConfig{
+			ClientID:    "client",
+			SigningAlgs: []string{"RS256"},
+		},
+		NodeConfig: &model.NodeConfig{
+			Labels: map[string]string{"workload": "memory"},
+			Taints: []model.Taint{{Key: "dedicated", Effect: "NoSchedule"}},
+		},
+		AdditionalWorkerPools: []model.WorkerPool{
+			{Name: "spot", MachineType: "n2-highmem-4", AutoScalerMax: 5, NodeConfig: &model.NodeConfig{CRIName: util.PtrTo("containerd")}},
+		},
+	}
+
+	t.Run("should restore encoded config", func(t *testing.T) {
+		data, err := encodeGardenerConfigBackup(config)
+		require.NoError(t, err)
+
+		restored, err := decodeGardenerConfigBackup(data)
+		require.NoError(t, err)
+
+		assert.Equal(t, config, restored)
+	})
+
+	t.Run("should restore encoded config without provider config", func(t *testing.T) {
+		withoutProviderConfig := config
+		withoutProviderConfig.GardenerProviderConfig = nil
+
+		data, err := encodeGardenerConfigBackup(withoutProviderConfig)
+		require.NoError(t, err)
+
+		restored, err := decodeGardenerConfigBackup(data)
+		require.NoError(t, err)
+
+		assert.Equal(t, withoutProviderConfig, restored)
+	})
+}
+
+func Test_nodeConfig(t *testing.T) {
+	t.Run("should restore encoded node config", func(t *testing.T) {
+		nodeConfig := &model.NodeConfig{
+			KubeletConfig: &model.KubeletConfig{MaxPods: util.PtrTo(200)},
+			Labels:        map[string]string{"workload": "memory"},
+			Taints:        []model.Taint{{Key: "dedicated", Value: "memory", Effect: "NoExecute"}},
+		}
+
+		data, err := encodeNodeConfig(nodeConfig)
+		require.NoError(t, err)
+
+		restored, decodeErr := decodeNodeConfig(data)
+		require.NoError(t, decodeErr)
+
+		assert.Equal(t, nodeConfig, restored)
+	})
+
+	t.Run("should store missing node config as NULL", func(t *testing.T) {
+		data, err := encodeNodeConfig(nil)
+		require.NoError(t, err)
+		assert.Nil(t, data)
+
+		restored, decodeErr := decodeNodeConfig(data)
+		require.NoError(t, decodeErr)
+		assert.Nil(t, restored)
+	})
+}
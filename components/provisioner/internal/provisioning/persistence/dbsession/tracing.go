@@ -0,0 +1,46 @@
+package dbsession
+
+import (
+	"context"
+
+	dbr "github.com/gocraft/dbr/v2"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/tracing"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// WithContext returns factory of sessions which trace queries as children of the span from the context,
+// factories other than the one returned by NewFactory are returned unchanged
+func WithContext(ctx context.Context, sessionFactory Factory) Factory {
+	f, ok := sessionFactory.(*factory)
+	if !ok || !tracing.Traced(ctx) {
+		return sessionFactory
+	}
+
+	traced := *f
+	traced.receiver = &tracingReceiver{parent: ctx}
+
+	return &traced
+}
+
+// tracingReceiver starts spans of queries, query itself runs with the context passed by dbr so that it is not cancelled together with the request
+type tracingReceiver struct {
+	dbr.NullEventReceiver
+	parent context.Context
+}
+
+var _ dbr.TracingEventReceiver = &tracingReceiver{}
+
+func (r *tracingReceiver) SpanStart(ctx context.Context, eventName, query string) context.Context {
+	_, span := tracing.Start(r.parent, eventName, semconv.DBSystemPostgreSQL, semconv.DBStatement(query))
+
+	return trace.ContextWithSpan(ctx, span)
+}
+
+func (r *tracingReceiver) SpanError(ctx context.Context, err error) {
+	tracing.RecordError(trace.SpanFromContext(ctx), err)
+}
+
+func (r *tracingReceiver) SpanFinish(ctx context.Context) {
+	trace.SpanFromContext(ctx).End()
+}
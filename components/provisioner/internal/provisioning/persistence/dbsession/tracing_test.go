@@ -0,0 +1,54 @@
+package dbsession
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/tracing"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+)
+
+func TestWithContext(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tracing.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+
+	t.Run("should trace queries as children of the span from the context", func(t *testing.T) {
+		// given
+		exporter.Reset()
+		ctx, span := tracing.Start(context.Background(), "request")
+		sessionFactory := WithContext(ctx, &factory{})
+
+		receiver := sessionFactory.(*factory).receiver.(*tracingReceiver)
+
+		// when
+		queryCtx := receiver.SpanStart(context.Background(), "dbr.select", "SELECT 1")
+		receiver.SpanError(queryCtx, errors.New("some error"))
+		receiver.SpanFinish(queryCtx)
+		span.End()
+
+		// then
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 2)
+		assert.Equal(t, "dbr.select", spans[0].Name)
+		assert.Equal(t, span.SpanContext().SpanID(), spans[0].Parent.SpanID())
+		assert.Contains(t, spans[0].Attributes, semconv.DBStatement("SELECT 1"))
+		assert.Equal(t, codes.Error, spans[0].Status.Code)
+	})
+
+	t.Run("should not trace queries when context holds no span", func(t *testing.T) {
+		// given
+		sessionFactory := &factory{}
+
+		// when
+		traced := WithContext(context.Background(), sessionFactory)
+
+		// then
+		assert.Same(t, sessionFactory, traced)
+	})
+}
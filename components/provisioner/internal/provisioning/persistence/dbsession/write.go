@@ -78,6 +78,11 @@ func (ws writeSession) InsertAdministrators(clusterId string, administrators []s
 }
 
 func (ws writeSession) InsertGardenerConfig(config model.GardenerConfig) dberrors.Error {
+	nodeConfig, dberr := encodeNodeConfig(config.NodeConfig)
+	if dberr != nil {
+		return dberr
+	}
+
 	_, err := ws.insertInto("gardener_config").
 		Pair("id", config.ID).
 		Pair("cluster_id", config.ClusterID).
@@ -109,6 +114,7 @@ func (ws writeSession) InsertGardenerConfig(config model.GardenerConfig) dberror
 		Pair("shoot_networking_filter_disabled", config.ShootNetworkingFilterDisabled).
 		Pair("control_plane_failure_tolerance", config.ControlPlaneFailureTolerance).
 		Pair("eu_access", config.EuAccess).
+		Pair("node_config", nodeConfig).
 		Exec()
 
 	if err != nil {
@@ -129,9 +135,66 @@ func (ws writeSession) InsertGardenerConfig(config model.GardenerConfig) dberror
 		}
 	}
 
+	return ws.insertWorkerPools(config)
+}
+
+func (ws writeSession) insertWorkerPools(config model.GardenerConfig) dberrors.Error {
+	for _, pool := range config.AdditionalWorkerPools {
+		nodeConfig, dberr := encodeNodeConfig(pool.NodeConfig)
+		if dberr != nil {
+			return dberr
+		}
+
+		_, err := ws.insertInto("worker_pool").
+			Pair("id", uuid.New().String()).
+			Pair("gardener_config_id", config.ID).
+			Pair("name", pool.Name).
+			Pair("machine_type", pool.MachineType).
+			Pair("machine_image", pool.MachineImage).
+			Pair("machine_image_version", pool.MachineImageVersion).
+			Pair("disk_type", pool.DiskType).
+			Pair("volume_size_gb", pool.VolumeSizeGB).
+			Pair("auto_scaler_min", pool.AutoScalerMin).
+			Pair("auto_scaler_max", pool.AutoScalerMax).
+			Pair("max_surge", pool.MaxSurge).
+			Pair("max_unavailable", pool.MaxUnavailable).
+			Pair("node_config", nodeConfig).
+			Exec()
+
+		if err != nil {
+			return dberrors.Internal("Failed to insert record to worker_pool table: %s", err)
+		}
+	}
 	return nil
 }
 
+// encodeNodeConfig returns JSON representation of the node config stored in the jsonb column, nil is stored as NULL
+func encodeNodeConfig(nodeConfig *model.NodeConfig) (*string, dberrors.Error) {
+	if nodeConfig == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(nodeConfig)
+	if err != nil {
+		return nil, dberrors.Internal("Failed to encode node config: %s", err)
+	}
+
+	encoded := string(data)
+	return &encoded, nil
+}
+
+func (ws writeSession) updateWorkerPools(config model.GardenerConfig) dberrors.Error {
+	_, err := ws.deleteFrom("worker_pool").
+		Where(dbr.Eq("gardener_config_id", config.ID)).
+		Exec()
+
+	if err != nil {
+		return dberrors.Internal("Failed to delete records from worker_pool table: %s", err)
+	}
+
+	return ws.insertWorkerPools(config)
+}
+
 func (ws writeSession) insertOidcConfig(config model.GardenerConfig) dberrors.Error {
 	_, err := ws.insertInto("oidc_config").
 		Pair("id", config.ID).
@@ -192,6 +255,16 @@ func (ws writeSession) insertDNSConfig(config model.GardenerConfig) dberrors.Err
 }
 
 func (ws writeSession) UpdateGardenerClusterConfig(config model.GardenerConfig) dberrors.Error {
+	// Worker pools are deleted and inserted again, they would be lost if the session failed in between outside of transaction
+	if ws.transaction == nil {
+		return dberrors.Internal("Failed to update configuration for gardener shoot cluster '%s': session is not within transaction", config.Name)
+	}
+
+	nodeConfig, dberr := encodeNodeConfig(config.NodeConfig)
+	if dberr != nil {
+		return dberr
+	}
+
 	res, err := ws.update("gardener_config").
 		Where(dbr.Eq("cluster_id", config.ClusterID)).
 		Set("kubernetes_version", config.KubernetesVersion).
@@ -212,20 +285,115 @@ func (ws writeSession) UpdateGardenerClusterConfig(config model.GardenerConfig)
 		Set("provider_specific_config", config.GardenerProviderConfig.RawJSON()).
 		Set("shoot_networking_filter_disabled", config.ShootNetworkingFilterDisabled).
 		Set("control_plane_failure_tolerance", config.ControlPlaneFailureTolerance).
+		Set("node_config", nodeConfig).
 		Exec()
 
+	if err != nil {
+		return dberrors.Internal("Failed to update record of configuration for gardener shoot cluster '%s': %s", config.Name, err)
+	}
+
+	// Worker pools and OIDC config are not modified when the configuration does not exist
+	dberr = ws.updateSucceeded(res, fmt.Sprintf("Failed to update record of configuration for gardener shoot cluster '%s': configuration not found", config.Name))
+	if dberr != nil {
+		return dberr
+	}
+
 	if config.OIDCConfig != nil {
-		err = ws.updateOidcConfig(config)
-		if err != nil {
-			return dberrors.Internal("Failed to update record for oidc config %s", err)
+		dberr = ws.updateOidcConfig(config)
+		if dberr != nil {
+			return dberrors.Internal("Failed to update record for oidc config %s", dberr)
 		}
 	}
 
+	return ws.updateWorkerPools(config)
+}
+
+func (ws writeSession) UpdateGardenerClusterSeed(runtimeID string, seed string) dberrors.Error {
+	res, err := ws.update("gardener_config").
+		Where(dbr.Eq("cluster_id", runtimeID)).
+		Set("seed", seed).
+		Exec()
+
 	if err != nil {
-		return dberrors.Internal("Failed to update record of configuration for gardener shoot cluster '%s': %s", config.Name, err)
+		return dberrors.Internal("Failed to update seed in %s cluster: %s", runtimeID, err)
 	}
 
-	return ws.updateSucceeded(res, fmt.Sprintf("Failed to update record of configuration for gardener shoot cluster '%s' state: %s", config.Name, err))
+	return ws.updateSucceeded(res, fmt.Sprintf("Failed to update seed in %s cluster: configuration not found", runtimeID))
+}
+
+// adoptedColumns maps the names of Shoot drift fields which can be adopted to the gardener_config columns storing them
+var adoptedColumns = map[string]func(config model.GardenerConfig) (string, interface{}){
+	"kubernetesVersion": func(config model.GardenerConfig) (string, interface{}) {
+		return "kubernetes_version", config.KubernetesVersion
+	},
+	"machineType": func(config model.GardenerConfig) (string, interface{}) {
+		return "machine_type", config.MachineType
+	},
+	"machineImage": func(config model.GardenerConfig) (string, interface{}) {
+		return "machine_image", config.MachineImage
+	},
+	"machineImageVersion": func(config model.GardenerConfig) (string, interface{}) {
+		return "machine_image_version", config.MachineImageVersion
+	},
+	"autoScalerMin": func(config model.GardenerConfig) (string, interface{}) {
+		return "auto_scaler_min", config.AutoScalerMin
+	},
+	"autoScalerMax": func(config model.GardenerConfig) (string, interface{}) {
+		return "auto_scaler_max", config.AutoScalerMax
+	},
+	"maxSurge": func(config model.GardenerConfig) (string, interface{}) {
+		return "max_surge", config.MaxSurge
+	},
+	"maxUnavailable": func(config model.GardenerConfig) (string, interface{}) {
+		return "max_unavailable", config.MaxUnavailable
+	},
+	"shootNetworkingFilterDisabled": func(config model.GardenerConfig) (string, interface{}) {
+		return "shoot_networking_filter_disabled", config.ShootNetworkingFilterDisabled
+	},
+}
+
+// oidcConfigField is adopted by replacing the row of oidc_config table
+const oidcConfigField = "oidcConfig"
+
+// UpdateAdoptedGardenerConfig stores only the given fields of the configuration adopted from the Shoot, other columns and worker pools are not modified
+func (ws writeSession) UpdateAdoptedGardenerConfig(config model.GardenerConfig, fields []string) dberrors.Error {
+	query := ws.update("gardener_config").
+		Where(dbr.Eq("cluster_id", config.ClusterID))
+
+	columns := 0
+	adoptOIDCConfig := false
+	for _, field := range fields {
+		if field == oidcConfigField {
+			adoptOIDCConfig = true
+			continue
+		}
+		column, found := adoptedColumns[field]
+		if !found {
+			return dberrors.Internal("Failed to update configuration of Runtime %s: field %s cannot be adopted", config.ClusterID, field)
+		}
+		query = query.Set(column(config))
+		columns++
+	}
+
+	if columns > 0 {
+		res, err := query.Exec()
+		if err != nil {
+			return dberrors.Internal("Failed to update adopted configuration of Runtime %s: %s", config.ClusterID, err)
+		}
+
+		dberr := ws.updateSucceeded(res, fmt.Sprintf("Failed to update adopted configuration of Runtime %s: configuration not found", config.ClusterID))
+		if dberr != nil {
+			return dberr
+		}
+	}
+
+	if adoptOIDCConfig && config.OIDCConfig != nil {
+		if dberr := ws.updateOidcConfig(config); dberr != nil {
+			return dberrors.Internal("Failed to update adopted OIDC config of Runtime %s: %s", config.ClusterID, dberr)
+		}
+	}
+
+	return nil
 }
 
 func (ws writeSession) updateOidcConfig(config model.GardenerConfig) dberrors.Error {
@@ -332,9 +500,10 @@ func (ws writeSession) DeleteCluster(runtimeID string) dberrors.Error {
 	return nil
 }
 
+// UpdateOperationState finishes the operation provided it is still in progress, so that the state of the cancelled operation is not overwritten
 func (ws writeSession) UpdateOperationState(operationID string, message string, state model.OperationState, endTime time.Time) dberrors.Error {
 	res, err := ws.update("operation").
-		Where(dbr.Eq("id", operationID)).
+		Where(dbr.And(dbr.Eq("id", operationID), dbr.Eq("state", model.InProgress))).
 		Set("state", state).
 		Set("message", message).
 		Set("end_timestamp", endTime).
@@ -344,7 +513,357 @@ func (ws writeSession) UpdateOperationState(operationID string, message string,
 		return dberrors.Internal("Failed to update operation %s state: %s", operationID, err)
 	}
 
-	return ws.updateSucceeded(res, fmt.Sprintf("Failed to update operation %s state: %s", operationID, err))
+	return ws.updateSucceeded(res, fmt.Sprintf("Failed to update operation %s state: operation not found or not in progress", operationID))
+}
+
+// CancelOperation sets the operation state to Cancelled provided it is still in progress
+func (ws writeSession) CancelOperation(operationID string, message string, endTime time.Time) dberrors.Error {
+	res, err := ws.update("operation").
+		Where(dbr.And(dbr.Eq("id", operationID), dbr.Eq("state", model.InProgress))).
+		Set("state", model.Cancelled).
+		Set("message", message).
+		Set("end_timestamp", endTime).
+		Exec()
+
+	if err != nil {
+		return dberrors.Internal("Failed to cancel operation %s: %s", operationID, err)
+	}
+
+	return ws.updateSucceeded(res, fmt.Sprintf("Failed to cancel operation %s: operation not found or not in progress", operationID))
+}
+
+// RetryOperation moves the failed operation back to in progress state, the operation is resumed from its current stage
+func (ws writeSession) RetryOperation(operationID string, message string, transitionTime time.Time) dberrors.Error {
+	res, err := ws.update("operation").
+		Where(dbr.And(dbr.Eq("id", operationID), dbr.Eq("state", model.Failed))).
+		Set("state", model.InProgress).
+		Set("message", message).
+		Set("end_timestamp", nil).
+		Set("last_transition", transitionTime).
+		Set("attempts", 0).
+		Exec()
+
+	if err != nil {
+		return dberrors.Internal("Failed to retry operation %s: %s", operationID, err)
+	}
+
+	return ws.updateSucceeded(res, fmt.Sprintf("Failed to retry operation %s: operation not found or not failed", operationID))
+}
+
+func (ws writeSession) InsertOperationRetry(retry model.OperationRetry) dberrors.Error {
+	_, err := ws.insertInto("operation_retry").
+		Pair("id", retry.ID).
+		Pair("operation_id", retry.OperationID).
+		Pair("stage", retry.Stage).
+		Pair("retry_timestamp", retry.Timestamp).
+		Pair("message", retry.Message).
+		Pair("err_message", retry.ErrMessage).
+		Pair("reason", retry.Reason).
+		Pair("component", retry.Component).
+		Exec()
+
+	if err != nil {
+		return dberrors.Internal("Failed to insert record to operation_retry table: %s", err)
+	}
+
+	return nil
+}
+
+// InsertGardenerConfigBackup stores configurations from before and after the upgrade, the latter is applied again when the failed upgrade is retried
+func (ws writeSession) InsertGardenerConfigBackup(operationID string, config, upgradedConfig model.GardenerConfig) dberrors.Error {
+	data, err := encodeGardenerConfigBackup(config)
+	if err != nil {
+		return dberrors.Internal("Failed to encode Gardener config backup: %s", err)
+	}
+
+	upgradedData, err := encodeGardenerConfigBackup(upgradedConfig)
+	if err != nil {
+		return dberrors.Internal("Failed to encode upgraded Gardener config backup: %s", err)
+	}
+
+	_, err = ws.insertInto("gardener_config_backup").
+		Pair("operation_id", operationID).
+		Pair("config", data).
+		Pair("upgraded_config", upgradedData).
+		Exec()
+
+	if err != nil {
+		return dberrors.Internal("Failed to insert record to gardener_config_backup table: %s", err)
+	}
+
+	return nil
+}
+
+// InsertIdempotencyKey reserves the key of the tenant, AlreadyExists error is returned if the key was already used.
+// Reservation without the operation created before staleBefore is taken over as the request which made it did not finish
+func (ws writeSession) InsertIdempotencyKey(key model.IdempotencyKey, staleBefore time.Time) dberrors.Error {
+	res, err := ws.insertBySql("INSERT INTO idempotency_key (tenant, key, payload_hash, operation_id, created_at) VALUES (?, ?, ?, ?, ?) "+
+		"ON CONFLICT (tenant, key) DO UPDATE SET payload_hash = EXCLUDED.payload_hash, created_at = EXCLUDED.created_at "+
+		"WHERE idempotency_key.operation_id IS NULL AND idempotency_key.created_at < ?",
+		key.Tenant, key.Key, key.PayloadHash, key.OperationID, key.CreatedAt, staleBefore).
+		Exec()
+
+	if err != nil {
+		return dberrors.Internal("Failed to insert record to idempotency_key table: %s", err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return dberrors.Internal("Failed to get number of rows affected: %s", err)
+	}
+
+	if rowsAffected == 0 {
+		return dberrors.AlreadyExists("Idempotency key %s already exists", key.Key)
+	}
+
+	return nil
+}
+
+func (ws writeSession) SetIdempotencyKeyOperation(tenant, key string, operationID string) dberrors.Error {
+	res, err := ws.update("idempotency_key").
+		Where(dbr.And(dbr.Eq("tenant", tenant), dbr.Eq("key", key))).
+		Set("operation_id", operationID).
+		Exec()
+
+	if err != nil {
+		return dberrors.Internal("Failed to update idempotency key %s: %s", key, err)
+	}
+
+	return ws.updateSucceeded(res, fmt.Sprintf("Failed to update idempotency key %s: key not found", key))
+}
+
+func (ws writeSession) DeleteIdempotencyKey(tenant, key string) dberrors.Error {
+	_, err := ws.deleteFrom("idempotency_key").
+		Where(dbr.And(dbr.Eq("tenant", tenant), dbr.Eq("key", key))).
+		Exec()
+
+	if err != nil {
+		return dberrors.Internal("Failed to delete idempotency key %s: %s", key, err)
+	}
+
+	return nil
+}
+
+func (ws writeSession) LockTenantRuntimes(tenant string) dberrors.Error {
+	// Advisory lock is released with the transaction, it would be released immediately outside of it
+	if ws.transaction == nil {
+		return dberrors.Internal("Failed to lock Runtimes of tenant %s: session is not within transaction", tenant)
+	}
+
+	var locked int
+	err := ws.selectBySql("SELECT 1 FROM pg_advisory_xact_lock(hashtext('runtime_quota'), hashtext(?))", tenant).
+		LoadOne(&locked)
+
+	if err != nil {
+		return dberrors.Internal("Failed to lock Runtimes of tenant %s: %s", tenant, err)
+	}
+
+	return nil
+}
+
+func (ws writeSession) CountActiveRuntimes(tenant string, subAccountID *string) (int, dberrors.Error) {
+	var count int
+
+	query := "SELECT count(*) FROM cluster WHERE tenant = ? AND deleted = false"
+	args := []interface{}{tenant}
+	if subAccountID != nil {
+		query += " AND sub_account_id = ?"
+		args = append(args, *subAccountID)
+	}
+
+	err := ws.selectBySql(query, args...).LoadOne(&count)
+	if err != nil {
+		return 0, dberrors.Internal("Failed to count active Runtimes: %s", err)
+	}
+
+	return count, nil
+}
+
+func (ws writeSession) LockLastOperation(runtimeID string) (model.Operation, dberrors.Error) {
+	// Row locks are released with the transaction, they would be released immediately outside of it
+	if ws.transaction == nil {
+		return model.Operation{}, dberrors.Internal("Failed to lock last operation of Runtime %s: session is not within transaction", runtimeID)
+	}
+
+	// Operations changing the configuration update gardener_config in the same transaction in which they are started
+	var configID string
+	err := ws.selectBySql("SELECT id FROM gardener_config WHERE cluster_id = ? FOR UPDATE", runtimeID).
+		LoadOne(&configID)
+	if err != nil {
+		if err == dbr.ErrNotFound {
+			return model.Operation{}, dberrors.NotFound("Configuration not found for Runtime: %s", runtimeID)
+		}
+		return model.Operation{}, dberrors.Internal("Failed to lock configuration of Runtime %s: %s", runtimeID, err)
+	}
+
+	var operation model.Operation
+	err = ws.selectBySql("SELECT "+strings.Join(operationColumns, ", ")+" FROM operation WHERE cluster_id = ? ORDER BY start_timestamp DESC LIMIT 1 FOR UPDATE", runtimeID).
+		LoadOne(&operation)
+	if err != nil {
+		if err == dbr.ErrNotFound {
+			return model.Operation{}, dberrors.NotFound("Last operation not found for runtime: %s", runtimeID)
+		}
+		return model.Operation{}, dberrors.Internal("Failed to lock last operation of Runtime %s: %s", runtimeID, err)
+	}
+
+	return operation, nil
+}
+
+func (ws writeSession) AcquireOperationLease(operationID, owner string, ttl time.Duration) (bool, dberrors.Error) {
+	// Lease is taken over only if it is already held by the owner or has expired, database clock is used by all replicas
+	res, err := ws.insertBySql("INSERT INTO operation_lease (operation_id, owner, heartbeat, expires_at) VALUES (?, ?, clock_timestamp(), clock_timestamp() + make_interval(secs => ?)) "+
+		"ON CONFLICT (operation_id) DO UPDATE SET owner = EXCLUDED.owner, heartbeat = EXCLUDED.heartbeat, expires_at = EXCLUDED.expires_at "+
+		"WHERE operation_lease.owner = EXCLUDED.owner OR operation_lease.expires_at < clock_timestamp()",
+		operationID, owner, ttl.Seconds()).
+		Exec()
+
+	if err != nil {
+		return false, dberrors.Internal("Failed to acquire lease of operation %s: %s", operationID, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return false, dberrors.Internal("Failed to get number of rows affected: %s", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (ws writeSession) RenewOperationLeases(owner string, ttl time.Duration) dberrors.Error {
+	_, err := ws.update("operation_lease").
+		Where(dbr.Eq("owner", owner)).
+		Set("heartbeat", dbr.Expr("clock_timestamp()")).
+		Set("expires_at", dbr.Expr("clock_timestamp() + make_interval(secs => ?)", ttl.Seconds())).
+		Exec()
+
+	if err != nil {
+		return dberrors.Internal("Failed to renew operation leases of %s: %s", owner, err)
+	}
+
+	return nil
+}
+
+func (ws writeSession) ReleaseOperationLease(operationID, owner string) dberrors.Error {
+	_, err := ws.deleteFrom("operation_lease").
+		Where(dbr.Eq("operation_id", operationID)).
+		Where(dbr.Eq("owner", owner)).
+		Exec()
+
+	if err != nil {
+		return dberrors.Internal("Failed to release lease of operation %s: %s", operationID, err)
+	}
+
+	return nil
+}
+
+func (ws writeSession) ReleaseOperationLeases(owner string) dberrors.Error {
+	_, err := ws.deleteFrom("operation_lease").
+		Where(dbr.Eq("owner", owner)).
+		Exec()
+
+	if err != nil {
+		return dberrors.Internal("Failed to release operation leases of %s: %s", owner, err)
+	}
+
+	return nil
+}
+
+func (ws writeSession) EnqueueOperation(queue, operationID string, priority model.OperationPriority) dberrors.Error {
+	// Schedule of the operation already in the queue is kept
+	_, err := ws.insertBySql("INSERT INTO operation_queue (operation_id, queue, priority, next_run_at, attempts) VALUES (?, ?, ?, clock_timestamp(), 0) "+
+		"ON CONFLICT (operation_id) DO NOTHING",
+		operationID, queue, priority).
+		Exec()
+
+	if err != nil {
+		return dberrors.Internal("Failed to enqueue operation %s: %s", operationID, err)
+	}
+
+	return nil
+}
+
+func (ws writeSession) ClaimQueuedOperation(queue, owner string, priorityAging, claimTimeout time.Duration) (model.QueuedOperation, dberrors.Error) {
+	var queuedOperation model.QueuedOperation
+
+	// Priority of the waiting operation is raised by one level every aging period
+	order := "priority DESC"
+	args := []interface{}{owner, claimTimeout.Seconds(), queue}
+	if priorityAging > 0 {
+		order = "priority + floor(extract(epoch FROM clock_timestamp() - next_run_at) / ?) DESC"
+		args = append(args, priorityAging.Seconds())
+	}
+
+	// The row is locked only while it is claimed, operations claimed or locked by other replicas are skipped
+	err := ws.selectBySql("UPDATE operation_queue SET owner = ?, locked_until = clock_timestamp() + make_interval(secs => ?) "+
+		"WHERE operation_id = (SELECT operation_id FROM operation_queue "+
+		"WHERE queue = ? AND next_run_at <= clock_timestamp() AND (locked_until IS NULL OR locked_until < clock_timestamp()) "+
+		"ORDER BY "+order+", next_run_at LIMIT 1 FOR UPDATE SKIP LOCKED) "+
+		"RETURNING operation_id, queue, priority, next_run_at, attempts",
+		args...).
+		LoadOne(&queuedOperation)
+
+	if err != nil {
+		if err == dbr.ErrNotFound {
+			return model.QueuedOperation{}, dberrors.NotFound("No operations to process in queue %s", queue)
+		}
+
+		return model.QueuedOperation{}, dberrors.Internal("Failed to claim operation from queue %s: %s", queue, err)
+	}
+
+	return queuedOperation, nil
+}
+
+func (ws writeSession) ExtendQueuedOperationClaim(operationID, owner string, claimTimeout time.Duration) dberrors.Error {
+	res, err := ws.update("operation_queue").
+		Where(dbr.And(dbr.Eq("operation_id", operationID), dbr.Eq("owner", owner))).
+		Set("locked_until", dbr.Expr("clock_timestamp() + make_interval(secs => ?)", claimTimeout.Seconds())).
+		Exec()
+
+	if err != nil {
+		return dberrors.Internal("Failed to extend claim of operation %s: %s", operationID, err)
+	}
+
+	return ws.updateSucceeded(res, fmt.Sprintf("Failed to extend claim of operation %s: operation not claimed by %s", operationID, owner))
+}
+
+func (ws writeSession) RescheduleQueuedOperation(operationID, owner string, delay time.Duration, attempts int) dberrors.Error {
+	res, err := ws.update("operation_queue").
+		Where(dbr.And(dbr.Eq("operation_id", operationID), dbr.Eq("owner", owner))).
+		Set("next_run_at", dbr.Expr("clock_timestamp() + make_interval(secs => ?)", delay.Seconds())).
+		Set("attempts", attempts).
+		Set("owner", nil).
+		Set("locked_until", nil).
+		Exec()
+
+	if err != nil {
+		return dberrors.Internal("Failed to reschedule operation %s: %s", operationID, err)
+	}
+
+	return ws.updateSucceeded(res, fmt.Sprintf("Failed to reschedule operation %s: operation not claimed by %s", operationID, owner))
+}
+
+func (ws writeSession) DeleteClaimedQueuedOperation(operationID, owner string) dberrors.Error {
+	res, err := ws.deleteFrom("operation_queue").
+		Where(dbr.And(dbr.Eq("operation_id", operationID), dbr.Eq("owner", owner))).
+		Exec()
+
+	if err != nil {
+		return dberrors.Internal("Failed to delete operation %s from queue: %s", operationID, err)
+	}
+
+	return ws.updateSucceeded(res, fmt.Sprintf("Failed to delete operation %s from queue: operation not claimed by %s", operationID, owner))
+}
+
+func (ws writeSession) DeleteQueuedOperation(operationID string) dberrors.Error {
+	_, err := ws.deleteFrom("operation_queue").
+		Where(dbr.Eq("operation_id", operationID)).
+		Exec()
+
+	if err != nil {
+		return dberrors.Internal("Failed to delete operation %s from queue: %s", operationID, err)
+	}
+
+	return nil
 }
 
 func (ws writeSession) UpdateOperationLastError(operationID, msg, reason, component string) dberrors.Error {
@@ -362,9 +881,23 @@ func (ws writeSession) UpdateOperationLastError(operationID, msg, reason, compon
 	return ws.updateSucceeded(res, fmt.Sprintf("Failed to update operation %s last error: %s", operationID, err))
 }
 
-func (ws writeSession) TransitionOperation(operationID string, message string, stage model.OperationStage, transitionTime time.Time) dberrors.Error {
+func (ws writeSession) UpdateOperationAttempts(operationID string, attempts int) dberrors.Error {
 	res, err := ws.update("operation").
 		Where(dbr.Eq("id", operationID)).
+		Set("attempts", attempts).
+		Exec()
+
+	if err != nil {
+		return dberrors.Internal("Failed to update operation %s attempts: %s", operationID, err)
+	}
+
+	return ws.updateSucceeded(res, fmt.Sprintf("Failed to update operation %s attempts: operation not found", operationID))
+}
+
+// TransitionOperation moves the operation to the next stage provided it is still in progress
+func (ws writeSession) TransitionOperation(operationID string, message string, stage model.OperationStage, transitionTime time.Time) dberrors.Error {
+	res, err := ws.update("operation").
+		Where(dbr.And(dbr.Eq("id", operationID), dbr.Eq("state", model.InProgress))).
 		Set("stage", stage).
 		Set("message", message).
 		Set("last_transition", transitionTime).
@@ -374,7 +907,7 @@ func (ws writeSession) TransitionOperation(operationID string, message string, s
 		return dberrors.Internal("Failed to update operation %s stage: %s", operationID, err)
 	}
 
-	return ws.updateSucceeded(res, fmt.Sprintf("Failed to update operation %s state: %s", operationID, err))
+	return ws.updateSucceeded(res, fmt.Sprintf("Failed to update operation %s stage: operation not found or not in progress", operationID))
 }
 
 func (ws writeSession) UpdateKubeconfig(runtimeID string, kubeconfig string) dberrors.Error {
@@ -396,30 +929,64 @@ func (ws writeSession) UpdateKubeconfig(runtimeID string, kubeconfig string) dbe
 	return ws.updateSucceeded(res, fmt.Sprintf("Failed to update cluster %s data: %s", runtimeID, err))
 }
 
-func (ws writeSession) UpdateKubernetesVersion(runtimeID string, version string) dberrors.Error {
+func (ws writeSession) UpdateShootNetworkingFilterDisabled(runtimeID string, shootNetworkingFilterDisabled *bool) dberrors.Error {
 	res, err := ws.update("gardener_config").
 		Where(dbr.Eq("cluster_id", runtimeID)).
-		Set("kubernetes_version", version).
+		Set("shoot_networking_filter_disabled", shootNetworkingFilterDisabled).
 		Exec()
 
 	if err != nil {
-		return dberrors.Internal("Failed to update Kubernetes version in %s cluster: %s", runtimeID, err)
+		return dberrors.Internal("Failed to update shoot networking filter disabled in %s cluster: %s", runtimeID, err)
 	}
 
-	return ws.updateSucceeded(res, fmt.Sprintf("Failed to update Kubernetes version in %s cluster: %s", runtimeID, err))
+	return ws.updateSucceeded(res, fmt.Sprintf("Failed to update shoot networking filter disabled in %s cluster: %s", runtimeID, err))
 }
 
-func (ws writeSession) UpdateShootNetworkingFilterDisabled(runtimeID string, shootNetworkingFilterDisabled *bool) dberrors.Error {
-	res, err := ws.update("gardener_config").
+func (ws writeSession) InsertGardenerConfigChanges(changes []model.GardenerConfigChange) dberrors.Error {
+	for _, change := range changes {
+		_, err := ws.insertInto("gardener_config_history").
+			Pair("id", change.ID).
+			Pair("cluster_id", change.ClusterID).
+			Pair("field", change.Field).
+			Pair("old_value", change.OldValue).
+			Pair("new_value", change.NewValue).
+			Pair("changed_at", change.ChangedAt).
+			Exec()
+
+		if err != nil {
+			return dberrors.Internal("Failed to insert record to gardener_config_history table: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// ReplaceShootDrifts stores the drift detected in the last reconciliation, drift which is no longer detected is removed
+func (ws writeSession) ReplaceShootDrifts(runtimeID string, drifts []model.ShootDrift) dberrors.Error {
+	_, err := ws.deleteFrom("shoot_drift").
 		Where(dbr.Eq("cluster_id", runtimeID)).
-		Set("shoot_networking_filter_disabled", shootNetworkingFilterDisabled).
 		Exec()
 
 	if err != nil {
-		return dberrors.Internal("Failed to update shoot networking filter disabled in %s cluster: %s", runtimeID, err)
+		return dberrors.Internal("Failed to delete drift of %s cluster: %s", runtimeID, err)
 	}
 
-	return ws.updateSucceeded(res, fmt.Sprintf("Failed to update shoot networking filter disabled in %s cluster: %s", runtimeID, err))
+	for _, drift := range drifts {
+		_, err = ws.insertInto("shoot_drift").
+			Pair("cluster_id", runtimeID).
+			Pair("field", drift.Field).
+			Pair("expected_value", drift.ExpectedValue).
+			Pair("actual_value", drift.ActualValue).
+			Pair("policy", drift.Policy).
+			Pair("detected_at", drift.DetectedAt).
+			Exec()
+
+		if err != nil {
+			return dberrors.Internal("Failed to insert record to shoot_drift table: %s", err)
+		}
+	}
+
+	return nil
 }
 
 func (ws writeSession) MarkClusterAsDeleted(runtimeID string) dberrors.Error {
@@ -481,6 +1048,22 @@ func (ws writeSession) insertInto(table string) *dbr.InsertStmt {
 	return ws.session.InsertInto(table)
 }
 
+func (ws writeSession) insertBySql(query string, value ...interface{}) *dbr.InsertStmt {
+	if ws.transaction != nil {
+		return ws.transaction.InsertBySql(query, value...)
+	}
+
+	return ws.session.InsertBySql(query, value...)
+}
+
+func (ws writeSession) selectBySql(query string, value ...interface{}) *dbr.SelectStmt {
+	if ws.transaction != nil {
+		return ws.transaction.SelectBySql(query, value...)
+	}
+
+	return ws.session.SelectBySql(query, value...)
+}
+
 func (ws writeSession) deleteFrom(table string) *dbr.DeleteStmt {
 	if ws.transaction != nil {
 		return ws.transaction.DeleteFrom(table)
@@ -0,0 +1,326 @@
+package dbsession
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gocraft/dbr/v2"
+	"github.com/google/uuid"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/database"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	schemaFilePath = "../../../../assets/database/provisioner.sql"
+	queueName      = "provisioning"
+)
+
+func TestWriteSession_OperationQueue(t *testing.T) {
+	ctx := context.Background()
+
+	containerCleanupFunc, connString, err := testutils.InitTestDBContainer(t, ctx)
+	require.NoError(t, err)
+	defer containerCleanupFunc()
+
+	connection, err := database.InitializeDatabaseConnection(connString, 5)
+	require.NoError(t, err)
+	defer testutils.CloseDatabase(t, connection)
+
+	err = database.SetupSchema(connection, schemaFilePath)
+	require.NoError(t, err)
+
+	factory, err := NewFactory(connection, "qbl92bqtl6zshtjb4bvbwwc2qk7vtw2d")
+	require.NoError(t, err)
+
+	t.Run("should claim due operation and skip it while it is claimed by other owner", func(t *testing.T) {
+		// given
+		operationID := insertOperation(t, connection)
+		require.Nil(t, factory.NewWriteSession().EnqueueOperation(queueName, operationID, model.NormalPriority))
+		defer deleteQueuedOperation(t, factory, operationID)
+
+		// when
+		claimed, dberr := factory.NewWriteSession().ClaimQueuedOperation(queueName, "replica-1", 0, time.Minute)
+
+		// then
+		require.Nil(t, dberr)
+		assert.Equal(t, operationID, claimed.OperationID)
+
+		_, dberr = factory.NewWriteSession().ClaimQueuedOperation(queueName, "replica-2", 0, time.Minute)
+		require.NotNil(t, dberr)
+		assert.Equal(t, dberrors.CodeNotFound, dberr.Code())
+	})
+
+	t.Run("should take over operation after the claim expires", func(t *testing.T) {
+		// given
+		operationID := insertOperation(t, connection)
+		require.Nil(t, factory.NewWriteSession().EnqueueOperation(queueName, operationID, model.NormalPriority))
+		defer deleteQueuedOperation(t, factory, operationID)
+
+		_, dberr := factory.NewWriteSession().ClaimQueuedOperation(queueName, "replica-1", 0, time.Second)
+		require.Nil(t, dberr)
+
+		// when
+		var claimed model.QueuedOperation
+		assert.Eventually(t, func() bool {
+			claimed, dberr = factory.NewWriteSession().ClaimQueuedOperation(queueName, "replica-2", 0, time.Minute)
+			return dberr == nil
+		}, 5*time.Second, 200*time.Millisecond)
+
+		// then
+		assert.Equal(t, operationID, claimed.OperationID)
+
+		dberr = factory.NewWriteSession().RescheduleQueuedOperation(operationID, "replica-1", 0, 1)
+		require.NotNil(t, dberr, "previous owner should not reschedule operation")
+		assert.Equal(t, dberrors.CodeNotFound, dberr.Code())
+
+		dberr = factory.NewWriteSession().DeleteClaimedQueuedOperation(operationID, "replica-1")
+		require.NotNil(t, dberr, "previous owner should not delete operation")
+		assert.Equal(t, dberrors.CodeNotFound, dberr.Code())
+	})
+
+	t.Run("should reschedule operation relative to the end of the long running claim", func(t *testing.T) {
+		// given
+		operationID := insertOperation(t, connection)
+		require.Nil(t, factory.NewWriteSession().EnqueueOperation(queueName, operationID, model.NormalPriority))
+		defer deleteQueuedOperation(t, factory, operationID)
+
+		session := factory.NewWriteSession()
+		_, dberr := session.ClaimQueuedOperation(queueName, "replica-1", 0, time.Minute)
+		require.Nil(t, dberr)
+
+		// Operation stays claimed while it is executed
+		time.Sleep(3 * time.Second)
+		require.Nil(t, session.ExtendQueuedOperationClaim(operationID, "replica-1", time.Minute))
+
+		// when
+		dberr = session.RescheduleQueuedOperation(operationID, "replica-1", 2*time.Second, 1)
+		require.Nil(t, dberr)
+
+		// then
+		_, dberr = factory.NewWriteSession().ClaimQueuedOperation(queueName, "replica-2", 0, time.Minute)
+		require.NotNil(t, dberr, "operation should not be due before the delay passes")
+		assert.Equal(t, dberrors.CodeNotFound, dberr.Code())
+
+		assert.Eventually(t, func() bool {
+			claimed, dberr := factory.NewWriteSession().ClaimQueuedOperation(queueName, "replica-2", 0, time.Minute)
+			return dberr == nil && claimed.OperationID == operationID && claimed.Attempts == 1
+		}, 5*time.Second, 200*time.Millisecond)
+	})
+}
+
+func TestWriteSession_OperationLease(t *testing.T) {
+	ctx := context.Background()
+
+	containerCleanupFunc, connString, err := testutils.InitTestDBContainer(t, ctx)
+	require.NoError(t, err)
+	defer containerCleanupFunc()
+
+	connection, err := database.InitializeDatabaseConnection(connString, 5)
+	require.NoError(t, err)
+	defer testutils.CloseDatabase(t, connection)
+
+	err = database.SetupSchema(connection, schemaFilePath)
+	require.NoError(t, err)
+
+	factory, err := NewFactory(connection, "qbl92bqtl6zshtjb4bvbwwc2qk7vtw2d")
+	require.NoError(t, err)
+
+	t.Run("should not take over lease before it expires", func(t *testing.T) {
+		// given
+		operationID := insertOperation(t, connection)
+		session := factory.NewWriteSession()
+
+		acquired, dberr := session.AcquireOperationLease(operationID, "owner-1", 2*time.Second)
+		require.Nil(t, dberr)
+		require.True(t, acquired)
+
+		// when
+		acquired, dberr = session.AcquireOperationLease(operationID, "owner-2", 2*time.Second)
+
+		// then
+		require.Nil(t, dberr)
+		assert.False(t, acquired)
+
+		assert.Eventually(t, func() bool {
+			acquired, dberr := session.AcquireOperationLease(operationID, "owner-2", 2*time.Second)
+			return dberr == nil && acquired
+		}, 5*time.Second, 200*time.Millisecond)
+	})
+
+	t.Run("should extend lease from the time of renewal", func(t *testing.T) {
+		// given
+		operationID := insertOperation(t, connection)
+		session := factory.NewWriteSession()
+
+		acquired, dberr := session.AcquireOperationLease(operationID, "owner-1", 2*time.Second)
+		require.Nil(t, dberr)
+		require.True(t, acquired)
+
+		time.Sleep(time.Second)
+
+		// when
+		dberr = session.RenewOperationLeases("owner-1", 2*time.Second)
+		require.Nil(t, dberr)
+
+		// then
+		time.Sleep(1500 * time.Millisecond)
+		acquired, dberr = session.AcquireOperationLease(operationID, "owner-2", 2*time.Second)
+		require.Nil(t, dberr)
+		assert.False(t, acquired, "renewed lease should not expire before TTL passes since renewal")
+	})
+
+	t.Run("should list operations in progress without lease held by the owner or other replica", func(t *testing.T) {
+		// given
+		withoutLease := insertOperation(t, connection)
+		ownedLease := insertOperation(t, connection)
+		otherLease := insertOperation(t, connection)
+		expiredLease := insertOperation(t, connection)
+		session := factory.NewWriteSession()
+
+		for operationID, owner := range map[string]string{ownedLease: "owner-1", otherLease: "owner-2", expiredLease: "owner-3"} {
+			acquired, dberr := session.AcquireOperationLease(operationID, owner, time.Second)
+			require.Nil(t, dberr)
+			require.True(t, acquired)
+		}
+		require.Nil(t, session.RenewOperationLeases("owner-2", time.Minute))
+
+		time.Sleep(1500 * time.Millisecond)
+
+		// when
+		operations, dberr := factory.NewReadSession().ListInProgressOperationsWithoutLease("owner-1")
+
+		// then
+		require.Nil(t, dberr)
+		ids := make([]string, 0, len(operations))
+		for _, operation := range operations {
+			ids = append(ids, operation.ID)
+		}
+		assert.Contains(t, ids, withoutLease)
+		assert.Contains(t, ids, expiredLease)
+		assert.NotContains(t, ids, ownedLease)
+		assert.NotContains(t, ids, otherLease)
+	})
+}
+
+func TestWriteSession_AdoptedGardenerConfig(t *testing.T) {
+	ctx := context.Background()
+
+	containerCleanupFunc, connString, err := testutils.InitTestDBContainer(t, ctx)
+	require.NoError(t, err)
+	defer containerCleanupFunc()
+
+	connection, err := database.InitializeDatabaseConnection(connString, 5)
+	require.NoError(t, err)
+	defer testutils.CloseDatabase(t, connection)
+
+	err = database.SetupSchema(connection, schemaFilePath)
+	require.NoError(t, err)
+
+	factory, err := NewFactory(connection, "qbl92bqtl6zshtjb4bvbwwc2qk7vtw2d")
+	require.NoError(t, err)
+
+	t.Run("should lock last operation only within transaction", func(t *testing.T) {
+		// given
+		operationID := insertOperation(t, connection)
+		clusterID := insertGardenerConfig(t, connection, operationID)
+
+		// when
+		_, dberr := factory.NewWriteSession().LockLastOperation(clusterID)
+
+		// then
+		require.NotNil(t, dberr)
+
+		transaction, dberr := factory.NewSessionWithinTransaction()
+		require.Nil(t, dberr)
+		defer transaction.RollbackUnlessCommitted()
+
+		operation, dberr := transaction.LockLastOperation(clusterID)
+		require.Nil(t, dberr)
+		assert.Equal(t, operationID, operation.ID)
+	})
+
+	t.Run("should update only adopted columns and keep worker pools", func(t *testing.T) {
+		// given
+		operationID := insertOperation(t, connection)
+		clusterID := insertGardenerConfig(t, connection, operationID)
+
+		transaction, dberr := factory.NewSessionWithinTransaction()
+		require.Nil(t, dberr)
+		defer transaction.RollbackUnlessCommitted()
+
+		// when
+		dberr = transaction.UpdateAdoptedGardenerConfig(model.GardenerConfig{
+			ClusterID:         clusterID,
+			KubernetesVersion: "1.28.2",
+			MachineType:       "m5.2xlarge",
+		}, []string{"kubernetesVersion"})
+		require.Nil(t, dberr)
+		require.Nil(t, transaction.Commit())
+
+		// then
+		var kubernetesVersion, machineType string
+		require.NoError(t, connection.QueryRow("SELECT kubernetes_version, machine_type FROM gardener_config WHERE cluster_id = $1", clusterID).Scan(&kubernetesVersion, &machineType))
+		assert.Equal(t, "1.28.2", kubernetesVersion)
+		assert.Equal(t, "m5.xlarge", machineType)
+
+		var workerPools int
+		require.NoError(t, connection.QueryRow("SELECT count(*) FROM worker_pool WHERE gardener_config_id = (SELECT id FROM gardener_config WHERE cluster_id = $1)", clusterID).Scan(&workerPools))
+		assert.Equal(t, 1, workerPools)
+	})
+
+	t.Run("should return error when field cannot be adopted", func(t *testing.T) {
+		// given
+		operationID := insertOperation(t, connection)
+		clusterID := insertGardenerConfig(t, connection, operationID)
+
+		// when
+		dberr := factory.NewWriteSession().UpdateAdoptedGardenerConfig(model.GardenerConfig{ClusterID: clusterID}, []string{"region"})
+
+		// then
+		require.NotNil(t, dberr)
+	})
+}
+
+func insertOperation(t *testing.T, connection *dbr.Connection) string {
+	clusterID := uuid.New().String()
+	operationID := uuid.New().String()
+
+	_, err := connection.Exec("INSERT INTO cluster (id, tenant, creation_timestamp, is_kubeconfig_encrypted) VALUES ($1, 'tenant', now(), false)", clusterID)
+	require.NoError(t, err)
+
+	_, err = connection.Exec("INSERT INTO operation (id, type, state, start_timestamp, cluster_id, stage, err_message, reason, component) "+
+		"VALUES ($1, $2, $3, now(), $4, $5, '', '', '')",
+		operationID, string(model.Provision), string(model.InProgress), clusterID, string(model.WaitingForClusterCreation))
+	require.NoError(t, err)
+
+	return operationID
+}
+
+func deleteQueuedOperation(t *testing.T, factory Factory, operationID string) {
+	require.Nil(t, factory.NewWriteSession().DeleteQueuedOperation(operationID))
+}
+
+// insertGardenerConfig stores the configuration with single worker pool for the cluster of the operation
+func insertGardenerConfig(t *testing.T, connection *dbr.Connection, operationID string) string {
+	var clusterID string
+	require.NoError(t, connection.QueryRow("SELECT cluster_id FROM operation WHERE id = $1", operationID).Scan(&clusterID))
+
+	configID := uuid.New().String()
+	_, err := connection.Exec("INSERT INTO gardener_config (id, cluster_id, name, project_name, kubernetes_version, machine_type, region, provider, seed, target_secret, worker_cidr, "+
+		"auto_scaler_min, auto_scaler_max, max_surge, max_unavailable, enable_kubernetes_version_auto_update, enable_machine_image_version_auto_update, eu_access) "+
+		"VALUES ($1, $2, 'shoot', 'project', '1.27.5', 'm5.xlarge', 'eu-central-1', 'aws', 'aws-eu1', 'secret', '10.250.0.0/16', 1, 3, 1, 0, false, false, false)",
+		configID, clusterID)
+	require.NoError(t, err)
+
+	_, err = connection.Exec("INSERT INTO worker_pool (id, gardener_config_id, name, machine_type, auto_scaler_min, auto_scaler_max, max_surge, max_unavailable) "+
+		"VALUES ($1, $2, 'spot', 'm5.large', 1, 5, 1, 0)",
+		uuid.New().String(), configID)
+	require.NoError(t, err)
+
+	return clusterID
+}
@@ -0,0 +1,46 @@
+package provisioning
+
+import (
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+)
+
+// Quotas limit the number of active Runtimes, zero means no limit
+type Quotas struct {
+	MaxRuntimesPerTenant     int `envconfig:"default=0"`
+	MaxRuntimesPerSubAccount int `envconfig:"default=0"`
+}
+
+func (q Quotas) enabled() bool {
+	return q.MaxRuntimesPerTenant > 0 || q.MaxRuntimesPerSubAccount > 0
+}
+
+// check has to be called within the transaction inserting the Runtime, the lock makes concurrent requests of the tenant wait for its end
+func (q Quotas) check(session dbsession.WriteSession, tenant, subAccount string) apperrors.AppError {
+	dberr := session.LockTenantRuntimes(tenant)
+	if dberr != nil {
+		return dberr.Append("Failed to verify Runtime quota of tenant %s", tenant)
+	}
+
+	if q.MaxRuntimesPerTenant > 0 {
+		count, dberr := session.CountActiveRuntimes(tenant, nil)
+		if dberr != nil {
+			return dberr.Append("Failed to verify Runtime quota of tenant %s", tenant)
+		}
+		if count >= q.MaxRuntimesPerTenant {
+			return apperrors.QuotaExceeded("Tenant %s reached the limit of %d active Runtimes", tenant, q.MaxRuntimesPerTenant)
+		}
+	}
+
+	if q.MaxRuntimesPerSubAccount > 0 && subAccount != "" {
+		count, dberr := session.CountActiveRuntimes(tenant, &subAccount)
+		if dberr != nil {
+			return dberr.Append("Failed to verify Runtime quota of sub account %s", subAccount)
+		}
+		if count >= q.MaxRuntimesPerSubAccount {
+			return apperrors.QuotaExceeded("Sub account %s reached the limit of %d active Runtimes", subAccount, q.MaxRuntimesPerSubAccount)
+		}
+	}
+
+	return nil
+}
@@ -1,15 +1,19 @@
 package provisioning
 
 import (
+	"context"
+	"fmt"
 	"time"
 
 	gardener_Types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/hashicorp/go-version"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/events"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/queue"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/tracing"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
 	uuid "github.com/kyma-project/control-plane/components/provisioner/internal/uuid"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
@@ -22,19 +26,31 @@ type DynamicKubeconfigProvider interface {
 
 //go:generate mockery --name=Service
 type Service interface {
-	ProvisionRuntime(config gqlschema.ProvisionRuntimeInput, tenant, subAccount string) (*gqlschema.OperationStatus, apperrors.AppError)
-	DeprovisionRuntime(id string) (string, apperrors.AppError)
-	UpgradeGardenerShoot(id string, input gqlschema.UpgradeShootInput) (*gqlschema.OperationStatus, apperrors.AppError)
-	ReconnectRuntimeAgent(id string) (string, apperrors.AppError)
-	RuntimeStatus(id string) (*gqlschema.RuntimeStatus, apperrors.AppError)
-	RuntimeOperationStatus(id string) (*gqlschema.OperationStatus, apperrors.AppError)
+	ProvisionRuntime(ctx context.Context, config gqlschema.ProvisionRuntimeInput, tenant, subAccount string) (*gqlschema.OperationStatus, apperrors.AppError)
+	DryRunProvisionRuntime(ctx context.Context, config gqlschema.ProvisionRuntimeInput, tenant, subAccount string) (*gqlschema.OperationStatus, apperrors.AppError)
+	DeprovisionRuntime(ctx context.Context, id string, priority *gqlschema.OperationPriority) (string, apperrors.AppError)
+	UpgradeGardenerShoot(ctx context.Context, id string, input gqlschema.UpgradeShootInput) (*gqlschema.OperationStatus, apperrors.AppError)
+	DryRunUpgradeGardenerShoot(ctx context.Context, id string, input gqlschema.UpgradeShootInput) (*gqlschema.OperationStatus, apperrors.AppError)
+	HibernateCluster(ctx context.Context, id string, priority *gqlschema.OperationPriority) (*gqlschema.OperationStatus, apperrors.AppError)
+	WakeUpCluster(ctx context.Context, id string, priority *gqlschema.OperationPriority) (*gqlschema.OperationStatus, apperrors.AppError)
+	CancelOperation(ctx context.Context, id string) (*gqlschema.OperationStatus, apperrors.AppError)
+	RetryOperation(ctx context.Context, id string) (*gqlschema.OperationStatus, apperrors.AppError)
+	ReconnectRuntimeAgent(ctx context.Context, id string) (string, apperrors.AppError)
+	RuntimeStatus(ctx context.Context, id string) (*gqlschema.RuntimeStatus, apperrors.AppError)
+	RuntimeOperationStatus(ctx context.Context, id string) (*gqlschema.OperationStatus, apperrors.AppError)
+	ListRuntimes(ctx context.Context, filter *gqlschema.RuntimeFilterInput, first *int, after *string) (*gqlschema.RuntimeSummaryPage, apperrors.AppError)
+	RuntimeOperations(ctx context.Context, runtimeID string, types []gqlschema.OperationType, states []gqlschema.OperationState, first *int, after *string) (*gqlschema.OperationHistoryPage, apperrors.AppError)
 }
 
 //go:generate mockery --name=Provisioner
 type Provisioner interface {
-	ProvisionCluster(cluster model.Cluster, operationId string) apperrors.AppError
-	DeprovisionCluster(cluster model.Cluster, operationId string) (model.Operation, apperrors.AppError)
-	UpgradeCluster(clusterID string, upgradeConfig model.GardenerConfig) apperrors.AppError
+	ProvisionCluster(ctx context.Context, cluster model.Cluster, operationId string) apperrors.AppError
+	DeprovisionCluster(ctx context.Context, cluster model.Cluster, operationId string) (model.Operation, apperrors.AppError)
+	UpgradeCluster(ctx context.Context, clusterID string, upgradeConfig model.GardenerConfig) apperrors.AppError
+	DryRunProvisionCluster(ctx context.Context, cluster model.Cluster) (model.DryRunResult, apperrors.AppError)
+	DryRunUpgradeCluster(ctx context.Context, clusterID string, upgradeConfig model.GardenerConfig) (model.DryRunResult, apperrors.AppError)
+	HibernateCluster(ctx context.Context, clusterID string, gardenerConfig model.GardenerConfig) apperrors.AppError
+	WakeUpCluster(ctx context.Context, clusterID string, gardenerConfig model.GardenerConfig) apperrors.AppError
 }
 
 //go:generate mockery --name=ShootProvider
@@ -57,6 +73,10 @@ type service struct {
 	upgradeQueue        queue.OperationQueue
 	shootUpgradeQueue   queue.OperationQueue
 	hibernationQueue    queue.OperationQueue
+	wakeUpQueue         queue.OperationQueue
+
+	quotas    Quotas
+	publisher events.Publisher
 }
 
 func NewProvisioningService(
@@ -69,10 +89,13 @@ func NewProvisioningService(
 	provisioningQueue queue.OperationQueue,
 	deprovisioningQueue queue.OperationQueue,
 	shootUpgradeQueue queue.OperationQueue,
+	hibernationQueue queue.OperationQueue,
+	wakeUpQueue queue.OperationQueue,
 	dynamicKubeconfigProvider DynamicKubeconfigProvider,
-
+	quotas Quotas,
+	publisher events.Publisher,
 ) Service {
-	return &service{
+	return tracedService{service: &service{
 		inputConverter:            inputConverter,
 		graphQLConverter:          graphQLConverter,
 		dbSessionFactory:          factory,
@@ -81,12 +104,16 @@ func NewProvisioningService(
 		provisioningQueue:         provisioningQueue,
 		deprovisioningQueue:       deprovisioningQueue,
 		shootUpgradeQueue:         shootUpgradeQueue,
+		hibernationQueue:          hibernationQueue,
+		wakeUpQueue:               wakeUpQueue,
 		shootProvider:             shootProvider,
 		dynamicKubeconfigProvider: dynamicKubeconfigProvider,
-	}
+		quotas:                    quotas,
+		publisher:                 publisher,
+	}}
 }
 
-func (r *service) ProvisionRuntime(config gqlschema.ProvisionRuntimeInput, tenant, subAccount string) (*gqlschema.OperationStatus, apperrors.AppError) {
+func (r *service) ProvisionRuntime(ctx context.Context, config gqlschema.ProvisionRuntimeInput, tenant, subAccount string) (*gqlschema.OperationStatus, apperrors.AppError) {
 
 	var runtimeID string
 
@@ -98,19 +125,36 @@ func (r *service) ProvisionRuntime(config gqlschema.ProvisionRuntimeInput, tenan
 		return nil, err
 	}
 
-	dbSession, dberr := r.dbSessionFactory.NewSessionWithinTransaction()
+	dbSession, dberr := r.sessions(ctx).NewSessionWithinTransaction()
 	if dberr != nil {
 		return nil, dberr
 	}
 	defer dbSession.RollbackUnlessCommitted()
 
+	if r.quotas.enabled() {
+		err = r.quotas.check(dbSession, tenant, subAccount)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Try to set provisioning started before triggering it (which is hard to interrupt) to verify all unique constraints
-	operation, dberr := r.setProvisioningStarted(dbSession, runtimeID, cluster)
+	operation, dberr := r.setProvisioningStarted(ctx, dbSession, runtimeID, cluster, priorityFromInput(config.Priority))
+	if dberr != nil {
+		return nil, dberr
+	}
+
+	dberr = r.provisioningQueue.Schedule(dbSession, operation.ID, operation.Priority)
+	if dberr != nil {
+		return nil, dberr.Append("Failed to queue provisioning operation")
+	}
+
+	dberr = linkIdempotencyKey(ctx, dbSession, operation.ID)
 	if dberr != nil {
 		return nil, dberr
 	}
 
-	err = r.provisioner.ProvisionCluster(cluster, operation.ID)
+	err = r.provisioner.ProvisionCluster(ctx, cluster, operation.ID)
 	if err != nil {
 		return nil, err.Append("Failed to start provisioning")
 	}
@@ -121,13 +165,31 @@ func (r *service) ProvisionRuntime(config gqlschema.ProvisionRuntimeInput, tenan
 	}
 
 	log.Infof("KymaConfig not provided. Starting provisioning steps for runtime %s without installation", cluster.ID)
-	r.provisioningQueue.Add(operation.ID)
+	r.provisioningQueue.Add(operation.ID, operation.Priority)
 
 	return r.graphQLConverter.OperationStatusToGQLOperationStatus(operation), nil
 }
 
-func (r *service) DeprovisionRuntime(id string) (string, apperrors.AppError) {
-	session := r.dbSessionFactory.NewReadWriteSession()
+// DryRunProvisionRuntime returns Shoot which would be created for the Runtime, nothing is stored in the database
+func (r *service) DryRunProvisionRuntime(ctx context.Context, config gqlschema.ProvisionRuntimeInput, tenant, subAccount string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	runtimeID := r.uuidGenerator.New()
+	log.Infof("Starting dry run of provisioning for Runtime: %s ", runtimeID)
+
+	cluster, err := r.inputConverter.ProvisioningInputToCluster(runtimeID, config, tenant, subAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.provisioner.DryRunProvisionCluster(ctx, cluster)
+	if err != nil {
+		return nil, err.Append("Failed to dry run provisioning")
+	}
+
+	return r.graphQLConverter.DryRunResultToGQLOperationStatus(runtimeID, model.Provision, result), nil
+}
+
+func (r *service) DeprovisionRuntime(ctx context.Context, id string, priority *gqlschema.OperationPriority) (string, apperrors.AppError) {
+	session := r.sessions(ctx).NewReadSession()
 
 	appErr := r.verifyLastOperationFinished(session, id)
 	if appErr != nil {
@@ -139,55 +201,137 @@ func (r *service) DeprovisionRuntime(id string) (string, apperrors.AppError) {
 		return "", dberr
 	}
 
-	operation, appErr := r.provisioner.DeprovisionCluster(cluster, r.uuidGenerator.New())
+	operation, appErr := r.provisioner.DeprovisionCluster(ctx, cluster, r.uuidGenerator.New())
 	if appErr != nil {
 		return "", apperrors.Internal("Failed to start deprovisioning: %s", appErr.Error()).SetComponent(appErr.Component()).SetReason(appErr.Reason())
 	}
+	operation.Priority = priorityFromInput(priority)
+	operation.TraceContext = tracing.Inject(ctx)
+
+	txSession, dberr := r.sessions(ctx).NewSessionWithinTransaction()
+	if dberr != nil {
+		return "", dberr
+	}
+	defer txSession.RollbackUnlessCommitted()
+
+	dberr = txSession.InsertOperation(operation)
+	if dberr != nil {
+		return "", dberr
+	}
+
+	dberr = r.deprovisioningQueue.Schedule(txSession, operation.ID, operation.Priority)
+	if dberr != nil {
+		return "", dberr.Append("Failed to queue deprovisioning operation")
+	}
+
+	dberr = linkIdempotencyKey(ctx, txSession, operation.ID)
+	if dberr != nil {
+		return "", dberr
+	}
 
-	dberr = session.InsertOperation(operation)
+	dberr = txSession.Commit()
 	if dberr != nil {
 		return "", dberr
 	}
 
 	log.Infof("Starting deprovisioning steps for runtime %s without installation", cluster.ID)
-	r.deprovisioningQueue.Add(operation.ID)
+	r.deprovisioningQueue.Add(operation.ID, operation.Priority)
 
 	return operation.ID, nil
 }
 
-func (r *service) UpgradeGardenerShoot(runtimeID string, input gqlschema.UpgradeShootInput) (*gqlschema.OperationStatus, apperrors.AppError) {
+func (r *service) UpgradeGardenerShoot(ctx context.Context, runtimeID string, input gqlschema.UpgradeShootInput) (*gqlschema.OperationStatus, apperrors.AppError) {
 	log.Infof("Starting Upgrade of Gardener Shoot for Runtime '%s'...", runtimeID)
 
+	cluster, gardenerConfig, err := r.prepareGardenerShootUpgrade(ctx, runtimeID, input)
+	if err != nil {
+		return &gqlschema.OperationStatus{}, err
+	}
+
+	txSession, dbErr := r.sessions(ctx).NewSessionWithinTransaction()
+	if dbErr != nil {
+		return &gqlschema.OperationStatus{}, apperrors.Internal("Failed to start database transaction: %s", dbErr.Error())
+	}
+	defer txSession.RollbackUnlessCommitted()
+
+	operation, gardError := r.setGardenerShootUpgradeStarted(ctx, txSession, cluster, gardenerConfig, input.Administrators, priorityFromInput(input.Priority))
+	if gardError != nil {
+		return &gqlschema.OperationStatus{}, apperrors.Internal("Failed to set shoot upgrade started: %s", gardError.Error())
+	}
+
+	dbErr = r.shootUpgradeQueue.Schedule(txSession, operation.ID, operation.Priority)
+	if dbErr != nil {
+		return &gqlschema.OperationStatus{}, apperrors.Internal("Failed to queue shoot upgrade operation: %s", dbErr.Error())
+	}
+
+	dbErr = linkIdempotencyKey(ctx, txSession, operation.ID)
+	if dbErr != nil {
+		return &gqlschema.OperationStatus{}, dbErr
+	}
+
+	err = r.provisioner.UpgradeCluster(ctx, cluster.ID, gardenerConfig)
+	if err != nil {
+		return &gqlschema.OperationStatus{}, apperrors.Internal("Failed to upgrade Cluster: %s", err.Error())
+	}
+
+	dbErr = txSession.Commit()
+	if dbErr != nil {
+		return &gqlschema.OperationStatus{}, apperrors.Internal("Failed to commit upgrade transaction: %s", dbErr.Error())
+	}
+
+	r.shootUpgradeQueue.Add(operation.ID, operation.Priority)
+
+	return r.graphQLConverter.OperationStatusToGQLOperationStatus(operation), nil
+}
+
+// DryRunUpgradeGardenerShoot returns changes which would be applied to the Shoot, neither the Shoot nor the database is modified
+func (r *service) DryRunUpgradeGardenerShoot(ctx context.Context, runtimeID string, input gqlschema.UpgradeShootInput) (*gqlschema.OperationStatus, apperrors.AppError) {
+	log.Infof("Starting dry run of Upgrade of Gardener Shoot for Runtime '%s'...", runtimeID)
+
+	cluster, gardenerConfig, err := r.prepareGardenerShootUpgrade(ctx, runtimeID, input)
+	if err != nil {
+		return &gqlschema.OperationStatus{}, err
+	}
+
+	result, err := r.provisioner.DryRunUpgradeCluster(ctx, cluster.ID, gardenerConfig)
+	if err != nil {
+		return &gqlschema.OperationStatus{}, err.Append("Failed to dry run Cluster upgrade")
+	}
+
+	return r.graphQLConverter.DryRunResultToGQLOperationStatus(cluster.ID, model.UpgradeShoot, result), nil
+}
+
+func (r *service) prepareGardenerShootUpgrade(ctx context.Context, runtimeID string, input gqlschema.UpgradeShootInput) (model.Cluster, model.GardenerConfig, apperrors.AppError) {
 	if input.GardenerConfig == nil {
-		return &gqlschema.OperationStatus{}, apperrors.Internal("Error: Gardener config is nil")
+		return model.Cluster{}, model.GardenerConfig{}, apperrors.Internal("Error: Gardener config is nil")
 	}
 
-	session := r.dbSessionFactory.NewReadSession()
+	session := r.sessions(ctx).NewReadSession()
 
 	err := r.verifyLastOperationFinished(session, runtimeID)
 	if err != nil {
-		return &gqlschema.OperationStatus{}, err
+		return model.Cluster{}, model.GardenerConfig{}, err
 	}
 
 	cluster, dberr := session.GetCluster(runtimeID)
 	if dberr != nil {
-		return &gqlschema.OperationStatus{}, apperrors.Internal("Failed to find shoot cluster to upgrade in database: %s", dberr.Error())
+		return model.Cluster{}, model.GardenerConfig{}, apperrors.Internal("Failed to find shoot cluster to upgrade in database: %s", dberr.Error())
 	}
 
 	gardenerConfig, err := r.inputConverter.UpgradeShootInputToGardenerConfig(*input.GardenerConfig, cluster.ClusterConfig)
 	if err != nil {
-		return &gqlschema.OperationStatus{}, err.Append("Failed to convert GardenerClusterUpgradeConfig: %s", err.Error())
+		return model.Cluster{}, model.GardenerConfig{}, err.Append("Failed to convert GardenerClusterUpgradeConfig: %s", err.Error())
 	}
 
 	shoot, err := r.shootProvider.Get(runtimeID, cluster.Tenant)
 	if err != nil {
-		return &gqlschema.OperationStatus{}, err.Append("Failed to get shoot")
+		return model.Cluster{}, model.GardenerConfig{}, err.Append("Failed to get shoot")
 	}
 
 	// This is a workaround for a problem with Kubernetes auto upgrade. If Kubernetes gets updated the current Kubernetes version is obtained for the shoot and stored in the database.
 	shouldTakeShootKubernetesVersion, err := isVersionHigher(shoot.Spec.Kubernetes.Version, gardenerConfig.KubernetesVersion)
 	if err != nil {
-		return &gqlschema.OperationStatus{}, err.Append("Failed to check if the shoot kubernetes version is higher than the config one")
+		return model.Cluster{}, model.GardenerConfig{}, err.Append("Failed to check if the shoot kubernetes version is higher than the config one")
 	}
 	if shouldTakeShootKubernetesVersion {
 		log.Infof("Kubernetes version in shoot was higher than the version provided in UpgradeGardenerShoot. Version fetched from the shoot will be used :%s.", shoot.Spec.Kubernetes.Version)
@@ -204,30 +348,112 @@ func (r *service) UpgradeGardenerShoot(runtimeID string, input gqlschema.Upgrade
 	// Validate provider specific changes to the shoot
 	err = gardenerConfig.GardenerProviderConfig.ValidateShootConfigChange(&shoot)
 	if err != nil {
-		return &gqlschema.OperationStatus{}, err.Append("Invalid gardener provider config change")
+		return model.Cluster{}, model.GardenerConfig{}, err.Append("Invalid gardener provider config change")
+	}
+
+	return cluster, gardenerConfig, nil
+}
+
+func (r *service) HibernateCluster(ctx context.Context, runtimeID string, priority *gqlschema.OperationPriority) (*gqlschema.OperationStatus, apperrors.AppError) {
+	log.Infof("Starting hibernation of Runtime '%s'...", runtimeID)
+
+	session := r.sessions(ctx).NewReadSession()
+
+	err := r.verifyLastOperationFinished(session, runtimeID)
+	if err != nil {
+		return &gqlschema.OperationStatus{}, err
+	}
+
+	cluster, dberr := session.GetCluster(runtimeID)
+	if dberr != nil {
+		return &gqlschema.OperationStatus{}, apperrors.Internal("Failed to find shoot cluster to hibernate in database: %s", dberr.Error())
+	}
+
+	shoot, err := r.shootProvider.Get(runtimeID, cluster.Tenant)
+	if err != nil {
+		return &gqlschema.OperationStatus{}, err.Append("Failed to get shoot")
+	}
+
+	hibernationStatus := getHibernationStatus(shoot)
+	if hibernationStatus.Hibernated {
+		return &gqlschema.OperationStatus{}, apperrors.BadRequest("Runtime %s is already hibernated", runtimeID)
+	}
+	if !hibernationStatus.HibernationPossible {
+		return &gqlschema.OperationStatus{}, apperrors.BadRequest("Hibernation of Runtime %s is not possible", runtimeID)
+	}
+
+	return r.startHibernationOperation(ctx, cluster, model.Hibernate, model.WaitForHibernation, priorityFromInput(priority), "Starting hibernation", r.provisioner.HibernateCluster, r.hibernationQueue)
+}
+
+func (r *service) WakeUpCluster(ctx context.Context, runtimeID string, priority *gqlschema.OperationPriority) (*gqlschema.OperationStatus, apperrors.AppError) {
+	log.Infof("Starting wake up of Runtime '%s'...", runtimeID)
+
+	session := r.sessions(ctx).NewReadSession()
+
+	err := r.verifyLastOperationFinished(session, runtimeID)
+	if err != nil {
+		return &gqlschema.OperationStatus{}, err
+	}
+
+	cluster, dberr := session.GetCluster(runtimeID)
+	if dberr != nil {
+		return &gqlschema.OperationStatus{}, apperrors.Internal("Failed to find shoot cluster to wake up in database: %s", dberr.Error())
+	}
+
+	shoot, err := r.shootProvider.Get(runtimeID, cluster.Tenant)
+	if err != nil {
+		return &gqlschema.OperationStatus{}, err.Append("Failed to get shoot")
+	}
+
+	if !getHibernationStatus(shoot).Hibernated {
+		return &gqlschema.OperationStatus{}, apperrors.BadRequest("Runtime %s is not hibernated", runtimeID)
 	}
-	txSession, dbErr := r.dbSessionFactory.NewSessionWithinTransaction()
+
+	return r.startHibernationOperation(ctx, cluster, model.WakeUp, model.WaitForWakeUp, priorityFromInput(priority), "Starting wake up", r.provisioner.WakeUpCluster, r.wakeUpQueue)
+}
+
+func (r *service) startHibernationOperation(
+	ctx context.Context,
+	cluster model.Cluster,
+	operationType model.OperationType,
+	operationStage model.OperationStage,
+	priority model.OperationPriority,
+	message string,
+	setHibernation func(ctx context.Context, clusterID string, gardenerConfig model.GardenerConfig) apperrors.AppError,
+	operationQueue queue.OperationQueue) (*gqlschema.OperationStatus, apperrors.AppError) {
+
+	txSession, dbErr := r.sessions(ctx).NewSessionWithinTransaction()
 	if dbErr != nil {
 		return &gqlschema.OperationStatus{}, apperrors.Internal("Failed to start database transaction: %s", dbErr.Error())
 	}
 	defer txSession.RollbackUnlessCommitted()
 
-	operation, gardError := r.setGardenerShootUpgradeStarted(txSession, cluster, gardenerConfig, input.Administrators)
-	if gardError != nil {
-		return &gqlschema.OperationStatus{}, apperrors.Internal("Failed to set shoot upgrade started: %s", gardError.Error())
+	operation, dbErr := r.setOperationStarted(ctx, txSession, cluster.ID, operationType, operationStage, priority, time.Now(), message)
+	if dbErr != nil {
+		return &gqlschema.OperationStatus{}, apperrors.Internal("Failed to set %s operation started: %s", operationType, dbErr.Error())
+	}
+
+	dbErr = operationQueue.Schedule(txSession, operation.ID, operation.Priority)
+	if dbErr != nil {
+		return &gqlschema.OperationStatus{}, apperrors.Internal("Failed to queue %s operation: %s", operationType, dbErr.Error())
 	}
 
-	err = r.provisioner.UpgradeCluster(cluster.ID, gardenerConfig)
+	dbErr = linkIdempotencyKey(ctx, txSession, operation.ID)
+	if dbErr != nil {
+		return &gqlschema.OperationStatus{}, dbErr
+	}
+
+	err := setHibernation(ctx, cluster.ID, cluster.ClusterConfig)
 	if err != nil {
-		return &gqlschema.OperationStatus{}, apperrors.Internal("Failed to upgrade Cluster: %s", err.Error())
+		return &gqlschema.OperationStatus{}, err.Append("Failed to start %s operation", operationType)
 	}
 
 	dbErr = txSession.Commit()
 	if dbErr != nil {
-		return &gqlschema.OperationStatus{}, apperrors.Internal("Failed to commit upgrade transaction: %s", dbErr.Error())
+		return &gqlschema.OperationStatus{}, apperrors.Internal("Failed to commit %s transaction: %s", operationType, dbErr.Error())
 	}
 
-	r.shootUpgradeQueue.Add(operation.ID)
+	operationQueue.Add(operation.ID, operation.Priority)
 
 	return r.graphQLConverter.OperationStatusToGQLOperationStatus(operation), nil
 }
@@ -245,12 +471,12 @@ func (r *service) verifyLastOperationFinished(session dbsession.ReadSession, run
 	return nil
 }
 
-func (r *service) ReconnectRuntimeAgent(string) (string, apperrors.AppError) {
+func (r *service) ReconnectRuntimeAgent(context.Context, string) (string, apperrors.AppError) {
 	return "", nil
 }
 
-func (r *service) RuntimeStatus(runtimeID string) (*gqlschema.RuntimeStatus, apperrors.AppError) {
-	runtimeStatus, dberr := r.getRuntimeStatus(runtimeID)
+func (r *service) RuntimeStatus(ctx context.Context, runtimeID string) (*gqlschema.RuntimeStatus, apperrors.AppError) {
+	runtimeStatus, dberr := r.getRuntimeStatus(ctx, runtimeID)
 	if dberr != nil {
 		return nil, dberr.Append("failed to get Runtime Status")
 	}
@@ -258,8 +484,8 @@ func (r *service) RuntimeStatus(runtimeID string) (*gqlschema.RuntimeStatus, app
 	return r.graphQLConverter.RuntimeStatusToGraphQLStatus(runtimeStatus), nil
 }
 
-func (r *service) RuntimeOperationStatus(operationID string) (*gqlschema.OperationStatus, apperrors.AppError) {
-	readSession := r.dbSessionFactory.NewReadSession()
+func (r *service) RuntimeOperationStatus(ctx context.Context, operationID string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	readSession := r.sessions(ctx).NewReadSession()
 
 	operation, dberr := readSession.GetOperation(operationID)
 	if dberr != nil {
@@ -269,8 +495,313 @@ func (r *service) RuntimeOperationStatus(operationID string) (*gqlschema.Operati
 	return r.graphQLConverter.OperationStatusToGQLOperationStatus(operation), nil
 }
 
-func (r *service) getRuntimeStatus(runtimeID string) (model.RuntimeStatus, apperrors.AppError) {
-	session := r.dbSessionFactory.NewReadSession()
+func (r *service) CancelOperation(ctx context.Context, operationID string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	log.Infof("Cancelling operation '%s'...", operationID)
+
+	session := r.sessions(ctx).NewReadSession()
+
+	operation, dberr := session.GetOperation(operationID)
+	if dberr != nil {
+		return nil, dberr.Append("failed to get operation to cancel")
+	}
+
+	if operation.State != model.InProgress {
+		return nil, apperrors.BadRequest("cannot cancel operation %s in state %s", operationID, operation.State)
+	}
+
+	txSession, dberr := r.sessions(ctx).NewSessionWithinTransaction()
+	if dberr != nil {
+		return nil, apperrors.Internal("Failed to start database transaction: %s", dberr.Error())
+	}
+	defer txSession.RollbackUnlessCommitted()
+
+	dberr = txSession.CancelOperation(operationID, "Operation cancelled", time.Now())
+	if dberr != nil {
+		if dberr.Code() == dberrors.CodeNotFound {
+			return nil, apperrors.BadRequest("cannot cancel operation %s as it is no longer in progress", operationID)
+		}
+		return nil, dberr.Append("failed to cancel operation")
+	}
+
+	if operationQueue, found := r.queueForOperation(operation.Type); found {
+		dberr = operationQueue.Unschedule(txSession, operationID)
+		if dberr != nil {
+			return nil, dberr.Append("failed to remove cancelled operation from queue")
+		}
+	}
+
+	dberr = txSession.Commit()
+	if dberr != nil {
+		return nil, apperrors.Internal("Failed to commit cancel transaction: %s", dberr.Error())
+	}
+
+	operation, dberr = session.GetOperation(operationID)
+	if dberr != nil {
+		return nil, dberr.Append("failed to get cancelled operation")
+	}
+
+	r.publishOperationChanged(operation)
+
+	return r.graphQLConverter.OperationStatusToGQLOperationStatus(operation), nil
+}
+
+func (r *service) RetryOperation(ctx context.Context, operationID string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	log.Infof("Retrying operation '%s'...", operationID)
+
+	session := r.sessions(ctx).NewReadSession()
+
+	operation, dberr := session.GetOperation(operationID)
+	if dberr != nil {
+		return nil, dberr.Append("failed to get operation to retry")
+	}
+
+	if operation.State != model.Failed {
+		return nil, apperrors.BadRequest("cannot retry operation %s in state %s", operationID, operation.State)
+	}
+
+	operationQueue, found := r.queueForOperation(operation.Type)
+	if !found {
+		return nil, apperrors.BadRequest("cannot retry operation %s of type %s", operationID, operation.Type)
+	}
+
+	lastOperation, dberr := session.GetLastOperation(operation.ClusterID)
+	if dberr != nil {
+		return nil, dberr.Append("failed to get last operation")
+	}
+
+	if lastOperation.ID != operation.ID {
+		return nil, apperrors.BadRequest("cannot retry operation %s as it is not the last operation of Runtime %s", operationID, operation.ClusterID)
+	}
+
+	if operation.Type == model.Provision || operation.Type == model.ProvisionNoInstall {
+		err := r.checkShootExists(session, operation)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	upgradedConfig, found, err := r.upgradedConfigToReapply(session, operation)
+	if err != nil {
+		return nil, err
+	}
+
+	txSession, dberr := r.sessions(ctx).NewSessionWithinTransaction()
+	if dberr != nil {
+		return nil, apperrors.Internal("Failed to start database transaction: %s", dberr.Error())
+	}
+	defer txSession.RollbackUnlessCommitted()
+
+	// Failure handler rolled the configuration back while the Shoot keeps the upgraded one, so it has to be restored before the upgrade is resumed
+	if found {
+		dberr = txSession.UpdateGardenerClusterConfig(upgradedConfig)
+		if dberr != nil {
+			return nil, dberr.Append("failed to restore upgraded Gardener config")
+		}
+	}
+
+	retryTime := time.Now()
+
+	dberr = txSession.InsertOperationRetry(model.OperationRetry{
+		ID:          r.uuidGenerator.New(),
+		OperationID: operation.ID,
+		Stage:       operation.Stage,
+		Timestamp:   retryTime,
+		Message:     operation.Message,
+		LastError:   operation.LastError,
+	})
+	if dberr != nil {
+		return nil, dberr.Append("failed to record operation retry")
+	}
+
+	message := fmt.Sprintf("Operation retried. Stage %s", operation.Stage)
+
+	dberr = txSession.RetryOperation(operation.ID, message, retryTime)
+	if dberr != nil {
+		if dberr.Code() == dberrors.CodeNotFound {
+			return nil, apperrors.BadRequest("cannot retry operation %s as it is no longer failed", operationID)
+		}
+		return nil, dberr.Append("failed to retry operation")
+	}
+
+	dberr = operationQueue.Schedule(txSession, operation.ID, operation.Priority)
+	if dberr != nil {
+		return nil, dberr.Append("failed to queue retried operation")
+	}
+
+	dberr = txSession.Commit()
+	if dberr != nil {
+		return nil, apperrors.Internal("Failed to commit retry transaction: %s", dberr.Error())
+	}
+
+	operationQueue.Add(operation.ID, operation.Priority)
+
+	operation.State = model.InProgress
+	operation.Message = message
+	operation.EndTimestamp = nil
+	operation.LastTransition = &retryTime
+
+	r.publishOperationChanged(operation)
+
+	return r.graphQLConverter.OperationStatusToGQLOperationStatus(operation), nil
+}
+
+func (r *service) publishOperationChanged(operation model.Operation) {
+	r.publisher.Publish(events.OperationEvent{OperationID: operation.ID, RuntimeID: operation.ClusterID})
+}
+
+// checkShootExists rejects the retry of provisioning whose Shoot was deleted, e.g. by the failure handler, as the Shoot is only created when provisioning is started
+func (r *service) checkShootExists(session dbsession.ReadSession, operation model.Operation) apperrors.AppError {
+	cluster, dberr := session.GetCluster(operation.ClusterID)
+	if dberr != nil {
+		return dberr.Append("failed to get Runtime of operation to retry")
+	}
+
+	_, err := r.shootProvider.Get(cluster.ID, cluster.Tenant)
+	if err != nil {
+		if err.Cause() == apperrors.ShootNotFound {
+			return apperrors.BadRequest("cannot retry operation %s as Shoot of Runtime %s no longer exists, Runtime has to be deprovisioned and provisioned again", operation.ID, operation.ClusterID)
+		}
+		return err.Append("failed to get Shoot of operation to retry")
+	}
+
+	return nil
+}
+
+// upgradedConfigToReapply returns the configuration the failed Shoot upgrade was applying, it is not found for other operations
+func (r *service) upgradedConfigToReapply(session dbsession.ReadSession, operation model.Operation) (model.GardenerConfig, bool, apperrors.AppError) {
+	if operation.Type != model.UpgradeShoot {
+		return model.GardenerConfig{}, false, nil
+	}
+
+	upgradedConfig, dberr := session.GetUpgradedGardenerConfigBackup(operation.ID)
+	if dberr != nil {
+		if dberr.Code() == dberrors.CodeNotFound {
+			log.Warnf("Upgraded Gardener config of operation %s not found, retrying with the current config", operation.ID)
+			return model.GardenerConfig{}, false, nil
+		}
+		return model.GardenerConfig{}, false, dberr.Append("failed to get upgraded Gardener config")
+	}
+
+	return upgradedConfig, true, nil
+}
+
+// sessions returns factory of sessions tracing queries as part of the request
+func (r *service) sessions(ctx context.Context) dbsession.Factory {
+	return dbsession.WithContext(ctx, r.dbSessionFactory)
+}
+
+// queueForOperation returns the queue which processes operations of the given type
+func (r *service) queueForOperation(operationType model.OperationType) (queue.OperationQueue, bool) {
+	switch operationType {
+	case model.Provision:
+		return r.provisioningQueue, true
+	case model.DeprovisionNoInstall:
+		return r.deprovisioningQueue, true
+	case model.UpgradeShoot:
+		return r.shootUpgradeQueue, true
+	case model.Hibernate:
+		return r.hibernationQueue, true
+	case model.WakeUp:
+		return r.wakeUpQueue, true
+	default:
+		return nil, false
+	}
+}
+
+func (r *service) ListRuntimes(ctx context.Context, filterInput *gqlschema.RuntimeFilterInput, first *int, after *string) (*gqlschema.RuntimeSummaryPage, apperrors.AppError) {
+	filter, err := r.inputConverter.RuntimeFilterInputToFilter(filterInput)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, err := pageSize(first)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := decodeCursor(after)
+	if err != nil {
+		return nil, err
+	}
+
+	readSession := r.sessions(ctx).NewReadSession()
+
+	// One more element is fetched to find out if there is a next page
+	runtimes, dberr := readSession.ListRuntimes(filter, cursor, limit+1)
+	if dberr != nil {
+		return nil, dberr.Append("failed to list Runtimes")
+	}
+
+	hasNextPage := len(runtimes) > limit
+	if hasNextPage {
+		runtimes = runtimes[:limit]
+	}
+
+	page := &gqlschema.RuntimeSummaryPage{
+		Data:     make([]*gqlschema.RuntimeSummary, 0, len(runtimes)),
+		PageInfo: &gqlschema.PageInfo{HasNextPage: hasNextPage},
+	}
+
+	for _, runtime := range runtimes {
+		page.Data = append(page.Data, r.graphQLConverter.RuntimeStatusToGraphQLSummary(runtime))
+	}
+
+	if len(runtimes) > 0 {
+		last := runtimes[len(runtimes)-1].RuntimeConfiguration
+		page.PageInfo.EndCursor = util.PtrTo(encodeCursor(model.PageCursor{Timestamp: last.CreationTimestamp, ID: last.ID}))
+	}
+
+	return page, nil
+}
+
+func (r *service) RuntimeOperations(ctx context.Context, runtimeID string, types []gqlschema.OperationType, states []gqlschema.OperationState, first *int, after *string) (*gqlschema.OperationHistoryPage, apperrors.AppError) {
+	filter, err := r.inputConverter.OperationFilterFromInput(types, states)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, err := pageSize(first)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := decodeCursor(after)
+	if err != nil {
+		return nil, err
+	}
+
+	readSession := r.sessions(ctx).NewReadSession()
+
+	// One more element is fetched to find out if there is a next page
+	operations, dberr := readSession.ListOperations(runtimeID, filter, cursor, limit+1)
+	if dberr != nil {
+		return nil, dberr.Append("failed to list operations for Runtime %s", runtimeID)
+	}
+
+	hasNextPage := len(operations) > limit
+	if hasNextPage {
+		operations = operations[:limit]
+	}
+
+	page := &gqlschema.OperationHistoryPage{
+		Data:     make([]*gqlschema.OperationHistoryEntry, 0, len(operations)),
+		PageInfo: &gqlschema.PageInfo{HasNextPage: hasNextPage},
+	}
+
+	for _, operation := range operations {
+		page.Data = append(page.Data, r.graphQLConverter.OperationToGraphQLHistoryEntry(operation))
+	}
+
+	if len(operations) > 0 {
+		last := operations[len(operations)-1]
+		page.PageInfo.EndCursor = util.PtrTo(encodeCursor(model.PageCursor{Timestamp: last.StartTimestamp, ID: last.ID}))
+	}
+
+	return page, nil
+}
+
+func (r *service) getRuntimeStatus(ctx context.Context, runtimeID string) (model.RuntimeStatus, apperrors.AppError) {
+	session := r.sessions(ctx).NewReadSession()
 
 	operation, err := session.GetLastOperation(runtimeID)
 	if err != nil {
@@ -289,13 +820,28 @@ func (r *service) getRuntimeStatus(runtimeID string) (model.RuntimeStatus, apper
 
 	cluster.Kubeconfig = util.PtrTo(string(kubeconfig))
 
+	var hibernationStatus model.HibernationStatus
+	shoot, appErr := r.shootProvider.Get(runtimeID, cluster.Tenant)
+	if appErr != nil {
+		log.Warnf("Failed to get shoot to read hibernation status of Runtime %s: %s", runtimeID, appErr.Error())
+	} else {
+		hibernationStatus = getHibernationStatus(shoot)
+	}
+
+	drifts, err := session.ListShootDrifts(runtimeID)
+	if err != nil {
+		return model.RuntimeStatus{}, err
+	}
+
 	return model.RuntimeStatus{
 		LastOperationStatus:  operation,
 		RuntimeConfiguration: cluster,
+		HibernationStatus:    hibernationStatus,
+		Drift:                drifts,
 	}, nil
 }
 
-func (r *service) setProvisioningStarted(dbSession dbsession.WriteSession, runtimeID string, cluster model.Cluster) (model.Operation, dberrors.Error) {
+func (r *service) setProvisioningStarted(ctx context.Context, dbSession dbsession.WriteSession, runtimeID string, cluster model.Cluster, priority model.OperationPriority) (model.Operation, dberrors.Error) {
 	timestamp := time.Now()
 	cluster.CreationTimestamp = timestamp
 
@@ -309,7 +855,7 @@ func (r *service) setProvisioningStarted(dbSession dbsession.WriteSession, runti
 
 	provisioningMode := model.Provision
 
-	operation, err := r.setOperationStarted(dbSession, runtimeID, provisioningMode, model.WaitingForClusterDomain, timestamp, "Provisioning started")
+	operation, err := r.setOperationStarted(ctx, dbSession, runtimeID, provisioningMode, model.WaitingForClusterDomain, priority, timestamp, "Provisioning started")
 	if err != nil {
 		return model.Operation{}, err.Append("Failed to set provisioning started: %s")
 	}
@@ -317,7 +863,7 @@ func (r *service) setProvisioningStarted(dbSession dbsession.WriteSession, runti
 	return operation, nil
 }
 
-func (r *service) setGardenerShootUpgradeStarted(txSession dbsession.WriteSession, currentCluster model.Cluster, gardenerConfig model.GardenerConfig, administrators []string) (model.Operation, error) {
+func (r *service) setGardenerShootUpgradeStarted(ctx context.Context, txSession dbsession.WriteSession, currentCluster model.Cluster, gardenerConfig model.GardenerConfig, administrators []string, priority model.OperationPriority) (model.Operation, error) {
 	log.Infof("Starting Upgrade of Gardener Shoot operation")
 
 	dberr := txSession.UpdateGardenerClusterConfig(gardenerConfig)
@@ -330,20 +876,28 @@ func (r *service) setGardenerShootUpgradeStarted(txSession dbsession.WriteSessio
 		return model.Operation{}, dberrors.Internal("Failed to set Shoot Upgrade started: %s", dberr.Error())
 	}
 
-	operation, dbError := r.setOperationStarted(txSession, currentCluster.ID, model.UpgradeShoot, model.WaitingForShootNewVersion, time.Now(), "Starting Gardener Shoot upgrade")
+	operation, dbError := r.setOperationStarted(ctx, txSession, currentCluster.ID, model.UpgradeShoot, model.WaitingForShootNewVersion, priority, time.Now(), "Starting Gardener Shoot upgrade")
 
 	if dbError != nil {
 		return model.Operation{}, dbError.Append("Failed to start operation of Gardener Shoot upgrade %s", dbError.Error())
 	}
 
+	// Configuration from before the upgrade is kept so that it can be restored when the upgrade fails
+	dbError = txSession.InsertGardenerConfigBackup(operation.ID, currentCluster.ClusterConfig, gardenerConfig)
+	if dbError != nil {
+		return model.Operation{}, dbError.Append("Failed to store Gardener config from before the upgrade")
+	}
+
 	return operation, nil
 }
 
 func (r *service) setOperationStarted(
+	ctx context.Context,
 	dbSession dbsession.WriteSession,
 	runtimeID string,
 	operationType model.OperationType,
 	operationStage model.OperationStage,
+	priority model.OperationPriority,
 	timestamp time.Time,
 	message string) (model.Operation, dberrors.Error) {
 	id := r.uuidGenerator.New()
@@ -357,6 +911,8 @@ func (r *service) setOperationStarted(
 		ClusterID:      runtimeID,
 		Stage:          operationStage,
 		LastTransition: &timestamp,
+		Priority:       priority,
+		TraceContext:   tracing.Inject(ctx),
 	}
 
 	err := dbSession.InsertOperation(operation)
@@ -379,6 +935,20 @@ func isVersionHigher(version1, version2 string) (bool, apperrors.AppError) {
 	return parsedVersion1.GreaterThan(parsedVersion2), nil
 }
 
+func getHibernationStatus(shoot gardener_Types.Shoot) model.HibernationStatus {
+	hibernationPossible := true
+	for _, constraint := range shoot.Status.Constraints {
+		if constraint.Type == gardener_Types.ShootHibernationPossible && constraint.Status != gardener_Types.ConditionTrue {
+			hibernationPossible = false
+		}
+	}
+
+	return model.HibernationStatus{
+		Hibernated:          shoot.Status.IsHibernated,
+		HibernationPossible: hibernationPossible,
+	}
+}
+
 func getShootNetworkingFilterDisabled(extensions []gardener_Types.Extension) *bool {
 	for _, extension := range extensions {
 		if extension.Type == model.ShootNetworkingFilterExtensionType {
@@ -1,6 +1,7 @@
 package provisioning
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -9,15 +10,21 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/events"
+	eventsMocks "github.com/kyma-project/control-plane/components/provisioner/internal/events/mocks"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/mocks"
 	queue_mock "github.com/kyma-project/control-plane/components/provisioner/internal/operations/queue/mocks"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
 	mocks2 "github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/mocks"
 	sessionMocks "github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/tracing"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util/testkit"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/uuid"
 	uuidMocks "github.com/kyma-project/control-plane/components/provisioner/internal/uuid/mocks"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
@@ -122,14 +129,15 @@ func TestService_ProvisionRuntime(t *testing.T) {
 		writeSessionWithinTransactionMock.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
 		writeSessionWithinTransactionMock.On("Commit").Return(nil)
 		writeSessionWithinTransactionMock.On("RollbackUnlessCommitted").Return()
-		provisioner.On("ProvisionCluster", mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(nil)
+		provisioner.On("ProvisionCluster", mock.Anything, mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(nil)
 
-		provisioningQueue.On("Add", mock.AnythingOfType("string")).Return(nil)
+		provisioningQueue.On("Schedule", mock.Anything, mock.AnythingOfType("string"), model.NormalPriority).Return(nil)
+		provisioningQueue.On("Add", mock.AnythingOfType("string"), model.NormalPriority).Return(nil)
 
-		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuidGeneratorMock, nil, provisioningQueue, nil, nil, kubeconfigProviderMock)
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuidGeneratorMock, nil, provisioningQueue, nil, nil, nil, nil, kubeconfigProviderMock, Quotas{}, events.NewNoopPublisher())
 
 		// when
-		operationStatus, err := service.ProvisionRuntime(provisionRuntimeInputNoKymaConfig, tenant, subAccountId)
+		operationStatus, err := service.ProvisionRuntime(context.Background(), provisionRuntimeInputNoKymaConfig, tenant, subAccountId)
 		require.NoError(t, err)
 
 		// then
@@ -141,11 +149,163 @@ func TestService_ProvisionRuntime(t *testing.T) {
 		provisioner.AssertExpectations(t)
 	})
 
+	t.Run("Should link operation to idempotency key within provisioning transaction", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		writeSessionWithinTransactionMock := &sessionMocks.WriteSessionWithinTransaction{}
+		provisioner := &mocks2.Provisioner{}
+		uuidGeneratorMock := &uuidMocks.UUIDGenerator{}
+
+		provisioningQueue := &mocks.OperationQueue{}
+
+		sessionFactoryMock.On("NewSessionWithinTransaction").Return(writeSessionWithinTransactionMock, nil)
+		uuidGeneratorMock.On("New").Return(runtimeID)
+		writeSessionWithinTransactionMock.On("InsertCluster", mock.MatchedBy(clusterMatcher)).Return(nil)
+		writeSessionWithinTransactionMock.On("InsertGardenerConfig", mock.AnythingOfType("model.GardenerConfig")).Return(nil)
+		writeSessionWithinTransactionMock.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
+		writeSessionWithinTransactionMock.On("Commit").Return(nil).NotBefore(
+			writeSessionWithinTransactionMock.On("SetIdempotencyKeyOperation", tenant, "idempotency-key", runtimeID).Return(nil),
+		)
+		writeSessionWithinTransactionMock.On("RollbackUnlessCommitted").Return()
+		provisioner.On("ProvisionCluster", mock.Anything, mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(nil)
+
+		provisioningQueue.On("Schedule", writeSessionWithinTransactionMock, runtimeID, model.NormalPriority).Return(nil)
+		provisioningQueue.On("Add", runtimeID, model.NormalPriority).Return(nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuidGeneratorMock, nil, provisioningQueue, nil, nil, nil, nil, kubeconfigProviderMock, Quotas{}, events.NewNoopPublisher())
+
+		// when
+		_, err := service.ProvisionRuntime(WithIdempotencyKey(context.Background(), tenant, "idempotency-key"), provisionRuntimeInputNoKymaConfig, tenant, subAccountId)
+		require.NoError(t, err)
+
+		// then
+		writeSessionWithinTransactionMock.AssertExpectations(t)
+		provisioningQueue.AssertExpectations(t)
+	})
+
+	t.Run("Should return error when failed to link operation to idempotency key", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		writeSessionWithinTransactionMock := &sessionMocks.WriteSessionWithinTransaction{}
+		provisioner := &mocks2.Provisioner{}
+		uuidGeneratorMock := &uuidMocks.UUIDGenerator{}
+
+		provisioningQueue := &mocks.OperationQueue{}
+
+		sessionFactoryMock.On("NewSessionWithinTransaction").Return(writeSessionWithinTransactionMock, nil)
+		uuidGeneratorMock.On("New").Return(runtimeID)
+		writeSessionWithinTransactionMock.On("InsertCluster", mock.MatchedBy(clusterMatcher)).Return(nil)
+		writeSessionWithinTransactionMock.On("InsertGardenerConfig", mock.AnythingOfType("model.GardenerConfig")).Return(nil)
+		writeSessionWithinTransactionMock.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
+		writeSessionWithinTransactionMock.On("SetIdempotencyKeyOperation", tenant, "idempotency-key", runtimeID).Return(dberrors.NotFound("error"))
+		writeSessionWithinTransactionMock.On("RollbackUnlessCommitted").Return()
+
+		provisioningQueue.On("Schedule", writeSessionWithinTransactionMock, runtimeID, model.NormalPriority).Return(nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuidGeneratorMock, nil, provisioningQueue, nil, nil, nil, nil, kubeconfigProviderMock, Quotas{}, events.NewNoopPublisher())
+
+		// when
+		_, err := service.ProvisionRuntime(WithIdempotencyKey(context.Background(), tenant, "idempotency-key"), provisionRuntimeInputNoKymaConfig, tenant, subAccountId)
+
+		// then
+		require.Error(t, err)
+		writeSessionWithinTransactionMock.AssertNotCalled(t, "Commit")
+		provisioner.AssertNotCalled(t, "ProvisionCluster", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Should start runtime provisioning when Runtime quotas are not exceeded", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		writeSessionWithinTransactionMock := &sessionMocks.WriteSessionWithinTransaction{}
+		provisioner := &mocks2.Provisioner{}
+		uuidGeneratorMock := &uuidMocks.UUIDGenerator{}
+
+		provisioningQueue := &mocks.OperationQueue{}
+
+		sessionFactoryMock.On("NewSessionWithinTransaction").Return(writeSessionWithinTransactionMock, nil)
+		lockCall := writeSessionWithinTransactionMock.On("LockTenantRuntimes", tenant).Return(nil)
+		tenantCountCall := writeSessionWithinTransactionMock.On("CountActiveRuntimes", tenant, (*string)(nil)).Return(4, nil).NotBefore(lockCall)
+		subAccountCountCall := writeSessionWithinTransactionMock.On("CountActiveRuntimes", tenant, util.PtrTo(subAccountId)).Return(1, nil).NotBefore(lockCall)
+		uuidGeneratorMock.On("New").Return(runtimeID)
+		writeSessionWithinTransactionMock.On("InsertCluster", mock.MatchedBy(clusterMatcher)).Return(nil).NotBefore(tenantCountCall, subAccountCountCall)
+		writeSessionWithinTransactionMock.On("InsertGardenerConfig", mock.AnythingOfType("model.GardenerConfig")).Return(nil)
+		writeSessionWithinTransactionMock.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
+		writeSessionWithinTransactionMock.On("Commit").Return(nil)
+		writeSessionWithinTransactionMock.On("RollbackUnlessCommitted").Return()
+		provisioner.On("ProvisionCluster", mock.Anything, mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(nil)
+
+		provisioningQueue.On("Schedule", mock.Anything, mock.AnythingOfType("string"), model.NormalPriority).Return(nil)
+		provisioningQueue.On("Add", mock.AnythingOfType("string"), model.NormalPriority).Return(nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuidGeneratorMock, nil, provisioningQueue, nil, nil, nil, nil, kubeconfigProviderMock, Quotas{MaxRuntimesPerTenant: 5, MaxRuntimesPerSubAccount: 2}, events.NewNoopPublisher())
+
+		// when
+		operationStatus, err := service.ProvisionRuntime(context.Background(), provisionRuntimeInputNoKymaConfig, tenant, subAccountId)
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, runtimeID, *operationStatus.RuntimeID)
+		writeSessionWithinTransactionMock.AssertExpectations(t)
+		provisioner.AssertExpectations(t)
+	})
+
+	for _, testCase := range []struct {
+		description      string
+		quotas           Quotas
+		tenantCount      int
+		subAccountCount  int
+		expectedErrorMsg string
+	}{
+		{
+			description:      "tenant",
+			quotas:           Quotas{MaxRuntimesPerTenant: 5},
+			tenantCount:      5,
+			expectedErrorMsg: "reached the limit of 5 active Runtimes",
+		},
+		{
+			description:      "sub account",
+			quotas:           Quotas{MaxRuntimesPerTenant: 5, MaxRuntimesPerSubAccount: 2},
+			tenantCount:      3,
+			subAccountCount:  2,
+			expectedErrorMsg: "reached the limit of 2 active Runtimes",
+		},
+	} {
+		t.Run("Should return error when Runtime quota of "+testCase.description+" is exceeded", func(t *testing.T) {
+			// given
+			sessionFactoryMock := &sessionMocks.Factory{}
+			writeSessionWithinTransactionMock := &sessionMocks.WriteSessionWithinTransaction{}
+			provisioner := &mocks2.Provisioner{}
+			uuidGeneratorMock := &uuidMocks.UUIDGenerator{}
+
+			sessionFactoryMock.On("NewSessionWithinTransaction").Return(writeSessionWithinTransactionMock, nil)
+			writeSessionWithinTransactionMock.On("LockTenantRuntimes", tenant).Return(nil)
+			writeSessionWithinTransactionMock.On("CountActiveRuntimes", tenant, (*string)(nil)).Return(testCase.tenantCount, nil)
+			writeSessionWithinTransactionMock.On("CountActiveRuntimes", tenant, util.PtrTo(subAccountId)).Return(testCase.subAccountCount, nil)
+			writeSessionWithinTransactionMock.On("RollbackUnlessCommitted").Return()
+			uuidGeneratorMock.On("New").Return(runtimeID)
+
+			service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuidGeneratorMock, nil, nil, nil, nil, nil, nil, kubeconfigProviderMock, testCase.quotas, events.NewNoopPublisher())
+
+			// when
+			_, err := service.ProvisionRuntime(context.Background(), provisionRuntimeInputNoKymaConfig, tenant, subAccountId)
+
+			// then
+			require.Error(t, err)
+			util.CheckErrorType(t, err, apperrors.CodeQuotaExceeded)
+			assert.Contains(t, err.Error(), testCase.expectedErrorMsg)
+			writeSessionWithinTransactionMock.AssertNotCalled(t, "InsertCluster", mock.Anything)
+			writeSessionWithinTransactionMock.AssertNotCalled(t, "Commit")
+			provisioner.AssertNotCalled(t, "ProvisionCluster", mock.Anything, mock.Anything)
+		})
+	}
+
 	t.Run("Should return error when failed to commit transaction", func(t *testing.T) {
 		// given
 		sessionFactoryMock := &sessionMocks.Factory{}
 		writeSessionWithinTransactionMock := &sessionMocks.WriteSessionWithinTransaction{}
 		provisioner := &mocks2.Provisioner{}
+		provisioningQueue := &mocks.OperationQueue{}
+		provisioningQueue.On("Schedule", writeSessionWithinTransactionMock, mock.AnythingOfType("string"), model.NormalPriority).Return(nil)
 
 		uuidGeneratorMock := &uuidMocks.UUIDGenerator{}
 		uuidGeneratorMock.On("New").Return(runtimeID)
@@ -157,12 +317,12 @@ func TestService_ProvisionRuntime(t *testing.T) {
 		writeSessionWithinTransactionMock.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
 		writeSessionWithinTransactionMock.On("Commit").Return(expectErr)
 		writeSessionWithinTransactionMock.On("RollbackUnlessCommitted").Return()
-		provisioner.On("ProvisionCluster", mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(nil)
+		provisioner.On("ProvisionCluster", mock.Anything, mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(nil)
 
-		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuidGeneratorMock, nil, nil, nil, nil, kubeconfigProviderMock)
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuidGeneratorMock, nil, provisioningQueue, nil, nil, nil, nil, kubeconfigProviderMock, Quotas{}, events.NewNoopPublisher())
 
 		// when
-		_, err := service.ProvisionRuntime(provisionRuntimeInput, tenant, subAccountId)
+		_, err := service.ProvisionRuntime(context.Background(), provisionRuntimeInput, tenant, subAccountId)
 		require.Error(t, err)
 
 		//then
@@ -178,6 +338,8 @@ func TestService_ProvisionRuntime(t *testing.T) {
 		sessionFactoryMock := &sessionMocks.Factory{}
 		writeSessionWithinTransactionMock := &sessionMocks.WriteSessionWithinTransaction{}
 		provisioner := &mocks2.Provisioner{}
+		provisioningQueue := &mocks.OperationQueue{}
+		provisioningQueue.On("Schedule", writeSessionWithinTransactionMock, mock.AnythingOfType("string"), model.NormalPriority).Return(nil)
 
 		uuidGeneratorMock := &uuidMocks.UUIDGenerator{}
 		uuidGeneratorMock.On("New").Return(runtimeID)
@@ -187,12 +349,12 @@ func TestService_ProvisionRuntime(t *testing.T) {
 		writeSessionWithinTransactionMock.On("InsertGardenerConfig", mock.AnythingOfType("model.GardenerConfig")).Return(nil)
 		writeSessionWithinTransactionMock.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
 		writeSessionWithinTransactionMock.On("RollbackUnlessCommitted").Return()
-		provisioner.On("ProvisionCluster", mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(apperrors.Internal("error"))
+		provisioner.On("ProvisionCluster", mock.Anything, mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(apperrors.Internal("error"))
 
-		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuidGeneratorMock, nil, nil, nil, nil, kubeconfigProviderMock)
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuidGeneratorMock, nil, provisioningQueue, nil, nil, nil, nil, kubeconfigProviderMock, Quotas{}, events.NewNoopPublisher())
 
 		// when
-		_, err := service.ProvisionRuntime(provisionRuntimeInput, tenant, subAccountId)
+		_, err := service.ProvisionRuntime(context.Background(), provisionRuntimeInput, tenant, subAccountId)
 		require.Error(t, err)
 		util.CheckErrorType(t, err, apperrors.CodeInternal)
 
@@ -203,6 +365,39 @@ func TestService_ProvisionRuntime(t *testing.T) {
 		provisioner.AssertExpectations(t)
 	})
 
+	t.Run("Should return dry run result without storing anything", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		provisioner := &mocks2.Provisioner{}
+		uuidGeneratorMock := &uuidMocks.UUIDGenerator{}
+		provisioningQueue := &mocks.OperationQueue{}
+
+		dryRunResult := model.DryRunResult{
+			Manifest: "kind: Shoot",
+			Diff:     []model.ShootFieldDiff{{Path: "spec.region", NewValue: util.PtrTo(`"europe-west1"`)}},
+		}
+
+		uuidGeneratorMock.On("New").Return(runtimeID)
+		provisioner.On("DryRunProvisionCluster", mock.Anything, mock.MatchedBy(clusterMatcher)).Return(dryRunResult, nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuidGeneratorMock, nil, provisioningQueue, nil, nil, nil, nil, kubeconfigProviderMock, Quotas{}, events.NewNoopPublisher())
+
+		// when
+		operationStatus, err := service.DryRunProvisionRuntime(context.Background(), provisionRuntimeInputNoKymaConfig, tenant, subAccountId)
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, runtimeID, *operationStatus.RuntimeID)
+		assert.Nil(t, operationStatus.ID)
+		assert.Equal(t, &gqlschema.DryRunResult{
+			Shoot: "kind: Shoot",
+			Diff:  []*gqlschema.ShootFieldDiff{{Path: "spec.region", NewValue: util.PtrTo(`"europe-west1"`)}},
+		}, operationStatus.DryRunResult)
+		sessionFactoryMock.AssertNotCalled(t, "NewSessionWithinTransaction")
+		provisioningQueue.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+		provisioner.AssertExpectations(t)
+	})
+
 }
 
 func TestService_DeprovisionRuntime(t *testing.T) {
@@ -236,32 +431,91 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 
 		sessionFactoryMock := &sessionMocks.Factory{}
 		readWriteSession := &sessionMocks.ReadWriteSession{}
+		txSession := &sessionMocks.WriteSessionWithinTransaction{}
 		provisioner := &mocks2.Provisioner{}
 
 		deprovisioningQueue := &mocks.OperationQueue{}
 
-		deprovisioningQueue.On("Add", mock.AnythingOfType("string")).Return(nil)
+		deprovisioningQueue.On("Schedule", txSession, mock.AnythingOfType("string"), model.NormalPriority).Return(nil)
+		deprovisioningQueue.On("Add", mock.AnythingOfType("string"), model.NormalPriority).Return(nil)
 
-		sessionFactoryMock.On("NewReadWriteSession").Return(readWriteSession)
+		sessionFactoryMock.On("NewReadSession").Return(readWriteSession)
 		readWriteSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
 		readWriteSession.On("GetCluster", runtimeID).Return(cluster, nil)
-		provisioner.On("DeprovisionCluster", mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(operation, nil)
-		readWriteSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
+		provisioner.On("DeprovisionCluster", mock.Anything, mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(operation, nil)
+		sessionFactoryMock.On("NewSessionWithinTransaction").Return(txSession, nil)
+		txSession.On("Commit").Return(nil)
+		txSession.On("RollbackUnlessCommitted").Return()
+		txSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, nil, deprovisioningQueue, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, nil, deprovisioningQueue, nil, nil, nil, nil, Quotas{}, events.NewNoopPublisher())
 
 		// when
-		opID, err := resolver.DeprovisionRuntime(runtimeID)
+		opID, err := resolver.DeprovisionRuntime(context.Background(), runtimeID, nil)
 		require.NoError(t, err)
 
 		// then
 		assert.Equal(t, operationID, opID)
 		sessionFactoryMock.AssertExpectations(t)
 		readWriteSession.AssertExpectations(t)
+		txSession.AssertExpectations(t)
 		provisioner.AssertExpectations(t)
 		deprovisioningQueue.AssertExpectations(t)
 	})
 
+	t.Run("Should trace Runtime deprovisioning and store trace context with the operation", func(t *testing.T) {
+		// given
+		exporter := tracetest.NewInMemoryExporter()
+		tracing.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+
+		ctx, requestSpan := tracing.Start(context.Background(), "request")
+
+		operation := model.Operation{
+			ID:             operationID,
+			Type:           model.DeprovisionNoInstall,
+			State:          model.InProgress,
+			StartTimestamp: time.Now(),
+			Message:        "Deprovisioning without installation started",
+			ClusterID:      runtimeID,
+		}
+
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readWriteSession := &sessionMocks.ReadWriteSession{}
+		txSession := &sessionMocks.WriteSessionWithinTransaction{}
+		provisioner := &mocks2.Provisioner{}
+
+		deprovisioningQueue := &mocks.OperationQueue{}
+		deprovisioningQueue.On("Schedule", txSession, mock.AnythingOfType("string"), model.NormalPriority).Return(nil)
+		deprovisioningQueue.On("Add", mock.AnythingOfType("string"), model.NormalPriority).Return(nil)
+
+		sessionFactoryMock.On("NewReadSession").Return(readWriteSession)
+		readWriteSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
+		readWriteSession.On("GetCluster", runtimeID).Return(cluster, nil)
+		provisioner.On("DeprovisionCluster", mock.Anything, mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(operation, nil)
+		sessionFactoryMock.On("NewSessionWithinTransaction").Return(txSession, nil)
+		txSession.On("Commit").Return(nil)
+		txSession.On("RollbackUnlessCommitted").Return()
+		txSession.On("InsertOperation", mock.MatchedBy(func(operation model.Operation) bool {
+			return tracing.Extract(operation.TraceContext).TraceID() == requestSpan.SpanContext().TraceID()
+		})).Return(nil)
+
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, nil, deprovisioningQueue, nil, nil, nil, nil, Quotas{}, events.NewNoopPublisher())
+
+		// when
+		_, err := resolver.DeprovisionRuntime(ctx, runtimeID, nil)
+		require.NoError(t, err)
+		requestSpan.End()
+
+		// then
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 2)
+		assert.Equal(t, "Service.DeprovisionRuntime", spans[0].Name)
+		assert.Equal(t, requestSpan.SpanContext().SpanID(), spans[0].Parent.SpanID())
+		assert.Contains(t, spans[0].Attributes, tracing.RuntimeIDKey.String(runtimeID))
+		assert.Contains(t, spans[0].Attributes, tracing.OperationIDKey.String(operationID))
+		readWriteSession.AssertExpectations(t)
+	})
+
 	t.Run("Should start Runtime deprovisioning without installation and return operation ID when activeKymaConfigID is missing", func(t *testing.T) {
 		// given
 		operation := model.Operation{
@@ -282,47 +536,100 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 
 		sessionFactoryMock := &sessionMocks.Factory{}
 		readWriteSession := &sessionMocks.ReadWriteSession{}
+		txSession := &sessionMocks.WriteSessionWithinTransaction{}
 		provisioner := &mocks2.Provisioner{}
 
 		deprovisioningQueue := &mocks.OperationQueue{}
 
-		deprovisioningQueue.On("Add", mock.AnythingOfType("string")).Return(nil)
+		deprovisioningQueue.On("Schedule", txSession, mock.AnythingOfType("string"), model.NormalPriority).Return(nil)
+		deprovisioningQueue.On("Add", mock.AnythingOfType("string"), model.NormalPriority).Return(nil)
 
-		sessionFactoryMock.On("NewReadWriteSession").Return(readWriteSession)
+		sessionFactoryMock.On("NewReadSession").Return(readWriteSession)
 		readWriteSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
 		readWriteSession.On("GetCluster", runtimeID).Return(cluster, nil)
-		provisioner.On("DeprovisionCluster", mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(operation, nil)
-		readWriteSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
+		provisioner.On("DeprovisionCluster", mock.Anything, mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(operation, nil)
+		sessionFactoryMock.On("NewSessionWithinTransaction").Return(txSession, nil)
+		txSession.On("Commit").Return(nil)
+		txSession.On("RollbackUnlessCommitted").Return()
+		txSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, nil, deprovisioningQueue, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, nil, deprovisioningQueue, nil, nil, nil, nil, Quotas{}, events.NewNoopPublisher())
 
 		// when
-		opID, err := resolver.DeprovisionRuntime(runtimeID)
+		opID, err := resolver.DeprovisionRuntime(context.Background(), runtimeID, nil)
 		require.NoError(t, err)
 
 		// then
 		assert.Equal(t, operationID, opID)
 		sessionFactoryMock.AssertExpectations(t)
 		readWriteSession.AssertExpectations(t)
+		txSession.AssertExpectations(t)
 		provisioner.AssertExpectations(t)
 		deprovisioningQueue.AssertExpectations(t)
 	})
 
+	t.Run("Should start Runtime deprovisioning with requested priority", func(t *testing.T) {
+		// given
+		operation := model.Operation{
+			ID:             operationID,
+			Type:           model.DeprovisionNoInstall,
+			State:          model.InProgress,
+			StartTimestamp: time.Now(),
+			Message:        "Deprovisioning without installation started",
+			ClusterID:      runtimeID,
+		}
+		operationMatcher := getOperationMatcher(model.Operation{
+			Type:      model.DeprovisionNoInstall,
+			State:     model.InProgress,
+			ClusterID: runtimeID,
+			Priority:  model.HighPriority,
+		})
+
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readWriteSession := &sessionMocks.ReadWriteSession{}
+		txSession := &sessionMocks.WriteSessionWithinTransaction{}
+		provisioner := &mocks2.Provisioner{}
+
+		deprovisioningQueue := &mocks.OperationQueue{}
+
+		deprovisioningQueue.On("Schedule", txSession, operationID, model.HighPriority).Return(nil)
+		deprovisioningQueue.On("Add", operationID, model.HighPriority).Return(nil)
+
+		sessionFactoryMock.On("NewReadSession").Return(readWriteSession)
+		readWriteSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
+		readWriteSession.On("GetCluster", runtimeID).Return(cluster, nil)
+		provisioner.On("DeprovisionCluster", mock.Anything, mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(operation, nil)
+		sessionFactoryMock.On("NewSessionWithinTransaction").Return(txSession, nil)
+		txSession.On("Commit").Return(nil)
+		txSession.On("RollbackUnlessCommitted").Return()
+		txSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
+
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, nil, deprovisioningQueue, nil, nil, nil, nil, Quotas{}, events.NewNoopPublisher())
+
+		// when
+		_, err := resolver.DeprovisionRuntime(context.Background(), runtimeID, util.PtrTo(gqlschema.OperationPriorityHigh))
+		require.NoError(t, err)
+
+		// then
+		txSession.AssertExpectations(t)
+		deprovisioningQueue.AssertExpectations(t)
+	})
+
 	t.Run("Should return error when failed to start deprovisioning", func(t *testing.T) {
 		// given
 		sessionFactoryMock := &sessionMocks.Factory{}
 		readWriteSession := &sessionMocks.ReadWriteSession{}
 		provisioner := &mocks2.Provisioner{}
 
-		sessionFactoryMock.On("NewReadWriteSession").Return(readWriteSession)
+		sessionFactoryMock.On("NewReadSession").Return(readWriteSession)
 		readWriteSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
 		readWriteSession.On("GetCluster", runtimeID).Return(cluster, nil)
-		provisioner.On("DeprovisionCluster", mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(model.Operation{}, apperrors.Internal("some error"))
+		provisioner.On("DeprovisionCluster", mock.Anything, mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(model.Operation{}, apperrors.Internal("some error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, nil, nil, Quotas{}, events.NewNoopPublisher())
 
 		// when
-		_, err := resolver.DeprovisionRuntime(runtimeID)
+		_, err := resolver.DeprovisionRuntime(context.Background(), runtimeID, nil)
 		require.Error(t, err)
 		util.CheckErrorType(t, err, apperrors.CodeInternal)
 
@@ -340,14 +647,14 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		sessionFactoryMock := &sessionMocks.Factory{}
 		readWriteSession := &sessionMocks.ReadWriteSession{}
 
-		sessionFactoryMock.On("NewReadWriteSession").Return(readWriteSession)
+		sessionFactoryMock.On("NewReadSession").Return(readWriteSession)
 		readWriteSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
 		readWriteSession.On("GetCluster", runtimeID).Return(model.Cluster{}, dberrors.Internal("some error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, nil, nil, Quotas{}, events.NewNoopPublisher())
 
 		// when
-		_, err := resolver.DeprovisionRuntime(runtimeID)
+		_, err := resolver.DeprovisionRuntime(context.Background(), runtimeID, nil)
 		require.Error(t, err)
 
 		// then
@@ -365,13 +672,13 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		sessionFactoryMock := &sessionMocks.Factory{}
 		readWriteSession := &sessionMocks.ReadWriteSession{}
 
-		sessionFactoryMock.On("NewReadWriteSession").Return(readWriteSession)
+		sessionFactoryMock.On("NewReadSession").Return(readWriteSession)
 		readWriteSession.On("GetLastOperation", runtimeID).Return(operation, nil)
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, nil, nil, Quotas{}, events.NewNoopPublisher())
 
 		// when
-		_, err := resolver.DeprovisionRuntime(runtimeID)
+		_, err := resolver.DeprovisionRuntime(context.Background(), runtimeID, nil)
 		require.Error(t, err)
 
 		// then
@@ -388,13 +695,13 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		sessionFactoryMock := &sessionMocks.Factory{}
 		readWriteSession := &sessionMocks.ReadWriteSession{}
 
-		sessionFactoryMock.On("NewReadWriteSession").Return(readWriteSession)
+		sessionFactoryMock.On("NewReadSession").Return(readWriteSession)
 		readWriteSession.On("GetLastOperation", runtimeID).Return(model.Operation{}, dberrors.Internal("some error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, nil, nil, Quotas{}, events.NewNoopPublisher())
 
 		// when
-		_, err := resolver.DeprovisionRuntime(runtimeID)
+		_, err := resolver.DeprovisionRuntime(context.Background(), runtimeID, nil)
 		require.Error(t, err)
 
 		// then
@@ -427,10 +734,10 @@ func TestService_RuntimeOperationStatus(t *testing.T) {
 		sessionFactoryMock.On("NewReadSession").Return(readSession)
 		readSession.On("GetOperation", operationID).Return(operation, nil)
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, Quotas{}, events.NewNoopPublisher())
 
 		// when
-		status, err := resolver.RuntimeOperationStatus(operationID)
+		status, err := resolver.RuntimeOperationStatus(context.Background(), operationID)
 		// then
 		require.NoError(t, err)
 		assert.Equal(t, gqlschema.OperationTypeProvision, status.Operation)
@@ -450,10 +757,10 @@ func TestService_RuntimeOperationStatus(t *testing.T) {
 		sessionFactoryMock.On("NewReadSession").Return(readSession)
 		readSession.On("GetOperation", operationID).Return(model.Operation{}, dberrors.Internal("error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, Quotas{}, events.NewNoopPublisher())
 
 		// when
-		_, err := resolver.RuntimeOperationStatus(operationID)
+		_, err := resolver.RuntimeOperationStatus(context.Background(), operationID)
 
 		// then
 		require.Error(t, err)
@@ -480,6 +787,15 @@ func TestService_RuntimeStatus(t *testing.T) {
 		Kubeconfig: util.PtrTo(kubeconfig),
 	}
 
+	drift := model.ShootDrift{
+		ClusterID:     runtimeID,
+		Field:         "machineType",
+		ExpectedValue: "m5.xlarge",
+		ActualValue:   "m5.2xlarge",
+		Policy:        model.DriftPolicyRecord,
+		DetectedAt:    time.Now(),
+	}
+
 	t.Run("Should return runtime status", func(t *testing.T) {
 		// given
 		sessionFactoryMock := &sessionMocks.Factory{}
@@ -488,20 +804,62 @@ func TestService_RuntimeStatus(t *testing.T) {
 		sessionFactoryMock.On("NewReadSession").Return(readSession)
 		readSession.On("GetLastOperation", operationID).Return(operation, nil)
 		readSession.On("GetCluster", operationID).Return(cluster, nil)
+		readSession.On("ListShootDrifts", operationID).Return([]model.ShootDrift{drift}, nil)
+
+		shootProvider := &mocks2.ShootProvider{}
+		shootProvider.On("Get", operationID, "").Return(*testkit.NewTestShoot("shoot").WithHibernationState(true, true).ToShoot(), nil)
 
 		provisioner := &mocks2.Provisioner{}
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, kubeconfigProviderMock())
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuidGenerator, shootProvider, nil, nil, nil, nil, nil, kubeconfigProviderMock(), Quotas{}, events.NewNoopPublisher())
 
 		// when
-		status, err := resolver.RuntimeStatus(operationID)
+		status, err := resolver.RuntimeStatus(context.Background(), operationID)
 
 		// then
 		require.NoError(t, err)
 		assert.Equal(t, cluster.ID, *status.LastOperationStatus.RuntimeID)
 		assert.Equal(t, cluster.Kubeconfig, status.RuntimeConfiguration.Kubeconfig)
+		assert.True(t, *status.HibernationStatus.Hibernated)
+		assert.True(t, *status.HibernationStatus.HibernationPossible)
+		assert.Equal(t, []*gqlschema.ShootDrift{{
+			Field:         "machineType",
+			ExpectedValue: "m5.xlarge",
+			ActualValue:   "m5.2xlarge",
+			Policy:        gqlschema.DriftPolicyRecord,
+			DetectedAt:    drift.DetectedAt,
+		}}, status.Drift)
+		sessionFactoryMock.AssertExpectations(t)
+		readSession.AssertExpectations(t)
+		shootProvider.AssertExpectations(t)
+	})
+
+	t.Run("Should return runtime status without hibernation status when failed to get shoot", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+
+		sessionFactoryMock.On("NewReadSession").Return(readSession)
+		readSession.On("GetLastOperation", operationID).Return(operation, nil)
+		readSession.On("GetCluster", operationID).Return(cluster, nil)
+		readSession.On("ListShootDrifts", operationID).Return(nil, nil)
+
+		shootProvider := &mocks2.ShootProvider{}
+		shootProvider.On("Get", operationID, "").Return(gardener_Types.Shoot{}, apperrors.Internal("error"))
+
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, uuidGenerator, shootProvider, nil, nil, nil, nil, nil, kubeconfigProviderMock(), Quotas{}, events.NewNoopPublisher())
+
+		// when
+		status, err := resolver.RuntimeStatus(context.Background(), operationID)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, cluster.ID, *status.LastOperationStatus.RuntimeID)
+		assert.False(t, *status.HibernationStatus.Hibernated)
+		assert.Nil(t, status.Drift)
 		sessionFactoryMock.AssertExpectations(t)
 		readSession.AssertExpectations(t)
+		shootProvider.AssertExpectations(t)
 	})
 
 	t.Run("Should return error when failed to get cluster", func(t *testing.T) {
@@ -513,10 +871,10 @@ func TestService_RuntimeStatus(t *testing.T) {
 		readSession.On("GetLastOperation", operationID).Return(operation, nil)
 		readSession.On("GetCluster", operationID).Return(model.Cluster{}, dberrors.Internal("error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, Quotas{}, events.NewNoopPublisher())
 
 		// when
-		_, err := resolver.RuntimeStatus(operationID)
+		_, err := resolver.RuntimeStatus(context.Background(), operationID)
 
 		// then
 		require.Error(t, err)
@@ -532,10 +890,10 @@ func TestService_RuntimeStatus(t *testing.T) {
 		sessionFactoryMock.On("NewReadSession").Return(readSession)
 		readSession.On("GetLastOperation", operationID).Return(model.Operation{}, dberrors.Internal("error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, Quotas{}, events.NewNoopPublisher())
 
 		// when
-		_, err := resolver.RuntimeStatus(operationID)
+		_, err := resolver.RuntimeStatus(context.Background(), operationID)
 
 		// then
 		require.Error(t, err)
@@ -605,9 +963,11 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 				writeSession.On("InsertAdministrators", runtimeID, mock.Anything).Return(nil)
 				provisioner.On("setOperationStarted", writeSession, runtimeID, model.UpgradeShoot, model.WaitingForShootNewVersion, nil, nil).Return(mock.MatchedBy(operationMatcher), nil)
 				writeSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
-				provisioner.On("UpgradeCluster", runtimeID, newUpgradedConfig).Return(nil)
+				writeSession.On("InsertGardenerConfigBackup", mock.AnythingOfType("string"), cluster.ClusterConfig, newUpgradedConfig).Return(nil)
+				provisioner.On("UpgradeCluster", mock.Anything, runtimeID, newUpgradedConfig).Return(nil)
 				writeSession.On("Commit").Return(nil)
-				upgradeShootQueue.On("Add", mock.AnythingOfType("string")).Return(nil)
+				upgradeShootQueue.On("Schedule", mock.Anything, mock.AnythingOfType("string"), model.NormalPriority).Return(nil)
+				upgradeShootQueue.On("Add", mock.AnythingOfType("string"), model.NormalPriority).Return(nil)
 				shootProvider.On("Get", runtimeID, tenant).Return(providedShoot("1.20"), nil)
 			},
 		},
@@ -623,9 +983,11 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 				writeSession.On("InsertAdministrators", runtimeID, mock.Anything).Return(nil)
 				provisioner.On("setOperationStarted", writeSession, runtimeID, model.UpgradeShoot, model.WaitingForShootNewVersion, nil, nil).Return(mock.MatchedBy(operationMatcher), nil)
 				writeSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
-				provisioner.On("UpgradeCluster", runtimeID, upgradedConfig).Return(nil)
+				writeSession.On("InsertGardenerConfigBackup", mock.AnythingOfType("string"), cluster.ClusterConfig, upgradedConfig).Return(nil)
+				provisioner.On("UpgradeCluster", mock.Anything, runtimeID, upgradedConfig).Return(nil)
 				writeSession.On("Commit").Return(nil)
-				upgradeShootQueue.On("Add", mock.AnythingOfType("string")).Return(nil)
+				upgradeShootQueue.On("Schedule", mock.Anything, mock.AnythingOfType("string"), model.NormalPriority).Return(nil)
+				upgradeShootQueue.On("Add", mock.AnythingOfType("string"), model.NormalPriority).Return(nil)
 				shootProvider.On("Get", runtimeID, tenant).Return(providedShoot("1.19"), nil)
 			},
 		},
@@ -643,10 +1005,10 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 
 			testCase.mockFunc(sessionFactory, readSession, writeSessionWithinTransaction, provisioner, shootProvider, upgradeShootQueue)
 
-			service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, provisioner, uuidGenerator, shootProvider, nil, nil, upgradeShootQueue, nil)
+			service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, provisioner, uuidGenerator, shootProvider, nil, nil, upgradeShootQueue, nil, nil, nil, Quotas{}, events.NewNoopPublisher())
 
 			// when
-			operationStatus, err := service.UpgradeGardenerShoot(runtimeID, upgradeShootInput)
+			operationStatus, err := service.UpgradeGardenerShoot(context.Background(), runtimeID, upgradeShootInput)
 			require.NoError(t, err)
 
 			// then
@@ -661,11 +1023,11 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 
 	for _, testCase := range []struct {
 		description string
-		mockFunc    func(sessionFactory *sessionMocks.Factory, readSession *sessionMocks.ReadSession, writeSession *sessionMocks.WriteSessionWithinTransaction, provisioner *mocks2.Provisioner, shootProvider *mocks2.ShootProvider)
+		mockFunc    func(sessionFactory *sessionMocks.Factory, readSession *sessionMocks.ReadSession, writeSession *sessionMocks.WriteSessionWithinTransaction, provisioner *mocks2.Provisioner, shootProvider *mocks2.ShootProvider, upgradeShootQueue *mocks.OperationQueue)
 	}{
 		{
 			description: "should fail to upgrade Shoot when failed to commit shoot update",
-			mockFunc: func(sessionFactory *sessionMocks.Factory, readSession *sessionMocks.ReadSession, writeSession *sessionMocks.WriteSessionWithinTransaction, provisioner *mocks2.Provisioner, shootProvider *mocks2.ShootProvider) {
+			mockFunc: func(sessionFactory *sessionMocks.Factory, readSession *sessionMocks.ReadSession, writeSession *sessionMocks.WriteSessionWithinTransaction, provisioner *mocks2.Provisioner, shootProvider *mocks2.ShootProvider, upgradeShootQueue *mocks.OperationQueue) {
 				sessionFactory.On("NewReadSession").Return(readSession)
 				readSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
 				readSession.On("GetCluster", runtimeID).Return(cluster, nil)
@@ -673,16 +1035,18 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 				writeSession.On("RollbackUnlessCommitted").Return()
 				writeSession.On("UpdateGardenerClusterConfig", upgradedConfig).Return(nil)
 				writeSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
+				writeSession.On("InsertGardenerConfigBackup", mock.AnythingOfType("string"), cluster.ClusterConfig, upgradedConfig).Return(nil)
 				writeSession.On("InsertAdministrators", runtimeID, mock.Anything).Return(nil)
+				upgradeShootQueue.On("Schedule", writeSession, mock.AnythingOfType("string"), model.NormalPriority).Return(nil)
 				provisioner.On("setOperationStarted", writeSession, runtimeID, model.UpgradeShoot, model.WaitingForShootNewVersion, nil, nil).Return(mock.MatchedBy(operationMatcher), nil)
-				provisioner.On("UpgradeCluster", runtimeID, upgradedConfig).Return(nil)
+				provisioner.On("UpgradeCluster", mock.Anything, runtimeID, upgradedConfig).Return(nil)
 				writeSession.On("Commit").Return(dberrors.Internal("error"))
 				shootProvider.On("Get", runtimeID, tenant).Return(providedShoot("1.19"), nil)
 			},
 		},
 		{
 			description: "should fail to upgrade Shoot when failed to upgrade cluster",
-			mockFunc: func(sessionFactory *sessionMocks.Factory, readSession *sessionMocks.ReadSession, writeSession *sessionMocks.WriteSessionWithinTransaction, provisioner *mocks2.Provisioner, shootProvider *mocks2.ShootProvider) {
+			mockFunc: func(sessionFactory *sessionMocks.Factory, readSession *sessionMocks.ReadSession, writeSession *sessionMocks.WriteSessionWithinTransaction, provisioner *mocks2.Provisioner, shootProvider *mocks2.ShootProvider, upgradeShootQueue *mocks.OperationQueue) {
 				sessionFactory.On("NewReadSession").Return(readSession)
 				readSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
 				readSession.On("GetCluster", runtimeID).Return(cluster, nil)
@@ -690,15 +1054,48 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 				writeSession.On("RollbackUnlessCommitted").Return()
 				writeSession.On("UpdateGardenerClusterConfig", upgradedConfig).Return(nil)
 				writeSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
+				writeSession.On("InsertGardenerConfigBackup", mock.AnythingOfType("string"), cluster.ClusterConfig, upgradedConfig).Return(nil)
 				writeSession.On("InsertAdministrators", runtimeID, mock.Anything).Return(nil)
+				upgradeShootQueue.On("Schedule", writeSession, mock.AnythingOfType("string"), model.NormalPriority).Return(nil)
 				provisioner.On("setOperationStarted", writeSession, runtimeID, model.UpgradeShoot, model.WaitingForShootNewVersion, nil, nil).Return(mock.MatchedBy(operationMatcher), nil)
-				provisioner.On("UpgradeCluster", runtimeID, upgradedConfig).Return(apperrors.Internal("error"))
+				provisioner.On("UpgradeCluster", mock.Anything, runtimeID, upgradedConfig).Return(apperrors.Internal("error"))
+				shootProvider.On("Get", runtimeID, tenant).Return(providedShoot("1.19"), nil)
+			},
+		},
+		{
+			description: "should fail to upgrade Shoot when failed to queue operation",
+			mockFunc: func(sessionFactory *sessionMocks.Factory, readSession *sessionMocks.ReadSession, writeSession *sessionMocks.WriteSessionWithinTransaction, _ *mocks2.Provisioner, shootProvider *mocks2.ShootProvider, upgradeShootQueue *mocks.OperationQueue) {
+				sessionFactory.On("NewReadSession").Return(readSession)
+				readSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
+				readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+				sessionFactory.On("NewSessionWithinTransaction").Return(writeSession, nil)
+				writeSession.On("RollbackUnlessCommitted").Return()
+				writeSession.On("UpdateGardenerClusterConfig", upgradedConfig).Return(nil)
+				writeSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
+				writeSession.On("InsertGardenerConfigBackup", mock.AnythingOfType("string"), cluster.ClusterConfig, upgradedConfig).Return(nil)
+				writeSession.On("InsertAdministrators", runtimeID, mock.Anything).Return(nil)
+				upgradeShootQueue.On("Schedule", writeSession, mock.AnythingOfType("string"), model.NormalPriority).Return(dberrors.Internal("error"))
+				shootProvider.On("Get", runtimeID, tenant).Return(providedShoot("1.19"), nil)
+			},
+		},
+		{
+			description: "should fail to upgrade Shoot when failed to store Gardener config from before the upgrade",
+			mockFunc: func(sessionFactory *sessionMocks.Factory, readSession *sessionMocks.ReadSession, writeSession *sessionMocks.WriteSessionWithinTransaction, _ *mocks2.Provisioner, shootProvider *mocks2.ShootProvider, _ *mocks.OperationQueue) {
+				sessionFactory.On("NewReadSession").Return(readSession)
+				readSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
+				readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+				sessionFactory.On("NewSessionWithinTransaction").Return(writeSession, nil)
+				writeSession.On("RollbackUnlessCommitted").Return()
+				writeSession.On("UpdateGardenerClusterConfig", upgradedConfig).Return(nil)
+				writeSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
+				writeSession.On("InsertAdministrators", runtimeID, mock.Anything).Return(nil)
+				writeSession.On("InsertGardenerConfigBackup", mock.AnythingOfType("string"), cluster.ClusterConfig, upgradedConfig).Return(dberrors.Internal("error"))
 				shootProvider.On("Get", runtimeID, tenant).Return(providedShoot("1.19"), nil)
 			},
 		},
 		{
 			description: "should fail to upgrade Shoot when failed to update gardener cluster config",
-			mockFunc: func(sessionFactory *sessionMocks.Factory, readSession *sessionMocks.ReadSession, writeSession *sessionMocks.WriteSessionWithinTransaction, _ *mocks2.Provisioner, shootProvider *mocks2.ShootProvider) {
+			mockFunc: func(sessionFactory *sessionMocks.Factory, readSession *sessionMocks.ReadSession, writeSession *sessionMocks.WriteSessionWithinTransaction, _ *mocks2.Provisioner, shootProvider *mocks2.ShootProvider, _ *mocks.OperationQueue) {
 				sessionFactory.On("NewReadSession").Return(readSession)
 				readSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
 				readSession.On("GetCluster", runtimeID).Return(cluster, nil)
@@ -710,7 +1107,7 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 		},
 		{
 			description: "should fail to upgrade Shoot when failed to create write session",
-			mockFunc: func(sessionFactory *sessionMocks.Factory, readSession *sessionMocks.ReadSession, _ *sessionMocks.WriteSessionWithinTransaction, _ *mocks2.Provisioner, shootProvider *mocks2.ShootProvider) {
+			mockFunc: func(sessionFactory *sessionMocks.Factory, readSession *sessionMocks.ReadSession, _ *sessionMocks.WriteSessionWithinTransaction, _ *mocks2.Provisioner, shootProvider *mocks2.ShootProvider, _ *mocks.OperationQueue) {
 				sessionFactory.On("NewReadSession").Return(readSession)
 				readSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
 				readSession.On("GetCluster", runtimeID).Return(cluster, nil)
@@ -720,7 +1117,7 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 		},
 		{
 			description: "should fail to upgrade Shoot when failed to get cluster",
-			mockFunc: func(sessionFactory *sessionMocks.Factory, readSession *sessionMocks.ReadSession, _ *sessionMocks.WriteSessionWithinTransaction, _ *mocks2.Provisioner, _ *mocks2.ShootProvider) {
+			mockFunc: func(sessionFactory *sessionMocks.Factory, readSession *sessionMocks.ReadSession, _ *sessionMocks.WriteSessionWithinTransaction, _ *mocks2.Provisioner, _ *mocks2.ShootProvider, _ *mocks.OperationQueue) {
 				sessionFactory.On("NewReadSession").Return(readSession)
 				readSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
 				readSession.On("GetCluster", runtimeID).Return(model.Cluster{}, dberrors.Internal("error"))
@@ -728,21 +1125,21 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 		},
 		{
 			description: "should fail to upgrade Shoot when failed to get last operation",
-			mockFunc: func(sessionFactory *sessionMocks.Factory, readSession *sessionMocks.ReadSession, _ *sessionMocks.WriteSessionWithinTransaction, _ *mocks2.Provisioner, _ *mocks2.ShootProvider) {
+			mockFunc: func(sessionFactory *sessionMocks.Factory, readSession *sessionMocks.ReadSession, _ *sessionMocks.WriteSessionWithinTransaction, _ *mocks2.Provisioner, _ *mocks2.ShootProvider, _ *mocks.OperationQueue) {
 				sessionFactory.On("NewReadSession").Return(readSession)
 				readSession.On("GetLastOperation", runtimeID).Return(model.Operation{}, dberrors.Internal("error"))
 			},
 		},
 		{
 			description: "should fail to upgrade Shoot when last operation is in progress",
-			mockFunc: func(sessionFactory *sessionMocks.Factory, readSession *sessionMocks.ReadSession, _ *sessionMocks.WriteSessionWithinTransaction, _ *mocks2.Provisioner, _ *mocks2.ShootProvider) {
+			mockFunc: func(sessionFactory *sessionMocks.Factory, readSession *sessionMocks.ReadSession, _ *sessionMocks.WriteSessionWithinTransaction, _ *mocks2.Provisioner, _ *mocks2.ShootProvider, _ *mocks.OperationQueue) {
 				sessionFactory.On("NewReadSession").Return(readSession)
 				readSession.On("GetLastOperation", runtimeID).Return(model.Operation{State: model.InProgress}, nil)
 			},
 		},
 		{
 			description: "should fail to upgrade Shoot when failed to get Kubernetes version",
-			mockFunc: func(sessionFactory *sessionMocks.Factory, readSession *sessionMocks.ReadSession, _ *sessionMocks.WriteSessionWithinTransaction, _ *mocks2.Provisioner, shootProvider *mocks2.ShootProvider) {
+			mockFunc: func(sessionFactory *sessionMocks.Factory, readSession *sessionMocks.ReadSession, _ *sessionMocks.WriteSessionWithinTransaction, _ *mocks2.Provisioner, shootProvider *mocks2.ShootProvider, _ *mocks.OperationQueue) {
 				sessionFactory.On("NewReadSession").Return(readSession)
 				readSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
 				readSession.On("GetCluster", runtimeID).Return(cluster, nil)
@@ -761,12 +1158,12 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 
 			shootProvider := &mocks2.ShootProvider{}
 
-			testCase.mockFunc(sessionFactory, readSession, writeSessionWithinTransaction, provisioner, shootProvider)
+			testCase.mockFunc(sessionFactory, readSession, writeSessionWithinTransaction, provisioner, shootProvider, upgradeShootQueue)
 
-			service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, provisioner, uuidGenerator, shootProvider, nil, nil, upgradeShootQueue, nil)
+			service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, provisioner, uuidGenerator, shootProvider, nil, nil, upgradeShootQueue, nil, nil, nil, Quotas{}, events.NewNoopPublisher())
 
 			// when
-			_, err := service.UpgradeGardenerShoot(runtimeID, upgradeShootInput)
+			_, err := service.UpgradeGardenerShoot(context.Background(), runtimeID, upgradeShootInput)
 			require.Error(t, err)
 
 			// then
@@ -775,12 +1172,929 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 			readSession.AssertExpectations(t)
 		})
 	}
+
+	t.Run("should return dry run result without modifying database", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		provisioner := &mocks2.Provisioner{}
+		upgradeShootQueue := &mocks.OperationQueue{}
+		shootProvider := &mocks2.ShootProvider{}
+
+		dryRunResult := model.DryRunResult{
+			Manifest: "kind: Shoot",
+			Diff:     []model.ShootFieldDiff{{Path: "spec.kubernetes.version", OldValue: util.PtrTo(`"1.19"`), NewValue: util.PtrTo(`"1.20"`)}},
+		}
+
+		sessionFactory.On("NewReadSession").Return(readSession)
+		readSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
+		readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+		shootProvider.On("Get", runtimeID, tenant).Return(providedShoot("1.19"), nil)
+		provisioner.On("DryRunUpgradeCluster", mock.Anything, runtimeID, upgradedConfig).Return(dryRunResult, nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, provisioner, uuidGenerator, shootProvider, nil, nil, upgradeShootQueue, nil, nil, nil, Quotas{}, events.NewNoopPublisher())
+
+		// when
+		operationStatus, err := service.DryRunUpgradeGardenerShoot(context.Background(), runtimeID, upgradeShootInput)
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, runtimeID, *operationStatus.RuntimeID)
+		assert.Equal(t, gqlschema.OperationTypeUpgradeShoot, operationStatus.Operation)
+		require.NotNil(t, operationStatus.DryRunResult)
+		assert.Len(t, operationStatus.DryRunResult.Diff, 1)
+		sessionFactory.AssertNotCalled(t, "NewSessionWithinTransaction")
+		upgradeShootQueue.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+		provisioner.AssertExpectations(t)
+	})
+}
+
+func TestService_HibernateCluster(t *testing.T) {
+	inputConverter := NewInputConverter(uuid.NewUUIDGenerator(), gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2)
+	graphQLConverter := NewGraphQLConverter()
+	uuidGenerator := uuid.NewUUIDGenerator()
+
+	lastOperation := model.Operation{State: model.Succeeded}
+
+	cluster := model.Cluster{
+		ID:     runtimeID,
+		Tenant: tenant,
+		ClusterConfig: model.GardenerConfig{
+			ClusterID: runtimeID,
+			Name:      "shoot",
+		},
+	}
+
+	operationMatcher := getOperationMatcher(model.Operation{
+		ClusterID: runtimeID,
+		State:     model.InProgress,
+		Type:      model.Hibernate,
+		Stage:     model.WaitForHibernation,
+	})
+
+	t.Run("should start hibernation of the Runtime", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		writeSession := &sessionMocks.WriteSessionWithinTransaction{}
+		provisioner := &mocks2.Provisioner{}
+		shootProvider := &mocks2.ShootProvider{}
+		hibernationQueue := &mocks.OperationQueue{}
+
+		sessionFactory.On("NewReadSession").Return(readSession)
+		readSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
+		readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+		shootProvider.On("Get", runtimeID, tenant).Return(*testkit.NewTestShoot("shoot").WithHibernationState(true, false).ToShoot(), nil)
+		sessionFactory.On("NewSessionWithinTransaction").Return(writeSession, nil)
+		writeSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
+		writeSession.On("RollbackUnlessCommitted").Return()
+		writeSession.On("Commit").Return(nil)
+		provisioner.On("HibernateCluster", mock.Anything, runtimeID, cluster.ClusterConfig).Return(nil)
+		hibernationQueue.On("Schedule", mock.Anything, mock.AnythingOfType("string"), model.NormalPriority).Return(nil)
+		hibernationQueue.On("Add", mock.AnythingOfType("string"), model.NormalPriority).Return(nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, provisioner, uuidGenerator, shootProvider, nil, nil, nil, hibernationQueue, nil, nil, Quotas{}, events.NewNoopPublisher())
+
+		// when
+		operationStatus, err := service.HibernateCluster(context.Background(), runtimeID, nil)
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, runtimeID, *operationStatus.RuntimeID)
+		assert.Equal(t, gqlschema.OperationTypeHibernate, operationStatus.Operation)
+		assert.NotEmpty(t, operationStatus.ID)
+		sessionFactory.AssertExpectations(t)
+		readSession.AssertExpectations(t)
+		writeSession.AssertExpectations(t)
+		provisioner.AssertExpectations(t)
+		hibernationQueue.AssertExpectations(t)
+	})
+
+	for _, testCase := range []struct {
+		description string
+		shoot       gardener_Types.Shoot
+	}{
+		{
+			description: "should fail to hibernate the Runtime when it is already hibernated",
+			shoot:       *testkit.NewTestShoot("shoot").WithHibernationState(true, true).ToShoot(),
+		},
+		{
+			description: "should fail to hibernate the Runtime when hibernation is not possible",
+			shoot:       *testkit.NewTestShoot("shoot").WithHibernationState(false, false).ToShoot(),
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// given
+			sessionFactory := &sessionMocks.Factory{}
+			readSession := &sessionMocks.ReadSession{}
+			shootProvider := &mocks2.ShootProvider{}
+
+			sessionFactory.On("NewReadSession").Return(readSession)
+			readSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
+			readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+			shootProvider.On("Get", runtimeID, tenant).Return(testCase.shoot, nil)
+
+			service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, nil, uuidGenerator, shootProvider, nil, nil, nil, nil, nil, nil, Quotas{}, events.NewNoopPublisher())
+
+			// when
+			_, err := service.HibernateCluster(context.Background(), runtimeID, nil)
+
+			// then
+			require.Error(t, err)
+			assert.Equal(t, apperrors.CodeBadRequest, err.Code())
+			sessionFactory.AssertExpectations(t)
+			readSession.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_WakeUpCluster(t *testing.T) {
+	inputConverter := NewInputConverter(uuid.NewUUIDGenerator(), gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2)
+	graphQLConverter := NewGraphQLConverter()
+	uuidGenerator := uuid.NewUUIDGenerator()
+
+	lastOperation := model.Operation{State: model.Succeeded}
+
+	cluster := model.Cluster{
+		ID:     runtimeID,
+		Tenant: tenant,
+		ClusterConfig: model.GardenerConfig{
+			ClusterID: runtimeID,
+			Name:      "shoot",
+		},
+	}
+
+	operationMatcher := getOperationMatcher(model.Operation{
+		ClusterID: runtimeID,
+		State:     model.InProgress,
+		Type:      model.WakeUp,
+		Stage:     model.WaitForWakeUp,
+		Priority:  model.HighPriority,
+	})
+
+	t.Run("should start wake up of the Runtime with requested priority", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		writeSession := &sessionMocks.WriteSessionWithinTransaction{}
+		provisioner := &mocks2.Provisioner{}
+		shootProvider := &mocks2.ShootProvider{}
+		wakeUpQueue := &mocks.OperationQueue{}
+
+		sessionFactory.On("NewReadSession").Return(readSession)
+		readSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
+		readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+		shootProvider.On("Get", runtimeID, tenant).Return(*testkit.NewTestShoot("shoot").WithHibernationState(true, true).ToShoot(), nil)
+		sessionFactory.On("NewSessionWithinTransaction").Return(writeSession, nil)
+		writeSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
+		writeSession.On("RollbackUnlessCommitted").Return()
+		writeSession.On("Commit").Return(nil)
+		provisioner.On("WakeUpCluster", mock.Anything, runtimeID, cluster.ClusterConfig).Return(nil)
+		wakeUpQueue.On("Schedule", mock.Anything, mock.AnythingOfType("string"), model.HighPriority).Return(nil)
+		wakeUpQueue.On("Add", mock.AnythingOfType("string"), model.HighPriority).Return(nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, provisioner, uuidGenerator, shootProvider, nil, nil, nil, nil, wakeUpQueue, nil, Quotas{}, events.NewNoopPublisher())
+
+		// when
+		operationStatus, err := service.WakeUpCluster(context.Background(), runtimeID, util.PtrTo(gqlschema.OperationPriorityHigh))
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, runtimeID, *operationStatus.RuntimeID)
+		assert.Equal(t, gqlschema.OperationTypeWakeUp, operationStatus.Operation)
+		assert.NotEmpty(t, operationStatus.ID)
+		sessionFactory.AssertExpectations(t)
+		readSession.AssertExpectations(t)
+		writeSession.AssertExpectations(t)
+		provisioner.AssertExpectations(t)
+		wakeUpQueue.AssertExpectations(t)
+	})
+
+	t.Run("should fail to wake up the Runtime when it is not hibernated", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		shootProvider := &mocks2.ShootProvider{}
+
+		sessionFactory.On("NewReadSession").Return(readSession)
+		readSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
+		readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+		shootProvider.On("Get", runtimeID, tenant).Return(*testkit.NewTestShoot("shoot").WithHibernationState(true, false).ToShoot(), nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, nil, uuidGenerator, shootProvider, nil, nil, nil, nil, nil, nil, Quotas{}, events.NewNoopPublisher())
+
+		// when
+		_, err := service.WakeUpCluster(context.Background(), runtimeID, nil)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeBadRequest, err.Code())
+		sessionFactory.AssertExpectations(t)
+		readSession.AssertExpectations(t)
+	})
+}
+
+func TestService_CancelOperation(t *testing.T) {
+	inputConverter := NewInputConverter(uuid.NewUUIDGenerator(), gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2)
+	graphQLConverter := NewGraphQLConverter()
+
+	operation := model.Operation{
+		ID:        operationID,
+		Type:      model.UpgradeShoot,
+		State:     model.InProgress,
+		ClusterID: runtimeID,
+		Stage:     model.WaitingForShootUpgrade,
+	}
+
+	cancelledOperation := operation
+	cancelledOperation.State = model.Cancelled
+	cancelledOperation.Message = "Operation cancelled"
+
+	t.Run("should cancel operation in progress", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readWriteSession := &sessionMocks.ReadWriteSession{}
+		txSession := &sessionMocks.WriteSessionWithinTransaction{}
+
+		sessionFactory.On("NewReadSession").Return(readWriteSession)
+		readWriteSession.On("GetOperation", operationID).Return(operation, nil).Once()
+		sessionFactory.On("NewSessionWithinTransaction").Return(txSession, nil)
+		txSession.On("CancelOperation", operationID, "Operation cancelled", mock.AnythingOfType("time.Time")).Return(nil)
+		txSession.On("Commit").Return(nil)
+		txSession.On("RollbackUnlessCommitted").Return()
+		readWriteSession.On("GetOperation", operationID).Return(cancelledOperation, nil).Once()
+		shootUpgradeQueue := &mocks.OperationQueue{}
+		shootUpgradeQueue.On("Unschedule", txSession, operationID).Return(nil)
+		publisher := &eventsMocks.Publisher{}
+		publisher.On("Publish", events.OperationEvent{OperationID: operationID, RuntimeID: runtimeID}).Return()
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, nil, nil, nil, nil, nil, shootUpgradeQueue, nil, nil, nil, Quotas{}, publisher)
+
+		// when
+		status, err := service.CancelOperation(context.Background(), operationID)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, gqlschema.OperationStateCancelled, status.State)
+		assert.Equal(t, operationID, *status.ID)
+		readWriteSession.AssertExpectations(t)
+		txSession.AssertExpectations(t)
+		shootUpgradeQueue.AssertExpectations(t)
+		publisher.AssertExpectations(t)
+	})
+
+	t.Run("should return error when operation is not in progress", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readWriteSession := &sessionMocks.ReadWriteSession{}
+
+		finishedOperation := operation
+		finishedOperation.State = model.Succeeded
+
+		sessionFactory.On("NewReadSession").Return(readWriteSession)
+		readWriteSession.On("GetOperation", operationID).Return(finishedOperation, nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, nil, nil, nil, nil, nil, nil, nil, nil, nil, Quotas{}, events.NewNoopPublisher())
+
+		// when
+		_, err := service.CancelOperation(context.Background(), operationID)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeBadRequest, err.Code())
+		readWriteSession.AssertNotCalled(t, "CancelOperation", operationID, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should return error when operation finished before it was cancelled", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readWriteSession := &sessionMocks.ReadWriteSession{}
+		txSession := &sessionMocks.WriteSessionWithinTransaction{}
+
+		sessionFactory.On("NewReadSession").Return(readWriteSession)
+		readWriteSession.On("GetOperation", operationID).Return(operation, nil)
+		sessionFactory.On("NewSessionWithinTransaction").Return(txSession, nil)
+		txSession.On("CancelOperation", operationID, "Operation cancelled", mock.AnythingOfType("time.Time")).Return(dberrors.NotFound("error"))
+		txSession.On("RollbackUnlessCommitted").Return()
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, nil, nil, nil, nil, nil, nil, nil, nil, nil, Quotas{}, events.NewNoopPublisher())
+
+		// when
+		_, err := service.CancelOperation(context.Background(), operationID)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeBadRequest, err.Code())
+	})
+
+	t.Run("should return error when failed to get operation", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readWriteSession := &sessionMocks.ReadWriteSession{}
+
+		sessionFactory.On("NewReadSession").Return(readWriteSession)
+		readWriteSession.On("GetOperation", operationID).Return(model.Operation{}, dberrors.Internal("error"))
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, nil, nil, nil, nil, nil, nil, nil, nil, nil, Quotas{}, events.NewNoopPublisher())
+
+		// when
+		_, err := service.CancelOperation(context.Background(), operationID)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, dberrors.CodeInternal, err.Code())
+	})
+}
+
+func TestService_RetryOperation(t *testing.T) {
+	inputConverter := NewInputConverter(uuid.NewUUIDGenerator(), gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2)
+	graphQLConverter := NewGraphQLConverter()
+	uuidGenerator := uuid.NewUUIDGenerator()
+
+	operation := model.Operation{
+		ID:        operationID,
+		Type:      model.Provision,
+		State:     model.Failed,
+		Message:   "error: timeout while processing operation",
+		ClusterID: runtimeID,
+		Stage:     model.WaitingForClusterCreation,
+		LastError: model.LastError{
+			ErrMessage: "error: timeout while processing operation",
+			Reason:     string(apperrors.ErrProvisionerTimeout),
+			Component:  string(apperrors.ErrProvisioner),
+		},
+	}
+
+	cluster := model.Cluster{ID: runtimeID, Tenant: tenant}
+
+	t.Run("should retry failed operation from its stage", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		writeSession := &sessionMocks.WriteSessionWithinTransaction{}
+		provisioningQueue := &mocks.OperationQueue{}
+		shootProvider := &mocks2.ShootProvider{}
+
+		sessionFactory.On("NewReadSession").Return(readSession)
+		readSession.On("GetOperation", operationID).Return(operation, nil)
+		readSession.On("GetLastOperation", runtimeID).Return(operation, nil)
+		readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+		shootProvider.On("Get", runtimeID, tenant).Return(gardener_Types.Shoot{}, nil)
+		sessionFactory.On("NewSessionWithinTransaction").Return(writeSession, nil)
+		writeSession.On("InsertOperationRetry", mock.MatchedBy(func(retry model.OperationRetry) bool {
+			return retry.ID != "" && retry.OperationID == operationID && retry.Stage == model.WaitingForClusterCreation &&
+				retry.Message == operation.Message && retry.LastError == operation.LastError
+		})).Return(nil)
+		writeSession.On("RetryOperation", operationID, "Operation retried. Stage WaitingForClusterCreation", mock.AnythingOfType("time.Time")).Return(nil)
+		writeSession.On("RollbackUnlessCommitted").Return()
+		writeSession.On("Commit").Return(nil)
+		provisioningQueue.On("Schedule", mock.Anything, operationID, model.NormalPriority).Return(nil)
+		provisioningQueue.On("Add", operationID, model.NormalPriority).Return()
+		publisher := &eventsMocks.Publisher{}
+		publisher.On("Publish", events.OperationEvent{OperationID: operationID, RuntimeID: runtimeID}).Return()
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, nil, uuidGenerator, shootProvider, provisioningQueue, nil, nil, nil, nil, nil, Quotas{}, publisher)
+
+		// when
+		status, err := service.RetryOperation(context.Background(), operationID)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, gqlschema.OperationStateInProgress, status.State)
+		assert.Equal(t, operationID, *status.ID)
+		writeSession.AssertExpectations(t)
+		provisioningQueue.AssertExpectations(t)
+		publisher.AssertExpectations(t)
+	})
+
+	t.Run("should re-apply upgraded Gardener config when retrying Shoot upgrade", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		writeSession := &sessionMocks.WriteSessionWithinTransaction{}
+		shootUpgradeQueue := &mocks.OperationQueue{}
+
+		upgradeShootOperation := operation
+		upgradeShootOperation.Type = model.UpgradeShoot
+		upgradeShootOperation.Stage = model.WaitingForShootUpgrade
+
+		upgradedConfig := model.GardenerConfig{ID: "gardener-config-id", ClusterID: runtimeID, KubernetesVersion: "1.28"}
+
+		sessionFactory.On("NewReadSession").Return(readSession)
+		readSession.On("GetOperation", operationID).Return(upgradeShootOperation, nil)
+		readSession.On("GetLastOperation", runtimeID).Return(upgradeShootOperation, nil)
+		readSession.On("GetUpgradedGardenerConfigBackup", operationID).Return(upgradedConfig, nil)
+		sessionFactory.On("NewSessionWithinTransaction").Return(writeSession, nil)
+		writeSession.On("UpdateGardenerClusterConfig", upgradedConfig).Return(nil)
+		writeSession.On("InsertOperationRetry", mock.AnythingOfType("model.OperationRetry")).Return(nil)
+		writeSession.On("RetryOperation", operationID, "Operation retried. Stage WaitingForShootUpgrade", mock.AnythingOfType("time.Time")).Return(nil)
+		writeSession.On("RollbackUnlessCommitted").Return()
+		writeSession.On("Commit").Return(nil)
+		shootUpgradeQueue.On("Schedule", mock.Anything, operationID, model.NormalPriority).Return(nil)
+		shootUpgradeQueue.On("Add", operationID, model.NormalPriority).Return()
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, nil, uuidGenerator, nil, nil, nil, shootUpgradeQueue, nil, nil, nil, Quotas{}, events.NewNoopPublisher())
+
+		// when
+		status, err := service.RetryOperation(context.Background(), operationID)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, gqlschema.OperationStateInProgress, status.State)
+		writeSession.AssertExpectations(t)
+		shootUpgradeQueue.AssertExpectations(t)
+	})
+
+	t.Run("should retry Shoot upgrade without upgraded Gardener config backup", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		writeSession := &sessionMocks.WriteSessionWithinTransaction{}
+		shootUpgradeQueue := &mocks.OperationQueue{}
+
+		upgradeShootOperation := operation
+		upgradeShootOperation.Type = model.UpgradeShoot
+		upgradeShootOperation.Stage = model.WaitingForShootUpgrade
+
+		sessionFactory.On("NewReadSession").Return(readSession)
+		readSession.On("GetOperation", operationID).Return(upgradeShootOperation, nil)
+		readSession.On("GetLastOperation", runtimeID).Return(upgradeShootOperation, nil)
+		readSession.On("GetUpgradedGardenerConfigBackup", operationID).Return(model.GardenerConfig{}, dberrors.NotFound("error"))
+		sessionFactory.On("NewSessionWithinTransaction").Return(writeSession, nil)
+		writeSession.On("InsertOperationRetry", mock.AnythingOfType("model.OperationRetry")).Return(nil)
+		writeSession.On("RetryOperation", operationID, mock.AnythingOfType("string"), mock.AnythingOfType("time.Time")).Return(nil)
+		writeSession.On("RollbackUnlessCommitted").Return()
+		writeSession.On("Commit").Return(nil)
+		shootUpgradeQueue.On("Schedule", mock.Anything, operationID, model.NormalPriority).Return(nil)
+		shootUpgradeQueue.On("Add", operationID, model.NormalPriority).Return()
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, nil, uuidGenerator, nil, nil, nil, shootUpgradeQueue, nil, nil, nil, Quotas{}, events.NewNoopPublisher())
+
+		// when
+		_, err := service.RetryOperation(context.Background(), operationID)
+
+		// then
+		require.NoError(t, err)
+		writeSession.AssertNotCalled(t, "UpdateGardenerClusterConfig", mock.Anything)
+		shootUpgradeQueue.AssertExpectations(t)
+	})
+
+	t.Run("should return error when operation is not failed", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+
+		succeededOperation := operation
+		succeededOperation.State = model.Succeeded
+
+		sessionFactory.On("NewReadSession").Return(readSession)
+		readSession.On("GetOperation", operationID).Return(succeededOperation, nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, Quotas{}, events.NewNoopPublisher())
+
+		// when
+		_, err := service.RetryOperation(context.Background(), operationID)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeBadRequest, err.Code())
+	})
+
+	t.Run("should return error when operation type cannot be retried", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+
+		upgradeOperation := operation
+		upgradeOperation.Type = model.Upgrade
+
+		sessionFactory.On("NewReadSession").Return(readSession)
+		readSession.On("GetOperation", operationID).Return(upgradeOperation, nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, Quotas{}, events.NewNoopPublisher())
+
+		// when
+		_, err := service.RetryOperation(context.Background(), operationID)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeBadRequest, err.Code())
+	})
+
+	t.Run("should return error when operation is not the last one", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+
+		sessionFactory.On("NewReadSession").Return(readSession)
+		readSession.On("GetOperation", operationID).Return(operation, nil)
+		readSession.On("GetLastOperation", runtimeID).Return(model.Operation{ID: "newer-operation", State: model.Succeeded}, nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, nil, uuidGenerator, nil, &mocks.OperationQueue{}, nil, nil, nil, nil, nil, Quotas{}, events.NewNoopPublisher())
+
+		// when
+		_, err := service.RetryOperation(context.Background(), operationID)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeBadRequest, err.Code())
+		sessionFactory.AssertNotCalled(t, "NewSessionWithinTransaction")
+	})
+
+	t.Run("should return error when Shoot of failed provisioning was deleted", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		provisioningQueue := &mocks.OperationQueue{}
+		shootProvider := &mocks2.ShootProvider{}
+
+		sessionFactory.On("NewReadSession").Return(readSession)
+		readSession.On("GetOperation", operationID).Return(operation, nil)
+		readSession.On("GetLastOperation", runtimeID).Return(operation, nil)
+		readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+		shootProvider.On("Get", runtimeID, tenant).Return(gardener_Types.Shoot{}, apperrors.MissingShoot("error"))
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, nil, uuidGenerator, shootProvider, provisioningQueue, nil, nil, nil, nil, nil, Quotas{}, events.NewNoopPublisher())
+
+		// when
+		_, err := service.RetryOperation(context.Background(), operationID)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeBadRequest, err.Code())
+		sessionFactory.AssertNotCalled(t, "NewSessionWithinTransaction")
+		provisioningQueue.AssertNotCalled(t, "Add", operationID, mock.Anything)
+	})
+
+	t.Run("should return error when failed to get Shoot of failed provisioning", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		shootProvider := &mocks2.ShootProvider{}
+
+		sessionFactory.On("NewReadSession").Return(readSession)
+		readSession.On("GetOperation", operationID).Return(operation, nil)
+		readSession.On("GetLastOperation", runtimeID).Return(operation, nil)
+		readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+		shootProvider.On("Get", runtimeID, tenant).Return(gardener_Types.Shoot{}, apperrors.Internal("error"))
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, nil, uuidGenerator, shootProvider, &mocks.OperationQueue{}, nil, nil, nil, nil, nil, Quotas{}, events.NewNoopPublisher())
+
+		// when
+		_, err := service.RetryOperation(context.Background(), operationID)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeInternal, err.Code())
+		sessionFactory.AssertNotCalled(t, "NewSessionWithinTransaction")
+	})
+
+	t.Run("should not enqueue operation when it was retried concurrently", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		writeSession := &sessionMocks.WriteSessionWithinTransaction{}
+		provisioningQueue := &mocks.OperationQueue{}
+		shootProvider := &mocks2.ShootProvider{}
+
+		sessionFactory.On("NewReadSession").Return(readSession)
+		readSession.On("GetOperation", operationID).Return(operation, nil)
+		readSession.On("GetLastOperation", runtimeID).Return(operation, nil)
+		readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+		shootProvider.On("Get", runtimeID, tenant).Return(gardener_Types.Shoot{}, nil)
+		sessionFactory.On("NewSessionWithinTransaction").Return(writeSession, nil)
+		writeSession.On("InsertOperationRetry", mock.AnythingOfType("model.OperationRetry")).Return(nil)
+		writeSession.On("RetryOperation", operationID, mock.AnythingOfType("string"), mock.AnythingOfType("time.Time")).Return(dberrors.NotFound("error"))
+		writeSession.On("RollbackUnlessCommitted").Return()
+		publisher := &eventsMocks.Publisher{}
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, nil, uuidGenerator, shootProvider, provisioningQueue, nil, nil, nil, nil, nil, Quotas{}, publisher)
+
+		// when
+		_, err := service.RetryOperation(context.Background(), operationID)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeBadRequest, err.Code())
+		writeSession.AssertNotCalled(t, "Commit")
+		provisioningQueue.AssertNotCalled(t, "Add", operationID, mock.Anything)
+		publisher.AssertNotCalled(t, "Publish", mock.Anything)
+	})
+}
+
+func TestService_ListRuntimes(t *testing.T) {
+	inputConverter := NewInputConverter(uuid.NewUUIDGenerator(), gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2)
+	graphQLConverter := NewGraphQLConverter()
+
+	creationTimestamp := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	newRuntimeStatus := func(id string, offset time.Duration) model.RuntimeStatus {
+		return model.RuntimeStatus{
+			LastOperationStatus: model.Operation{ID: "op-" + id, Type: model.Provision, State: model.Succeeded, ClusterID: id},
+			RuntimeConfiguration: model.Cluster{
+				ID:                id,
+				Tenant:            tenant,
+				CreationTimestamp: creationTimestamp.Add(offset),
+				ClusterConfig: model.GardenerConfig{
+					Name:              "shoot-" + id,
+					Provider:          "gcp",
+					Region:            "europe-west1",
+					KubernetesVersion: "1.27",
+				},
+			},
+		}
+	}
+
+	runtimes := []model.RuntimeStatus{
+		newRuntimeStatus("runtime-1", 0),
+		newRuntimeStatus("runtime-2", time.Minute),
+		newRuntimeStatus("runtime-3", 2*time.Minute),
+	}
+
+	t.Run("should return first page of Runtimes matching the filter", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+
+		failed := gqlschema.OperationStateFailed
+		expectedFilter := model.RuntimeFilter{
+			Tenant:             util.PtrTo(tenant),
+			LastOperationState: util.PtrTo(model.Failed),
+		}
+
+		sessionFactory.On("NewReadSession").Return(readSession)
+		readSession.On("ListRuntimes", expectedFilter, (*model.PageCursor)(nil), 3).Return(runtimes, nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, nil, nil, nil, nil, nil, nil, nil, nil, nil, Quotas{}, events.NewNoopPublisher())
+
+		// when
+		page, err := service.ListRuntimes(context.Background(), &gqlschema.RuntimeFilterInput{Tenant: util.PtrTo(tenant), LastOperationState: &failed}, util.PtrTo(2), nil)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, page.Data, 2)
+		assert.Equal(t, "runtime-1", page.Data[0].ID)
+		assert.Equal(t, "shoot-runtime-1", page.Data[0].Name)
+		assert.Equal(t, "op-runtime-1", *page.Data[0].LastOperationStatus.ID)
+		assert.Equal(t, "runtime-2", page.Data[1].ID)
+		assert.True(t, page.PageInfo.HasNextPage)
+		require.NotNil(t, page.PageInfo.EndCursor)
+
+		cursor, err := decodeCursor(page.PageInfo.EndCursor)
+		require.NoError(t, err)
+		assert.Equal(t, "runtime-2", cursor.ID)
+		assert.True(t, creationTimestamp.Add(time.Minute).Equal(cursor.Timestamp))
+		readSession.AssertExpectations(t)
+	})
+
+	t.Run("should return last page of Runtimes after the cursor", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+
+		after := model.PageCursor{Timestamp: creationTimestamp.Add(time.Minute), ID: "runtime-2"}
+
+		sessionFactory.On("NewReadSession").Return(readSession)
+		readSession.On("ListRuntimes", model.RuntimeFilter{}, mock.MatchedBy(func(cursor *model.PageCursor) bool {
+			return cursor != nil && cursor.ID == after.ID && cursor.Timestamp.Equal(after.Timestamp)
+		}), defaultPageSize+1).Return(runtimes[2:], nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, nil, nil, nil, nil, nil, nil, nil, nil, nil, Quotas{}, events.NewNoopPublisher())
+
+		// when
+		page, err := service.ListRuntimes(context.Background(), nil, nil, util.PtrTo(encodeCursor(after)))
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, page.Data, 1)
+		assert.Equal(t, "runtime-3", page.Data[0].ID)
+		assert.False(t, page.PageInfo.HasNextPage)
+		readSession.AssertExpectations(t)
+	})
+
+	t.Run("should return empty page when no Runtimes match the filter", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+
+		sessionFactory.On("NewReadSession").Return(readSession)
+		readSession.On("ListRuntimes", model.RuntimeFilter{Deleted: util.PtrTo(true)}, (*model.PageCursor)(nil), defaultPageSize+1).Return([]model.RuntimeStatus{}, nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, nil, nil, nil, nil, nil, nil, nil, nil, nil, Quotas{}, events.NewNoopPublisher())
+
+		// when
+		page, err := service.ListRuntimes(context.Background(), &gqlschema.RuntimeFilterInput{Deleted: util.PtrTo(true)}, nil, nil)
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, page.Data)
+		assert.False(t, page.PageInfo.HasNextPage)
+		assert.Nil(t, page.PageInfo.EndCursor)
+	})
+
+	for _, testCase := range []struct {
+		description string
+		filter      *gqlschema.RuntimeFilterInput
+		first       *int
+		after       *string
+	}{
+		{
+			description: "should return error when page size is too small",
+			first:       util.PtrTo(0),
+		},
+		{
+			description: "should return error when page size is too big",
+			first:       util.PtrTo(maxPageSize + 1),
+		},
+		{
+			description: "should return error when cursor is malformed",
+			after:       util.PtrTo("not a cursor"),
+		},
+		{
+			description: "should return error when filtering by unsupported operation state",
+			filter:      &gqlschema.RuntimeFilterInput{LastOperationState: util.PtrTo(gqlschema.OperationStatePending)},
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// given
+			sessionFactory := &sessionMocks.Factory{}
+
+			service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, nil, nil, nil, nil, nil, nil, nil, nil, nil, Quotas{}, events.NewNoopPublisher())
+
+			// when
+			_, err := service.ListRuntimes(context.Background(), testCase.filter, testCase.first, testCase.after)
+
+			// then
+			require.Error(t, err)
+			assert.Equal(t, apperrors.CodeBadRequest, err.Code())
+			sessionFactory.AssertExpectations(t)
+		})
+	}
+
+	t.Run("should return error when failed to list Runtimes", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+
+		sessionFactory.On("NewReadSession").Return(readSession)
+		readSession.On("ListRuntimes", model.RuntimeFilter{}, (*model.PageCursor)(nil), defaultPageSize+1).Return(nil, dberrors.Internal("error"))
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, nil, nil, nil, nil, nil, nil, nil, nil, nil, Quotas{}, events.NewNoopPublisher())
+
+		// when
+		_, err := service.ListRuntimes(context.Background(), nil, nil, nil)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, dberrors.CodeInternal, err.Code())
+	})
+}
+
+func TestService_RuntimeOperations(t *testing.T) {
+	inputConverter := NewInputConverter(uuid.NewUUIDGenerator(), gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2)
+	graphQLConverter := NewGraphQLConverter()
+
+	startTimestamp := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	endTimestamp := startTimestamp.Add(30 * time.Minute)
+
+	operations := []model.Operation{
+		{ID: "op-1", Type: model.ProvisionNoInstall, State: model.Succeeded, Stage: model.FinishedStage, ClusterID: runtimeID, StartTimestamp: startTimestamp, EndTimestamp: &endTimestamp},
+		{ID: "op-2", Type: model.UpgradeShoot, State: model.Failed, Stage: model.WaitingForClusterDomain, ClusterID: runtimeID, StartTimestamp: startTimestamp.Add(time.Hour), LastError: model.LastError{ErrMessage: "error", Reason: "ERR_INFRA_QUOTA_EXCEEDED", Component: "gardener"}},
+		{ID: "op-3", Type: model.Hibernate, State: model.InProgress, Stage: model.WaitForHibernation, ClusterID: runtimeID, StartTimestamp: startTimestamp.Add(2 * time.Hour)},
+	}
+
+	t.Run("should return first page of operations matching the filter", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+
+		expectedFilter := model.OperationFilter{
+			Types:  []model.OperationType{model.Provision, model.ProvisionNoInstall, model.UpgradeShoot},
+			States: []model.OperationState{model.Succeeded, model.Failed},
+		}
+
+		sessionFactory.On("NewReadSession").Return(readSession)
+		readSession.On("ListOperations", runtimeID, expectedFilter, (*model.PageCursor)(nil), 2).Return(operations[:2], nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, nil, nil, nil, nil, nil, nil, nil, nil, nil, Quotas{}, events.NewNoopPublisher())
+
+		// when
+		page, err := service.RuntimeOperations(context.Background(), runtimeID,
+			[]gqlschema.OperationType{gqlschema.OperationTypeProvision, gqlschema.OperationTypeUpgradeShoot},
+			[]gqlschema.OperationState{gqlschema.OperationStateSucceeded, gqlschema.OperationStateFailed},
+			util.PtrTo(1), nil)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, page.Data, 1)
+		assert.Equal(t, &gqlschema.OperationHistoryEntry{
+			ID:             "op-1",
+			Operation:      gqlschema.OperationTypeProvision,
+			State:          gqlschema.OperationStateSucceeded,
+			Stage:          string(model.FinishedStage),
+			Message:        util.PtrTo(""),
+			StartTimestamp: startTimestamp,
+			EndTimestamp:   &endTimestamp,
+			LastError:      &gqlschema.LastError{},
+		}, page.Data[0])
+		assert.True(t, page.PageInfo.HasNextPage)
+		require.NotNil(t, page.PageInfo.EndCursor)
+
+		cursor, err := decodeCursor(page.PageInfo.EndCursor)
+		require.NoError(t, err)
+		assert.Equal(t, "op-1", cursor.ID)
+		assert.True(t, startTimestamp.Equal(cursor.Timestamp))
+		readSession.AssertExpectations(t)
+	})
+
+	t.Run("should return last page of operations after the cursor", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+
+		after := model.PageCursor{Timestamp: startTimestamp, ID: "op-1"}
+
+		sessionFactory.On("NewReadSession").Return(readSession)
+		readSession.On("ListOperations", runtimeID, model.OperationFilter{}, mock.MatchedBy(func(cursor *model.PageCursor) bool {
+			return cursor != nil && cursor.ID == after.ID && cursor.Timestamp.Equal(after.Timestamp)
+		}), defaultPageSize+1).Return(operations[1:], nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, nil, nil, nil, nil, nil, nil, nil, nil, nil, Quotas{}, events.NewNoopPublisher())
+
+		// when
+		page, err := service.RuntimeOperations(context.Background(), runtimeID, nil, nil, nil, util.PtrTo(encodeCursor(after)))
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, page.Data, 2)
+		assert.Equal(t, "op-2", page.Data[0].ID)
+		assert.Equal(t, &gqlschema.LastError{ErrMessage: "error", Reason: "ERR_INFRA_QUOTA_EXCEEDED", Component: "gardener"}, page.Data[0].LastError)
+		assert.Equal(t, "op-3", page.Data[1].ID)
+		assert.Equal(t, gqlschema.OperationTypeHibernate, page.Data[1].Operation)
+		assert.Equal(t, string(model.WaitForHibernation), page.Data[1].Stage)
+		assert.Nil(t, page.Data[1].EndTimestamp)
+		assert.False(t, page.PageInfo.HasNextPage)
+		readSession.AssertExpectations(t)
+	})
+
+	for _, testCase := range []struct {
+		description string
+		states      []gqlschema.OperationState
+		first       *int
+		after       *string
+	}{
+		{
+			description: "should return error when page size is too big",
+			first:       util.PtrTo(maxPageSize + 1),
+		},
+		{
+			description: "should return error when cursor is malformed",
+			after:       util.PtrTo("not a cursor"),
+		},
+		{
+			description: "should return error when filtering by unsupported operation state",
+			states:      []gqlschema.OperationState{gqlschema.OperationStatePending},
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// given
+			sessionFactory := &sessionMocks.Factory{}
+
+			service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, nil, nil, nil, nil, nil, nil, nil, nil, nil, Quotas{}, events.NewNoopPublisher())
+
+			// when
+			_, err := service.RuntimeOperations(context.Background(), runtimeID, nil, testCase.states, testCase.first, testCase.after)
+
+			// then
+			require.Error(t, err)
+			assert.Equal(t, apperrors.CodeBadRequest, err.Code())
+			sessionFactory.AssertExpectations(t)
+		})
+	}
+
+	t.Run("should return error when failed to list operations", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+
+		sessionFactory.On("NewReadSession").Return(readSession)
+		readSession.On("ListOperations", runtimeID, model.OperationFilter{}, (*model.PageCursor)(nil), defaultPageSize+1).Return(nil, dberrors.Internal("error"))
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, nil, nil, nil, nil, nil, nil, nil, nil, nil, Quotas{}, events.NewNoopPublisher())
+
+		// when
+		_, err := service.RuntimeOperations(context.Background(), runtimeID, nil, nil, nil, nil)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, dberrors.CodeInternal, err.Code())
+	})
 }
 
 func getOperationMatcher(expected model.Operation) func(model.Operation) bool {
 	return func(op model.Operation) bool {
 		return op.Type == expected.Type && op.ClusterID == expected.ClusterID &&
-			op.State == expected.State && op.Stage == expected.Stage
+			op.State == expected.State && op.Stage == expected.Stage && op.Priority == expected.Priority
 	}
 }
 
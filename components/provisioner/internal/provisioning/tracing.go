@@ -0,0 +1,137 @@
+package provisioning
+
+import (
+	"context"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/tracing"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracedService starts span for every call of the Service, IDs of the Runtime and the operation are added to the span as attributes
+type tracedService struct {
+	service Service
+}
+
+func (s tracedService) ProvisionRuntime(ctx context.Context, config gqlschema.ProvisionRuntimeInput, tenant, subAccount string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	ctx, span := tracing.Start(ctx, "Service.ProvisionRuntime")
+	status, err := s.service.ProvisionRuntime(ctx, config, tenant, subAccount)
+	endWithOperationStatus(span, status, err)
+
+	return status, err
+}
+
+func (s tracedService) DryRunProvisionRuntime(ctx context.Context, config gqlschema.ProvisionRuntimeInput, tenant, subAccount string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	ctx, span := tracing.Start(ctx, "Service.DryRunProvisionRuntime")
+	status, err := s.service.DryRunProvisionRuntime(ctx, config, tenant, subAccount)
+	endWithOperationStatus(span, status, err)
+
+	return status, err
+}
+
+func (s tracedService) DeprovisionRuntime(ctx context.Context, id string, priority *gqlschema.OperationPriority) (string, apperrors.AppError) {
+	ctx, span := tracing.Start(ctx, "Service.DeprovisionRuntime", tracing.RuntimeIDKey.String(id))
+	operationID, err := s.service.DeprovisionRuntime(ctx, id, priority)
+	if operationID != "" {
+		span.SetAttributes(tracing.OperationIDKey.String(operationID))
+	}
+	tracing.End(span, err)
+
+	return operationID, err
+}
+
+func (s tracedService) UpgradeGardenerShoot(ctx context.Context, id string, input gqlschema.UpgradeShootInput) (*gqlschema.OperationStatus, apperrors.AppError) {
+	ctx, span := tracing.Start(ctx, "Service.UpgradeGardenerShoot", tracing.RuntimeIDKey.String(id))
+	status, err := s.service.UpgradeGardenerShoot(ctx, id, input)
+	endWithOperationStatus(span, status, err)
+
+	return status, err
+}
+
+func (s tracedService) DryRunUpgradeGardenerShoot(ctx context.Context, id string, input gqlschema.UpgradeShootInput) (*gqlschema.OperationStatus, apperrors.AppError) {
+	ctx, span := tracing.Start(ctx, "Service.DryRunUpgradeGardenerShoot", tracing.RuntimeIDKey.String(id))
+	status, err := s.service.DryRunUpgradeGardenerShoot(ctx, id, input)
+	endWithOperationStatus(span, status, err)
+
+	return status, err
+}
+
+func (s tracedService) HibernateCluster(ctx context.Context, id string, priority *gqlschema.OperationPriority) (*gqlschema.OperationStatus, apperrors.AppError) {
+	ctx, span := tracing.Start(ctx, "Service.HibernateCluster", tracing.RuntimeIDKey.String(id))
+	status, err := s.service.HibernateCluster(ctx, id, priority)
+	endWithOperationStatus(span, status, err)
+
+	return status, err
+}
+
+func (s tracedService) WakeUpCluster(ctx context.Context, id string, priority *gqlschema.OperationPriority) (*gqlschema.OperationStatus, apperrors.AppError) {
+	ctx, span := tracing.Start(ctx, "Service.WakeUpCluster", tracing.RuntimeIDKey.String(id))
+	status, err := s.service.WakeUpCluster(ctx, id, priority)
+	endWithOperationStatus(span, status, err)
+
+	return status, err
+}
+
+func (s tracedService) CancelOperation(ctx context.Context, id string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	ctx, span := tracing.Start(ctx, "Service.CancelOperation", tracing.OperationIDKey.String(id))
+	status, err := s.service.CancelOperation(ctx, id)
+	endWithOperationStatus(span, status, err)
+
+	return status, err
+}
+
+func (s tracedService) RetryOperation(ctx context.Context, id string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	ctx, span := tracing.Start(ctx, "Service.RetryOperation", tracing.OperationIDKey.String(id))
+	status, err := s.service.RetryOperation(ctx, id)
+	endWithOperationStatus(span, status, err)
+
+	return status, err
+}
+
+func (s tracedService) ReconnectRuntimeAgent(ctx context.Context, id string) (string, apperrors.AppError) {
+	return s.service.ReconnectRuntimeAgent(ctx, id)
+}
+
+func (s tracedService) RuntimeStatus(ctx context.Context, id string) (*gqlschema.RuntimeStatus, apperrors.AppError) {
+	ctx, span := tracing.Start(ctx, "Service.RuntimeStatus", tracing.RuntimeIDKey.String(id))
+	status, err := s.service.RuntimeStatus(ctx, id)
+	tracing.End(span, err)
+
+	return status, err
+}
+
+func (s tracedService) RuntimeOperationStatus(ctx context.Context, id string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	ctx, span := tracing.Start(ctx, "Service.RuntimeOperationStatus", tracing.OperationIDKey.String(id))
+	status, err := s.service.RuntimeOperationStatus(ctx, id)
+	endWithOperationStatus(span, status, err)
+
+	return status, err
+}
+
+func (s tracedService) ListRuntimes(ctx context.Context, filter *gqlschema.RuntimeFilterInput, first *int, after *string) (*gqlschema.RuntimeSummaryPage, apperrors.AppError) {
+	ctx, span := tracing.Start(ctx, "Service.ListRuntimes")
+	page, err := s.service.ListRuntimes(ctx, filter, first, after)
+	tracing.End(span, err)
+
+	return page, err
+}
+
+func (s tracedService) RuntimeOperations(ctx context.Context, runtimeID string, types []gqlschema.OperationType, states []gqlschema.OperationState, first *int, after *string) (*gqlschema.OperationHistoryPage, apperrors.AppError) {
+	ctx, span := tracing.Start(ctx, "Service.RuntimeOperations", tracing.RuntimeIDKey.String(runtimeID))
+	page, err := s.service.RuntimeOperations(ctx, runtimeID, types, states, first, after)
+	tracing.End(span, err)
+
+	return page, err
+}
+
+func endWithOperationStatus(span trace.Span, status *gqlschema.OperationStatus, err apperrors.AppError) {
+	if status != nil && status.ID != nil && *status.ID != "" {
+		span.SetAttributes(tracing.OperationIDKey.String(*status.ID))
+	}
+	if status != nil && status.RuntimeID != nil && *status.RuntimeID != "" {
+		span.SetAttributes(tracing.RuntimeIDKey.String(*status.RuntimeID))
+	}
+
+	tracing.End(span, err)
+}
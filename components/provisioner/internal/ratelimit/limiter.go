@@ -0,0 +1,78 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// idleLimiterTTL is the time after which limiters of inactive keys are removed
+const idleLimiterTTL = 10 * time.Minute
+
+type limiterEntry struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// keyedLimiter keeps separate token bucket for every key, for example for every tenant
+type keyedLimiter struct {
+	limit rate.Limit
+	burst int
+
+	mutex       sync.Mutex
+	limiters    map[string]*limiterEntry
+	lastCleanup time.Time
+	now         func() time.Time
+}
+
+func newKeyedLimiter(limit rate.Limit, burst int) *keyedLimiter {
+	return &keyedLimiter{
+		limit:    limit,
+		burst:    burst,
+		limiters: map[string]*limiterEntry{},
+		now:      time.Now,
+	}
+}
+
+// reserve returns zero if the request is allowed, otherwise the time after which it can be retried
+func (l *keyedLimiter) reserve(key string) time.Duration {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := l.now()
+	l.removeIdle(now)
+
+	entry, found := l.limiters[key]
+	if !found {
+		entry = &limiterEntry{limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.limiters[key] = entry
+	}
+	entry.lastSeen = now
+
+	reservation := entry.limiter.ReserveN(now, 1)
+	if !reservation.OK() {
+		return idleLimiterTTL
+	}
+
+	delay := reservation.DelayFrom(now)
+	if delay > 0 {
+		// Rejected requests do not consume tokens
+		reservation.CancelAt(now)
+	}
+
+	return delay
+}
+
+func (l *keyedLimiter) removeIdle(now time.Time) {
+	if now.Sub(l.lastCleanup) < idleLimiterTTL {
+		return
+	}
+
+	for key, entry := range l.limiters {
+		if now.Sub(entry.lastSeen) >= idleLimiterTTL {
+			delete(l.limiters, key)
+		}
+	}
+	l.lastCleanup = now
+}
@@ -0,0 +1,98 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/api/middlewares"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+)
+
+type Config struct {
+	Enabled bool `envconfig:"default=false"`
+	// RequestsPerSecond limits all API requests of a single tenant
+	RequestsPerSecond float64 `envconfig:"default=10"`
+	RequestsBurst     int     `envconfig:"default=20"`
+	// MutationsPerMinute limits calls of every mutation by a single tenant
+	MutationsPerMinute float64 `envconfig:"default=10"`
+	MutationsBurst     int     `envconfig:"default=5"`
+}
+
+// NewRequestLimiter returns HTTP middleware limiting requests rate of every tenant
+func NewRequestLimiter(config Config) func(http.Handler) http.Handler {
+	limiter := newKeyedLimiter(rate.Limit(config.RequestsPerSecond), config.RequestsBurst)
+
+	return func(handler http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			tenant := tenantFromContext(r.Context())
+
+			delay := limiter.reserve(tenant)
+			if delay > 0 {
+				log.Warnf("Rate limit of requests exceeded for tenant %s", tenant)
+				w.Header().Set("Retry-After", retryAfter(delay))
+				http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
+				return
+			}
+
+			handler.ServeHTTP(w, r)
+		})
+	}
+}
+
+// MutationLimiter is GraphQL extension limiting calls rate of every mutation separately for every tenant
+type MutationLimiter struct {
+	limiter *keyedLimiter
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.RootFieldInterceptor
+} = &MutationLimiter{}
+
+func NewMutationLimiter(config Config) *MutationLimiter {
+	return &MutationLimiter{
+		limiter: newKeyedLimiter(rate.Limit(config.MutationsPerMinute/60), config.MutationsBurst),
+	}
+}
+
+func (l *MutationLimiter) ExtensionName() string {
+	return "MutationLimiter"
+}
+
+func (l *MutationLimiter) Validate(_ graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (l *MutationLimiter) InterceptRootField(ctx context.Context, next graphql.RootResolver) graphql.Marshaler {
+	fieldCtx := graphql.GetRootFieldContext(ctx)
+	if fieldCtx == nil || fieldCtx.Object != "Mutation" {
+		return next(ctx)
+	}
+
+	tenant := tenantFromContext(ctx)
+	mutation := fieldCtx.Field.Name
+
+	delay := l.limiter.reserve(tenant + "/" + mutation)
+	if delay > 0 {
+		log.Warnf("Rate limit of %s mutation exceeded for tenant %s", mutation, tenant)
+		graphql.AddError(ctx, apperrors.TooManyRequests("rate limit of %s mutation exceeded, retry after %s", mutation, retryAfter(delay)+"s"))
+		return graphql.Null
+	}
+
+	return next(ctx)
+}
+
+func tenantFromContext(ctx context.Context) string {
+	tenant, _ := ctx.Value(middlewares.Tenant).(string)
+	return tenant
+}
+
+func retryAfter(delay time.Duration) string {
+	return fmt.Sprintf("%d", int(math.Ceil(delay.Seconds())))
+}
@@ -0,0 +1,134 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/api/middlewares"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"golang.org/x/time/rate"
+)
+
+func TestRequestLimiter(t *testing.T) {
+	// given
+	config := Config{RequestsPerSecond: 1, RequestsBurst: 2}
+	handler := NewRequestLimiter(config)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	request := func(tenant string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+		req = req.WithContext(context.WithValue(req.Context(), middlewares.Tenant, tenant))
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	// when
+	assert.Equal(t, http.StatusOK, request("tenant").Code)
+	assert.Equal(t, http.StatusOK, request("tenant").Code)
+	limited := request("tenant")
+
+	// then
+	assert.Equal(t, http.StatusTooManyRequests, limited.Code)
+	assert.Equal(t, "1", limited.Header().Get("Retry-After"))
+	assert.Equal(t, http.StatusOK, request("other-tenant").Code, "other tenants should not be limited")
+}
+
+func TestMutationLimiter_InterceptRootField(t *testing.T) {
+	limiter := NewMutationLimiter(Config{MutationsPerMinute: 1, MutationsBurst: 1})
+
+	call := func(tenant, object, field string) (bool, error) {
+		ctx := graphql.WithResponseContext(context.Background(), graphql.DefaultErrorPresenter, graphql.DefaultRecover)
+		ctx = graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: object,
+			Field:  graphql.CollectedField{Field: &ast.Field{Name: field}},
+		})
+		ctx = context.WithValue(ctx, middlewares.Tenant, tenant)
+
+		called := false
+		limiter.InterceptRootField(ctx, func(ctx context.Context) graphql.Marshaler {
+			called = true
+			return graphql.MarshalString("result")
+		})
+
+		errs := graphql.GetErrors(ctx)
+		if len(errs) > 0 {
+			return called, errs[0]
+		}
+		return called, nil
+	}
+
+	t.Run("should limit mutation calls of tenant", func(t *testing.T) {
+		// when
+		called, err := call("tenant", "Mutation", "provisionRuntime")
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, called)
+
+		// when
+		called, err = call("tenant", "Mutation", "provisionRuntime")
+
+		// then
+		require.Error(t, err)
+		assert.False(t, called)
+		var appErr apperrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, apperrors.CodeTooManyRequests, appErr.Code())
+	})
+
+	t.Run("should limit every mutation and tenant separately", func(t *testing.T) {
+		for _, testCase := range []struct {
+			tenant   string
+			mutation string
+		}{
+			{tenant: "tenant", mutation: "deprovisionRuntime"},
+			{tenant: "other-tenant", mutation: "provisionRuntime"},
+		} {
+			// when
+			called, err := call(testCase.tenant, "Mutation", testCase.mutation)
+
+			// then
+			require.NoError(t, err)
+			assert.True(t, called)
+		}
+	})
+
+	t.Run("should not limit queries", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			// when
+			called, err := call("tenant", "Query", "runtimeStatus")
+
+			// then
+			require.NoError(t, err)
+			assert.True(t, called)
+		}
+	})
+}
+
+func TestKeyedLimiter_removeIdle(t *testing.T) {
+	// given
+	now := time.Now()
+	limiter := newKeyedLimiter(rate.Limit(1), 1)
+	limiter.now = func() time.Time { return now }
+
+	limiter.reserve("tenant")
+	require.Len(t, limiter.limiters, 1)
+
+	// when
+	now = now.Add(idleLimiterTTL)
+	delay := limiter.reserve("other-tenant")
+
+	// then
+	assert.Zero(t, delay)
+	assert.Len(t, limiter.limiters, 1)
+	assert.Contains(t, limiter.limiters, "other-tenant")
+}
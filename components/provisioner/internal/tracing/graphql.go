@@ -0,0 +1,37 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// GraphQLTracer is GraphQL extension starting span for every field which has its own resolver
+type GraphQLTracer struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.FieldInterceptor
+} = GraphQLTracer{}
+
+func (t GraphQLTracer) ExtensionName() string {
+	return "Tracing"
+}
+
+func (t GraphQLTracer) Validate(_ graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (t GraphQLTracer) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fieldCtx := graphql.GetFieldContext(ctx)
+	if fieldCtx == nil || !fieldCtx.IsResolver {
+		return next(ctx)
+	}
+
+	ctx, span := Start(ctx, fmt.Sprintf("%s.%s", fieldCtx.Object, fieldCtx.Field.Name))
+	result, err := next(ctx)
+	End(span, err)
+
+	return result, err
+}
@@ -0,0 +1,118 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	instrumentationName = "github.com/kyma-project/control-plane/components/provisioner"
+	serviceName         = "provisioner"
+
+	traceParentHeader = "traceparent"
+)
+
+const (
+	OperationIDKey   = attribute.Key("provisioner.operation.id")
+	OperationTypeKey = attribute.Key("provisioner.operation.type")
+	RuntimeIDKey     = attribute.Key("provisioner.runtime.id")
+)
+
+type Config struct {
+	Enabled bool `envconfig:"default=false"`
+	// Endpoint is the host and port of OTLP HTTP collector
+	Endpoint string `envconfig:"default=localhost:4318"`
+	Insecure bool   `envconfig:"default=true"`
+	// SampleRatio is the fraction of traces started by the Provisioner which are recorded
+	SampleRatio float64 `envconfig:"default=1"`
+}
+
+// NewTracerProvider returns provider exporting spans to OTLP collector in batches
+func NewTracerProvider(ctx context.Context, config Config) (*sdktrace.TracerProvider, error) {
+	options := []otlptracehttp.Option{otlptracehttp.WithEndpoint(config.Endpoint)}
+	if config.Insecure {
+		options = append(options, otlptracehttp.WithInsecure())
+	}
+
+	exporter, err := otlptracehttp.New(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(config.SampleRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(serviceName))),
+	), nil
+}
+
+// SetTracerProvider makes the provider used by all spans of the Provisioner, without it spans are not recorded
+func SetTracerProvider(provider trace.TracerProvider) {
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+}
+
+// Start starts span which is a child of the span from the context
+func Start(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// StartLinked starts new trace linked to the trace stored with Inject, processing of asynchronous operations
+// is traced separately from the request which started the operation
+func StartLinked(ctx context.Context, name string, traceContext string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	options := []trace.SpanStartOption{trace.WithNewRoot(), trace.WithAttributes(attributes...)}
+
+	if linked := Extract(traceContext); linked.IsValid() {
+		options = append(options, trace.WithLinks(trace.Link{SpanContext: linked}))
+	}
+
+	return otel.Tracer(instrumentationName).Start(ctx, name, options...)
+}
+
+// End records the error, if any, and ends the span
+func End(span trace.Span, err error) {
+	RecordError(span, err)
+	span.End()
+}
+
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// Inject returns trace context of the span from the context in W3C traceparent format, it is empty when the span is not recorded
+func Inject(ctx context.Context) string {
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+
+	return carrier.Get(traceParentHeader)
+}
+
+// Extract returns span context stored with Inject
+func Extract(traceContext string) trace.SpanContext {
+	if traceContext == "" {
+		return trace.SpanContext{}
+	}
+
+	ctx := propagation.TraceContext{}.Extract(context.Background(), propagation.MapCarrier{traceParentHeader: traceContext})
+
+	return trace.SpanContextFromContext(ctx)
+}
+
+// Traced returns true when the context holds span which is part of a trace
+func Traced(ctx context.Context) bool {
+	return trace.SpanContextFromContext(ctx).IsValid()
+}
@@ -0,0 +1,188 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func newInMemoryExporter() *tracetest.InMemoryExporter {
+	exporter := tracetest.NewInMemoryExporter()
+	SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+
+	return exporter
+}
+
+func TestInjectExtract(t *testing.T) {
+	newInMemoryExporter()
+
+	t.Run("should restore span context stored with Inject", func(t *testing.T) {
+		// given
+		ctx, span := Start(context.Background(), "request")
+		defer span.End()
+
+		// when
+		traceContext := Inject(ctx)
+		spanContext := Extract(traceContext)
+
+		// then
+		assert.NotEmpty(t, traceContext)
+		assert.Equal(t, span.SpanContext().TraceID(), spanContext.TraceID())
+		assert.Equal(t, span.SpanContext().SpanID(), spanContext.SpanID())
+	})
+
+	t.Run("should return empty trace context when context holds no span", func(t *testing.T) {
+		// when
+		traceContext := Inject(context.Background())
+
+		// then
+		assert.Empty(t, traceContext)
+		assert.False(t, Extract(traceContext).IsValid())
+	})
+}
+
+func TestStartLinked(t *testing.T) {
+	// given
+	exporter := newInMemoryExporter()
+
+	ctx, requestSpan := Start(context.Background(), "request")
+	traceContext := Inject(ctx)
+	requestSpan.End()
+
+	// when
+	_, span := StartLinked(ctx, "operation", traceContext, OperationIDKey.String("operationID"))
+	span.End()
+
+	// then
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+
+	operationSpan := spans[1]
+	assert.Equal(t, "operation", operationSpan.Name)
+	assert.False(t, operationSpan.Parent.IsValid())
+	assert.NotEqual(t, requestSpan.SpanContext().TraceID(), operationSpan.SpanContext.TraceID())
+	require.Len(t, operationSpan.Links, 1)
+	assert.Equal(t, requestSpan.SpanContext().SpanID(), operationSpan.Links[0].SpanContext.SpanID())
+	assert.Contains(t, operationSpan.Attributes, OperationIDKey.String("operationID"))
+}
+
+func TestEnd(t *testing.T) {
+	// given
+	exporter := newInMemoryExporter()
+	_, span := Start(context.Background(), "failing")
+
+	// when
+	End(span, errors.New("some error"))
+
+	// then
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 1)
+	assert.Equal(t, codes.Error, spans[0].Status.Code)
+	assert.Equal(t, "some error", spans[0].Status.Description)
+}
+
+func TestGraphQLTracer(t *testing.T) {
+	fieldContext := func(isResolver bool) context.Context {
+		return graphql.WithFieldContext(context.Background(), &graphql.FieldContext{
+			Object:     "Mutation",
+			Field:      graphql.CollectedField{Field: &ast.Field{Name: "provisionRuntime"}},
+			IsResolver: isResolver,
+		})
+	}
+
+	t.Run("should start span for field with resolver", func(t *testing.T) {
+		// given
+		exporter := newInMemoryExporter()
+		var resolverCtx context.Context
+
+		// when
+		result, err := GraphQLTracer{}.InterceptField(fieldContext(true), func(ctx context.Context) (interface{}, error) {
+			resolverCtx = ctx
+			return "result", nil
+		})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "result", result)
+		assert.True(t, Traced(resolverCtx))
+
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 1)
+		assert.Equal(t, "Mutation.provisionRuntime", spans[0].Name)
+	})
+
+	t.Run("should not start span for field without resolver", func(t *testing.T) {
+		// given
+		exporter := newInMemoryExporter()
+
+		// when
+		_, err := GraphQLTracer{}.InterceptField(fieldContext(false), func(ctx context.Context) (interface{}, error) {
+			return "result", nil
+		})
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, exporter.GetSpans())
+	})
+}
+
+func TestNewTransport(t *testing.T) {
+	var traceParent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceParent = r.Header.Get(traceParentHeader)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewTransport("gardener", http.DefaultTransport)}
+
+	send := func(ctx context.Context) {
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+
+		response, err := client.Do(request)
+		require.NoError(t, err)
+		require.NoError(t, response.Body.Close())
+	}
+
+	t.Run("should trace request sent as part of a trace", func(t *testing.T) {
+		// given
+		exporter := newInMemoryExporter()
+		ctx, span := Start(context.Background(), "operation")
+
+		// when
+		send(ctx)
+		span.End()
+
+		// then
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 2)
+		assert.Equal(t, "gardener GET", spans[0].Name)
+		assert.Equal(t, span.SpanContext().SpanID(), spans[0].Parent.SpanID())
+		assert.Equal(t, trace.SpanKindClient, spans[0].SpanKind)
+		assert.Equal(t, Inject(trace.ContextWithSpanContext(context.Background(), spans[0].SpanContext)), traceParent)
+	})
+
+	t.Run("should not trace request sent outside of a trace", func(t *testing.T) {
+		// given
+		exporter := newInMemoryExporter()
+
+		// when
+		send(context.Background())
+
+		// then
+		assert.Empty(t, exporter.GetSpans())
+		assert.Empty(t, traceParent)
+	})
+}
@@ -0,0 +1,29 @@
+package tracing
+
+import (
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(request *http.Request) (*http.Response, error) {
+	return f(request)
+}
+
+// NewTransport traces requests sent as part of a trace, other requests such as watches of the shoot controller are not traced
+func NewTransport(name string, transport http.RoundTripper) http.RoundTripper {
+	traced := otelhttp.NewTransport(transport, otelhttp.WithSpanNameFormatter(func(_ string, request *http.Request) string {
+		return fmt.Sprintf("%s %s", name, request.Method)
+	}))
+
+	return roundTripperFunc(func(request *http.Request) (*http.Response, error) {
+		if !Traced(request.Context()) {
+			return transport.RoundTrip(request)
+		}
+
+		return traced.RoundTrip(request)
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"time"
 )
 
 type ProviderSpecificConfig interface {
@@ -40,6 +41,28 @@ type AWSZoneInput struct {
 	WorkerCidr   string `json:"workerCidr"`
 }
 
+type AlicloudProviderConfig struct {
+	VpcCidr       *string         `json:"vpcCidr,omitempty"`
+	AlicloudZones []*AlicloudZone `json:"alicloudZones"`
+}
+
+func (AlicloudProviderConfig) IsProviderSpecificConfig() {}
+
+type AlicloudProviderConfigInput struct {
+	VpcCidr       string               `json:"vpcCidr"`
+	AlicloudZones []*AlicloudZoneInput `json:"alicloudZones"`
+}
+
+type AlicloudZone struct {
+	Name       string `json:"name"`
+	WorkerCidr string `json:"workerCidr"`
+}
+
+type AlicloudZoneInput struct {
+	Name       string `json:"name"`
+	WorkerCidr string `json:"workerCidr"`
+}
+
 type AzureProviderConfig struct {
 	VnetCidr                     *string      `json:"vnetCidr,omitempty"`
 	Zones                        []string     `json:"zones,omitempty"`
@@ -71,6 +94,7 @@ type AzureZoneInput struct {
 type ClusterConfigInput struct {
 	GardenerConfig *GardenerConfigInput `json:"gardenerConfig"`
 	Administrators []string             `json:"administrators,omitempty"`
+	Priority       *OperationPriority   `json:"priority,omitempty"`
 }
 
 type ComponentConfiguration struct {
@@ -124,6 +148,11 @@ type DNSProviderInput struct {
 	Type           string   `json:"type"`
 }
 
+type DryRunResult struct {
+	Shoot string            `json:"shoot"`
+	Diff  []*ShootFieldDiff `json:"diff"`
+}
+
 type Error struct {
 	Message *string `json:"message,omitempty"`
 }
@@ -168,6 +197,8 @@ type GardenerConfig struct {
 	ShootNetworkingFilterDisabled       *bool                  `json:"shootNetworkingFilterDisabled,omitempty"`
 	ControlPlaneFailureTolerance        *string                `json:"controlPlaneFailureTolerance,omitempty"`
 	EuAccess                            *bool                  `json:"euAccess,omitempty"`
+	NodeConfig                          *NodeConfig            `json:"nodeConfig,omitempty"`
+	AdditionalWorkerPools               []*WorkerPool          `json:"additionalWorkerPools,omitempty"`
 }
 
 type GardenerConfigInput struct {
@@ -201,6 +232,8 @@ type GardenerConfigInput struct {
 	ControlPlaneFailureTolerance        *string                `json:"controlPlaneFailureTolerance,omitempty"`
 	EuAccess                            *bool                  `json:"euAccess,omitempty"`
 	ShootAndSeedSameRegion              *bool                  `json:"shootAndSeedSameRegion,omitempty"`
+	NodeConfig                          *NodeConfigInput       `json:"nodeConfig,omitempty"`
+	AdditionalWorkerPools               []*WorkerPoolInput     `json:"additionalWorkerPools,omitempty"`
 }
 
 type GardenerUpgradeInput struct {
@@ -221,6 +254,8 @@ type GardenerUpgradeInput struct {
 	OidcConfig                          *OIDCConfigInput       `json:"oidcConfig,omitempty"`
 	ExposureClassName                   *string                `json:"exposureClassName,omitempty"`
 	ShootNetworkingFilterDisabled       *bool                  `json:"shootNetworkingFilterDisabled,omitempty"`
+	NodeConfig                          *NodeConfigInput       `json:"nodeConfig,omitempty"`
+	AdditionalWorkerPools               []*WorkerPoolInput     `json:"additionalWorkerPools,omitempty"`
 }
 
 type HibernationStatus struct {
@@ -228,6 +263,34 @@ type HibernationStatus struct {
 	HibernationPossible *bool `json:"hibernationPossible,omitempty"`
 }
 
+type KubeletConfig struct {
+	MaxPods      *int             `json:"maxPods,omitempty"`
+	PodPidsLimit *int             `json:"podPidsLimit,omitempty"`
+	EvictionHard *KubeletEviction `json:"evictionHard,omitempty"`
+}
+
+type KubeletConfigInput struct {
+	MaxPods      *int                  `json:"maxPods,omitempty"`
+	PodPidsLimit *int                  `json:"podPidsLimit,omitempty"`
+	EvictionHard *KubeletEvictionInput `json:"evictionHard,omitempty"`
+}
+
+type KubeletEviction struct {
+	MemoryAvailable   *string `json:"memoryAvailable,omitempty"`
+	ImageFSAvailable  *string `json:"imageFSAvailable,omitempty"`
+	ImageFSInodesFree *string `json:"imageFSInodesFree,omitempty"`
+	NodeFSAvailable   *string `json:"nodeFSAvailable,omitempty"`
+	NodeFSInodesFree  *string `json:"nodeFSInodesFree,omitempty"`
+}
+
+type KubeletEvictionInput struct {
+	MemoryAvailable   *string `json:"memoryAvailable,omitempty"`
+	ImageFSAvailable  *string `json:"imageFSAvailable,omitempty"`
+	ImageFSInodesFree *string `json:"imageFSInodesFree,omitempty"`
+	NodeFSAvailable   *string `json:"nodeFSAvailable,omitempty"`
+	NodeFSInodesFree  *string `json:"nodeFSInodesFree,omitempty"`
+}
+
 type KymaConfig struct {
 	Version       *string                   `json:"version,omitempty"`
 	Profile       *KymaProfile              `json:"profile,omitempty"`
@@ -252,6 +315,20 @@ type LastError struct {
 type Mutation struct {
 }
 
+type NodeConfig struct {
+	Labels        Labels         `json:"labels,omitempty"`
+	Taints        []*Taint       `json:"taints,omitempty"`
+	CriName       *string        `json:"criName,omitempty"`
+	KubeletConfig *KubeletConfig `json:"kubeletConfig,omitempty"`
+}
+
+type NodeConfigInput struct {
+	Labels        Labels              `json:"labels,omitempty"`
+	Taints        []*TaintInput       `json:"taints,omitempty"`
+	CriName       *string             `json:"criName,omitempty"`
+	KubeletConfig *KubeletConfigInput `json:"kubeletConfig,omitempty"`
+}
+
 type OIDCConfig struct {
 	ClientID       string   `json:"clientID"`
 	GroupsClaim    string   `json:"groupsClaim"`
@@ -286,6 +363,23 @@ type OpenStackProviderConfigInput struct {
 	LoadBalancerProvider string   `json:"loadBalancerProvider"`
 }
 
+type OperationHistoryEntry struct {
+	ID             string         `json:"id"`
+	Operation      OperationType  `json:"operation"`
+	State          OperationState `json:"state"`
+	Stage          string         `json:"stage"`
+	Message        *string        `json:"message,omitempty"`
+	StartTimestamp time.Time      `json:"startTimestamp"`
+	EndTimestamp   *time.Time     `json:"endTimestamp,omitempty"`
+	LastTransition *time.Time     `json:"lastTransition,omitempty"`
+	LastError      *LastError     `json:"lastError,omitempty"`
+}
+
+type OperationHistoryPage struct {
+	Data     []*OperationHistoryEntry `json:"data"`
+	PageInfo *PageInfo                `json:"pageInfo"`
+}
+
 type OperationStatus struct {
 	ID               *string        `json:"id,omitempty"`
 	Operation        OperationType  `json:"operation"`
@@ -294,6 +388,13 @@ type OperationStatus struct {
 	RuntimeID        *string        `json:"runtimeID,omitempty"`
 	CompassRuntimeID *string        `json:"compassRuntimeID,omitempty"`
 	LastError        *LastError     `json:"lastError,omitempty"`
+	Attempts         int            `json:"attempts"`
+	DryRunResult     *DryRunResult  `json:"dryRunResult,omitempty"`
+}
+
+type PageInfo struct {
+	EndCursor   *string `json:"endCursor,omitempty"`
+	HasNextPage bool    `json:"hasNextPage"`
 }
 
 type ProviderSpecificInput struct {
@@ -301,12 +402,14 @@ type ProviderSpecificInput struct {
 	AzureConfig     *AzureProviderConfigInput     `json:"azureConfig,omitempty"`
 	AwsConfig       *AWSProviderConfigInput       `json:"awsConfig,omitempty"`
 	OpenStackConfig *OpenStackProviderConfigInput `json:"openStackConfig,omitempty"`
+	AlicloudConfig  *AlicloudProviderConfigInput  `json:"alicloudConfig,omitempty"`
 }
 
 type ProvisionRuntimeInput struct {
 	RuntimeInput  *RuntimeInput       `json:"runtimeInput"`
 	ClusterConfig *ClusterConfigInput `json:"clusterConfig"`
 	KymaConfig    *KymaConfigInput    `json:"kymaConfig,omitempty"`
+	Priority      *OperationPriority  `json:"priority,omitempty"`
 }
 
 type Query struct {
@@ -323,6 +426,16 @@ type RuntimeConnectionStatus struct {
 	Errors []*Error                     `json:"errors,omitempty"`
 }
 
+type RuntimeFilterInput struct {
+	Tenant             *string         `json:"tenant,omitempty"`
+	SubAccountID       *string         `json:"subAccountID,omitempty"`
+	Provider           *string         `json:"provider,omitempty"`
+	Region             *string         `json:"region,omitempty"`
+	KubernetesVersion  *string         `json:"kubernetesVersion,omitempty"`
+	LastOperationState *OperationState `json:"lastOperationState,omitempty"`
+	Deleted            *bool           `json:"deleted,omitempty"`
+}
+
 type RuntimeInput struct {
 	Name        string  `json:"name"`
 	Description *string `json:"description,omitempty"`
@@ -334,6 +447,54 @@ type RuntimeStatus struct {
 	RuntimeConnectionStatus *RuntimeConnectionStatus `json:"runtimeConnectionStatus,omitempty"`
 	RuntimeConfiguration    *RuntimeConfig           `json:"runtimeConfiguration,omitempty"`
 	HibernationStatus       *HibernationStatus       `json:"hibernationStatus,omitempty"`
+	Drift                   []*ShootDrift            `json:"drift,omitempty"`
+}
+
+type RuntimeSummary struct {
+	ID                  string           `json:"id"`
+	Tenant              string           `json:"tenant"`
+	SubAccountID        *string          `json:"subAccountID,omitempty"`
+	Name                string           `json:"name"`
+	Provider            string           `json:"provider"`
+	Region              string           `json:"region"`
+	KubernetesVersion   string           `json:"kubernetesVersion"`
+	Deleted             bool             `json:"deleted"`
+	CreationTimestamp   time.Time        `json:"creationTimestamp"`
+	LastOperationStatus *OperationStatus `json:"lastOperationStatus,omitempty"`
+}
+
+type RuntimeSummaryPage struct {
+	Data     []*RuntimeSummary `json:"data"`
+	PageInfo *PageInfo         `json:"pageInfo"`
+}
+
+type ShootDrift struct {
+	Field         string      `json:"field"`
+	ExpectedValue string      `json:"expectedValue"`
+	ActualValue   string      `json:"actualValue"`
+	Policy        DriftPolicy `json:"policy"`
+	DetectedAt    time.Time   `json:"detectedAt"`
+}
+
+type ShootFieldDiff struct {
+	Path     string  `json:"path"`
+	OldValue *string `json:"oldValue,omitempty"`
+	NewValue *string `json:"newValue,omitempty"`
+}
+
+type Subscription struct {
+}
+
+type Taint struct {
+	Key    string  `json:"key"`
+	Value  *string `json:"value,omitempty"`
+	Effect string  `json:"effect"`
+}
+
+type TaintInput struct {
+	Key    string  `json:"key"`
+	Value  *string `json:"value,omitempty"`
+	Effect string  `json:"effect"`
 }
 
 type UpgradeRuntimeInput struct {
@@ -343,6 +504,35 @@ type UpgradeRuntimeInput struct {
 type UpgradeShootInput struct {
 	GardenerConfig *GardenerUpgradeInput `json:"gardenerConfig"`
 	Administrators []string              `json:"administrators,omitempty"`
+	Priority       *OperationPriority    `json:"priority,omitempty"`
+}
+
+type WorkerPool struct {
+	Name                string      `json:"name"`
+	MachineType         string      `json:"machineType"`
+	MachineImage        *string     `json:"machineImage,omitempty"`
+	MachineImageVersion *string     `json:"machineImageVersion,omitempty"`
+	DiskType            *string     `json:"diskType,omitempty"`
+	VolumeSizeGb        *int        `json:"volumeSizeGB,omitempty"`
+	AutoScalerMin       int         `json:"autoScalerMin"`
+	AutoScalerMax       int         `json:"autoScalerMax"`
+	MaxSurge            int         `json:"maxSurge"`
+	MaxUnavailable      int         `json:"maxUnavailable"`
+	NodeConfig          *NodeConfig `json:"nodeConfig,omitempty"`
+}
+
+type WorkerPoolInput struct {
+	Name                string           `json:"name"`
+	MachineType         string           `json:"machineType"`
+	MachineImage        *string          `json:"machineImage,omitempty"`
+	MachineImageVersion *string          `json:"machineImageVersion,omitempty"`
+	DiskType            *string          `json:"diskType,omitempty"`
+	VolumeSizeGb        *int             `json:"volumeSizeGB,omitempty"`
+	AutoScalerMin       int              `json:"autoScalerMin"`
+	AutoScalerMax       int              `json:"autoScalerMax"`
+	MaxSurge            int              `json:"maxSurge"`
+	MaxUnavailable      int              `json:"maxUnavailable"`
+	NodeConfig          *NodeConfigInput `json:"nodeConfig,omitempty"`
 }
 
 type ConflictStrategy string
@@ -386,6 +576,49 @@ func (e ConflictStrategy) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type DriftPolicy string
+
+const (
+	DriftPolicyRecord DriftPolicy = "Record"
+	DriftPolicyRevert DriftPolicy = "Revert"
+	DriftPolicyAdopt  DriftPolicy = "Adopt"
+)
+
+var AllDriftPolicy = []DriftPolicy{
+	DriftPolicyRecord,
+	DriftPolicyRevert,
+	DriftPolicyAdopt,
+}
+
+func (e DriftPolicy) IsValid() bool {
+	switch e {
+	case DriftPolicyRecord, DriftPolicyRevert, DriftPolicyAdopt:
+		return true
+	}
+	return false
+}
+
+func (e DriftPolicy) String() string {
+	return string(e)
+}
+
+func (e *DriftPolicy) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DriftPolicy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DriftPolicy", str)
+	}
+	return nil
+}
+
+func (e DriftPolicy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type KymaProfile string
 
 const (
@@ -427,6 +660,49 @@ func (e KymaProfile) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type OperationPriority string
+
+const (
+	OperationPriorityLow    OperationPriority = "Low"
+	OperationPriorityNormal OperationPriority = "Normal"
+	OperationPriorityHigh   OperationPriority = "High"
+)
+
+var AllOperationPriority = []OperationPriority{
+	OperationPriorityLow,
+	OperationPriorityNormal,
+	OperationPriorityHigh,
+}
+
+func (e OperationPriority) IsValid() bool {
+	switch e {
+	case OperationPriorityLow, OperationPriorityNormal, OperationPriorityHigh:
+		return true
+	}
+	return false
+}
+
+func (e OperationPriority) String() string {
+	return string(e)
+}
+
+func (e *OperationPriority) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OperationPriority(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OperationPriority", str)
+	}
+	return nil
+}
+
+func (e OperationPriority) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type OperationState string
 
 const (
@@ -434,6 +710,7 @@ const (
 	OperationStateInProgress OperationState = "InProgress"
 	OperationStateSucceeded  OperationState = "Succeeded"
 	OperationStateFailed     OperationState = "Failed"
+	OperationStateCancelled  OperationState = "Cancelled"
 )
 
 var AllOperationState = []OperationState{
@@ -441,11 +718,12 @@ var AllOperationState = []OperationState{
 	OperationStateInProgress,
 	OperationStateSucceeded,
 	OperationStateFailed,
+	OperationStateCancelled,
 }
 
 func (e OperationState) IsValid() bool {
 	switch e {
-	case OperationStatePending, OperationStateInProgress, OperationStateSucceeded, OperationStateFailed:
+	case OperationStatePending, OperationStateInProgress, OperationStateSucceeded, OperationStateFailed, OperationStateCancelled:
 		return true
 	}
 	return false
@@ -483,6 +761,7 @@ const (
 	OperationTypeDeprovisionNoInstall OperationType = "DeprovisionNoInstall"
 	OperationTypeReconnectRuntime     OperationType = "ReconnectRuntime"
 	OperationTypeHibernate            OperationType = "Hibernate"
+	OperationTypeWakeUp               OperationType = "WakeUp"
 )
 
 var AllOperationType = []OperationType{
@@ -494,11 +773,12 @@ var AllOperationType = []OperationType{
 	OperationTypeDeprovisionNoInstall,
 	OperationTypeReconnectRuntime,
 	OperationTypeHibernate,
+	OperationTypeWakeUp,
 }
 
 func (e OperationType) IsValid() bool {
 	switch e {
-	case OperationTypeProvision, OperationTypeProvisionNoInstall, OperationTypeUpgrade, OperationTypeUpgradeShoot, OperationTypeDeprovision, OperationTypeDeprovisionNoInstall, OperationTypeReconnectRuntime, OperationTypeHibernate:
+	case OperationTypeProvision, OperationTypeProvisionNoInstall, OperationTypeUpgrade, OperationTypeUpgradeShoot, OperationTypeDeprovision, OperationTypeDeprovisionNoInstall, OperationTypeReconnectRuntime, OperationTypeHibernate, OperationTypeWakeUp:
 		return true
 	}
 	return false
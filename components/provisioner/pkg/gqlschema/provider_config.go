@@ -34,6 +34,8 @@ func (g *GardenerConfig) UnmarshalJSON(data []byte) error {
 		g.ProviderSpecificConfig = &AWSProviderConfig{}
 	case "openstack": // TODO to enum which will be validated
 		g.ProviderSpecificConfig = &OpenStackProviderConfig{}
+	case "alicloud": // TODO to enum which will be validated
+		g.ProviderSpecificConfig = &AlicloudProviderConfig{}
 	default:
 		return fmt.Errorf("got unknown provider type %q", *temp.Provider)
 	}
@@ -25,6 +25,12 @@ func TestGardenerConfig_UnmarshalJSON(t *testing.T) {
 		CloudProfileName:     "converged-cloud-cp",
 		LoadBalancerProvider: "f5",
 	}
+	alicloudProviderCfg := &AlicloudProviderConfig{
+		VpcCidr: util.PtrTo("10.10.0.0/16"),
+		AlicloudZones: []*AlicloudZone{
+			{Name: "eu-central-1a", WorkerCidr: "10.10.0.0/19"},
+		},
+	}
 
 	for _, testCase := range []struct {
 		description    string
@@ -50,6 +56,10 @@ func TestGardenerConfig_UnmarshalJSON(t *testing.T) {
 			description:    "gardener cluster with Openstack",
 			gardenerConfig: newGardenerClusterCfg(fixGardenerConfig("openstack"), openstackProviderCfg),
 		},
+		{
+			description:    "gardener cluster with Alicloud",
+			gardenerConfig: newGardenerClusterCfg(fixGardenerConfig("alicloud"), alicloudProviderCfg),
+		},
 	} {
 		t.Run(testCase.description, func(t *testing.T) {
 			// given
@@ -0,0 +1,18 @@
+BEGIN;
+
+UPDATE operation SET state = 'FAILED' WHERE state = 'CANCELLED';
+
+ALTER TYPE operation_state RENAME TO operation_state_old;
+
+CREATE TYPE operation_state AS ENUM (
+    'IN_PROGRESS',
+    'SUCCEEDED',
+    'FAILED'
+    );
+
+
+ALTER TABLE operation ALTER COLUMN state TYPE operation_state USING state::text::operation_state;
+
+DROP TYPE operation_state_old;
+
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN;
+
+ALTER TABLE operation ALTER COLUMN state TYPE VARCHAR(255);
+
+DROP TYPE IF EXISTS operation_state;
+CREATE TYPE operation_state AS ENUM (
+    'IN_PROGRESS',
+    'SUCCEEDED',
+    'FAILED',
+    'CANCELLED'
+    );
+
+ALTER TABLE operation ALTER COLUMN state TYPE operation_state USING (state::operation_state);
+
+COMMIT;
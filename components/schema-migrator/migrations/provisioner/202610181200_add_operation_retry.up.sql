@@ -0,0 +1,16 @@
+BEGIN;
+
+CREATE TABLE operation_retry
+(
+    id uuid PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    operation_id uuid NOT NULL,
+    stage varchar(256) NOT NULL,
+    retry_timestamp timestamp without time zone NOT NULL,
+    message text,
+    err_message text NOT NULL,
+    reason text NOT NULL,
+    component text NOT NULL,
+    foreign key (operation_id) REFERENCES operation (id) ON DELETE CASCADE
+);
+
+COMMIT;
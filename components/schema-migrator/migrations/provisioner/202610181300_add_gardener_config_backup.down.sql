@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS gardener_config_backup;
+
+COMMIT;
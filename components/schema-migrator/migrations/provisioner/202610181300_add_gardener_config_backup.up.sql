@@ -0,0 +1,10 @@
+BEGIN;
+
+CREATE TABLE gardener_config_backup
+(
+    operation_id uuid PRIMARY KEY CHECK (operation_id <> '00000000-0000-0000-0000-000000000000'),
+    config jsonb NOT NULL,
+    foreign key (operation_id) REFERENCES operation (id) ON DELETE CASCADE
+);
+
+COMMIT;
@@ -0,0 +1,12 @@
+BEGIN;
+
+CREATE TABLE idempotency_key
+(
+    key varchar(256) PRIMARY KEY,
+    payload_hash varchar(64) NOT NULL,
+    operation_id uuid,
+    created_at timestamp without time zone NOT NULL,
+    foreign key (operation_id) REFERENCES operation (id) ON DELETE CASCADE
+);
+
+COMMIT;
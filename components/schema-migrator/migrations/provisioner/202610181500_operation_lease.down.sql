@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS operation_lease;
+
+COMMIT;
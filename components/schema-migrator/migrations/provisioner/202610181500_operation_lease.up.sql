@@ -0,0 +1,14 @@
+BEGIN;
+
+CREATE TABLE operation_lease
+(
+    operation_id uuid PRIMARY KEY,
+    owner varchar(256) NOT NULL,
+    heartbeat timestamp without time zone NOT NULL,
+    expires_at timestamp without time zone NOT NULL,
+    foreign key (operation_id) REFERENCES operation (id) ON DELETE CASCADE
+);
+
+CREATE INDEX operation_lease_owner_idx ON operation_lease (owner);
+
+COMMIT;
@@ -0,0 +1,14 @@
+BEGIN;
+
+CREATE TABLE operation_queue
+(
+    operation_id uuid PRIMARY KEY,
+    queue varchar(64) NOT NULL,
+    next_run_at timestamp without time zone NOT NULL,
+    attempts integer NOT NULL DEFAULT 0,
+    foreign key (operation_id) REFERENCES operation (id) ON DELETE CASCADE
+);
+
+CREATE INDEX operation_queue_next_run_idx ON operation_queue (queue, next_run_at);
+
+COMMIT;
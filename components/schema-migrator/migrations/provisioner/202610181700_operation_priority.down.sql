@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE operation_queue DROP COLUMN IF EXISTS priority;
+
+ALTER TABLE operation DROP COLUMN IF EXISTS priority;
+
+COMMIT;
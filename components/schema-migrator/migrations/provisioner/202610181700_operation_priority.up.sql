@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE operation ADD COLUMN priority integer NOT NULL DEFAULT 0;
+
+ALTER TABLE operation_queue ADD COLUMN priority integer NOT NULL DEFAULT 0;
+
+COMMIT;
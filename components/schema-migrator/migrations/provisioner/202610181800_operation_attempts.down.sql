@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE operation DROP COLUMN IF EXISTS attempts;
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE operation ADD COLUMN trace_context text NOT NULL DEFAULT '';
+
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN;
+
+CREATE TABLE shoot_drift
+(
+    cluster_id uuid NOT NULL,
+    field varchar(256) NOT NULL,
+    expected_value text NOT NULL,
+    actual_value text NOT NULL,
+    policy varchar(256) NOT NULL,
+    detected_at timestamp without time zone NOT NULL,
+    PRIMARY KEY (cluster_id, field),
+    foreign key (cluster_id) REFERENCES cluster (id) ON DELETE CASCADE
+);
+
+COMMIT;
@@ -0,0 +1,16 @@
+BEGIN;
+
+CREATE TABLE gardener_config_history
+(
+    id uuid PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    cluster_id uuid NOT NULL,
+    field varchar(256) NOT NULL,
+    old_value text NOT NULL,
+    new_value text NOT NULL,
+    changed_at timestamp without time zone NOT NULL,
+    foreign key (cluster_id) REFERENCES cluster (id) ON DELETE CASCADE
+);
+
+CREATE INDEX gardener_config_history_cluster_idx ON gardener_config_history (cluster_id, changed_at);
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE gardener_config DROP COLUMN IF EXISTS node_config;
+ALTER TABLE worker_pool DROP COLUMN IF EXISTS node_config;
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE gardener_config ADD COLUMN node_config jsonb;
+ALTER TABLE worker_pool ADD COLUMN node_config jsonb;
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE gardener_config_backup DROP COLUMN IF EXISTS upgraded_config;
+
+COMMIT;
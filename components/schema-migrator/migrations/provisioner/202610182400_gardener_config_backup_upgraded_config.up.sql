@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE gardener_config_backup ADD COLUMN upgraded_config jsonb;
+
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+
+DELETE FROM idempotency_key a USING idempotency_key b
+WHERE a.key = b.key AND (a.created_at, a.tenant) < (b.created_at, b.tenant);
+
+ALTER TABLE idempotency_key DROP CONSTRAINT idempotency_key_pkey;
+ALTER TABLE idempotency_key DROP COLUMN tenant;
+ALTER TABLE idempotency_key ADD PRIMARY KEY (key);
+
+COMMIT;
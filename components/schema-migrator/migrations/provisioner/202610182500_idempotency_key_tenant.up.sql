@@ -0,0 +1,13 @@
+BEGIN;
+
+ALTER TABLE idempotency_key ADD COLUMN tenant varchar(256) NOT NULL DEFAULT '';
+
+UPDATE idempotency_key SET tenant = cluster.tenant
+FROM operation JOIN cluster ON cluster.id = operation.cluster_id
+WHERE operation.id = idempotency_key.operation_id;
+
+ALTER TABLE idempotency_key DROP CONSTRAINT idempotency_key_pkey;
+ALTER TABLE idempotency_key ADD PRIMARY KEY (tenant, key);
+ALTER TABLE idempotency_key ALTER COLUMN tenant DROP DEFAULT;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE operation_queue DROP COLUMN IF EXISTS locked_until;
+
+ALTER TABLE operation_queue DROP COLUMN IF EXISTS owner;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE operation_queue ADD COLUMN owner varchar(256);
+
+ALTER TABLE operation_queue ADD COLUMN locked_until timestamp without time zone;
+
+COMMIT;